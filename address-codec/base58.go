@@ -76,6 +76,11 @@ func EncodeBase58(b []byte) string {
 
 }
 
+// IsBase58Char reports whether c is a character of the XRP base58 alphabet.
+func IsBase58Char(c rune) bool {
+	return c >= 0 && c < rune(len(b58)) && b58[c] != 255
+}
+
 // DecodeBase58 decodes a modified base58 string to a byte slice.
 func DecodeBase58(b string) []byte {
 	answer := big.NewInt(0)
@@ -68,3 +68,53 @@ func TestDecodeBase58(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBase58Char(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    rune
+		expected bool
+	}{
+		{
+			name:     "pass - first character of the alphabet",
+			input:    'r',
+			expected: true,
+		},
+		{
+			name:     "pass - digit",
+			input:    '9',
+			expected: true,
+		},
+		{
+			name:     "fail - zero",
+			input:    '0',
+			expected: false,
+		},
+		{
+			name:     "fail - uppercase O",
+			input:    'O',
+			expected: false,
+		},
+		{
+			name:     "fail - lowercase l",
+			input:    'l',
+			expected: false,
+		},
+		{
+			name:     "fail - non ascii",
+			input:    'é',
+			expected: false,
+		},
+		{
+			name:     "fail - negative",
+			input:    -1,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, IsBase58Char(tc.input))
+		})
+	}
+}
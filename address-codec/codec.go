@@ -22,6 +22,9 @@ const (
 	// NodePublicKeyLength is the length in bytes of a node/validation public key.
 	NodePublicKeyLength = 33
 
+	// NodePrivateKeyLength is the length in bytes of a node/validation private key.
+	NodePrivateKeyLength = 32
+
 	// AccountAddressPrefix is the classic address prefix (0x00).
 	AccountAddressPrefix = 0x00
 
@@ -33,6 +36,9 @@ const (
 
 	// NodePublicKeyPrefix is the prefix for node/validation public keys (0x1C).
 	NodePublicKeyPrefix = 0x1C
+
+	// NodePrivateKeyPrefix is the prefix for node/validation private keys (0x20).
+	NodePrivateKeyPrefix = 0x20
 )
 
 // Encode returns the Base58Check encoding of a byte slice with the given type prefix,
@@ -163,6 +169,27 @@ func DecodeNodePublicKey(key string) ([]byte, error) {
 	return decodedNodeKey, nil
 }
 
+// EncodeNodePrivateKey returns the base58 encoding of a node private key byte slice.
+func EncodeNodePrivateKey(b []byte) (string, error) {
+
+	if len(b) != NodePrivateKeyLength {
+		return "", &EncodeLengthError{Instance: "NodePrivateKey", Expected: NodePrivateKeyLength, Input: len(b)}
+	}
+
+	return Base58CheckEncode(b, NodePrivateKeyPrefix), nil
+}
+
+// DecodeNodePrivateKey returns the decoded node private key byte slice from a base58 string.
+func DecodeNodePrivateKey(key string) ([]byte, error) {
+
+	decodedNodeKey, err := Decode(key, []byte{NodePrivateKeyPrefix})
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedNodeKey, nil
+}
+
 // EncodeAccountPublicKey returns the base58 encoding of an account public key byte slice.
 func EncodeAccountPublicKey(b []byte) (string, error) {
 
@@ -464,6 +464,76 @@ func TestDecodeNodePublicKey(t *testing.T) {
 	}
 }
 
+func TestEncodeNodePrivateKey(t *testing.T) {
+	tt := []struct {
+		name           string
+		input          []byte
+		expectedOutput string
+		expectedErr    error
+	}{
+		{
+			name:           "pass - successful encode",
+			input:          []byte{0x39, 0x58, 0x98, 0x66, 0x57, 0x28, 0xf5, 0x7d, 0xe5, 0xd9, 0xf, 0x1d, 0xe1, 0x2, 0x27, 0x8a, 0x96, 0x7d, 0x69, 0x41, 0xa4, 0x5a, 0x6c, 0x9a, 0x98, 0xcb, 0x12, 0x33, 0x94, 0x48, 0x9e, 0x55},
+			expectedOutput: "pnen77YEeUd4fFKG7iycBWcwKpTaeFRkW2WFostaATy1DSupwXe",
+			expectedErr:    nil,
+		},
+		{
+			name:           "fail - length error",
+			input:          []byte{0x00},
+			expectedOutput: "",
+			expectedErr:    &EncodeLengthError{Instance: "NodePrivateKey", Expected: NodePrivateKeyLength, Input: 1},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := EncodeNodePrivateKey(tc.input)
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOutput, res)
+			}
+		})
+	}
+}
+
+func TestDecodeNodePrivateKey(t *testing.T) {
+	tt := []struct {
+		name           string
+		input          string
+		expectedOutput []byte
+		expectedErr    error
+	}{
+		{
+			name:           "pass - successful decode",
+			input:          "pnen77YEeUd4fFKG7iycBWcwKpTaeFRkW2WFostaATy1DSupwXe",
+			expectedOutput: []byte{0x39, 0x58, 0x98, 0x66, 0x57, 0x28, 0xf5, 0x7d, 0xe5, 0xd9, 0xf, 0x1d, 0xe1, 0x2, 0x27, 0x8a, 0x96, 0x7d, 0x69, 0x41, 0xa4, 0x5a, 0x6c, 0x9a, 0x98, 0xcb, 0x12, 0x33, 0x94, 0x48, 0x9e, 0x55},
+			expectedErr:    nil,
+		},
+		{
+			name:           "fail - prefix error",
+			input:          "n9MDGCfimuyCmKXUAMcR12rv39PE6PY5YfFpNs75ZjtY3UWt31td",
+			expectedOutput: nil,
+			expectedErr:    errors.New("b58string prefix and typeprefix not equal"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := DecodeNodePrivateKey(tc.input)
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOutput, res)
+			}
+		})
+	}
+}
+
 func TestEncodeAccountPublicKey(t *testing.T) {
 	tt := []struct {
 		name           string
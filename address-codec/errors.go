@@ -28,6 +28,19 @@ var (
 	ErrChecksum = errors.New("checksum error")
 	// ErrInvalidFormat indicates that the check-encoded string has an invalid format.
 	ErrInvalidFormat = errors.New("invalid format: version and/or checksum bytes missing")
+
+	// secret numbers
+
+	// ErrInvalidSecretNumbersLength indicates that secret numbers are not made of 8 blocks of 6 digits.
+	ErrInvalidSecretNumbersLength = errors.New("secret numbers must be 8 blocks of 6 digits")
+	// ErrInvalidSecretNumbersBlock indicates that a block of secret numbers is not made of 6 digits.
+	ErrInvalidSecretNumbersBlock = errors.New("block must be 6 digits")
+	// ErrInvalidSecretNumbersBlockPosition indicates that the position of a block of secret numbers is not between 0 and 7.
+	ErrInvalidSecretNumbersBlockPosition = errors.New("block position must be between 0 and 7")
+	// ErrSecretNumbersBlockOverflow indicates that the value of a block of secret numbers does not fit in two bytes.
+	ErrSecretNumbersBlockOverflow = errors.New("block value must not exceed 65535")
+	// ErrSecretNumbersChecksum indicates that the checksum digit of a block of secret numbers does not match its value.
+	ErrSecretNumbersChecksum = errors.New("block checksum does not match")
 )
 
 // Dynamic errors
@@ -43,3 +56,21 @@ type EncodeLengthError struct {
 func (e *EncodeLengthError) Error() string {
 	return fmt.Sprintf("`%v` length should be %v not %v", e.Instance, e.Expected, e.Input)
 }
+
+// SecretNumbersBlockError is an error that occurs when a block of secret numbers is invalid.
+// Block is the position of the block, starting at 0.
+type SecretNumbersBlockError struct {
+	Block int
+	Value string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *SecretNumbersBlockError) Error() string {
+	return fmt.Sprintf("secret numbers block %d (%q): %v", e.Block+1, e.Value, e.Err)
+}
+
+// Unwrap returns the reason why the block is invalid.
+func (e *SecretNumbersBlockError) Unwrap() error {
+	return e.Err
+}
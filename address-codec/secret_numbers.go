@@ -0,0 +1,93 @@
+package addresscodec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SecretNumbersBlocks is the number of blocks of secret numbers.
+	SecretNumbersBlocks = 8
+	// SecretNumbersBlockLength is the number of digits of a block of secret numbers:
+	// five digits for the value and a checksum digit.
+	SecretNumbersBlockLength = 6
+)
+
+// EncodeSecretNumbers encodes the 16 bytes entropy of a family seed as secret numbers, the seed format of the
+// Xaman wallet and the xrpl-secret-numbers library. Every block encodes two bytes of entropy as a five digits
+// value, followed by a checksum digit that depends on the position of the block.
+func EncodeSecretNumbers(entropy []byte) ([]string, error) {
+	if len(entropy) != FamilySeedLength {
+		return nil, &EncodeLengthError{Instance: "Entropy", Input: len(entropy), Expected: FamilySeedLength}
+	}
+
+	blocks := make([]string, SecretNumbersBlocks)
+	for i := range blocks {
+		value := int(entropy[2*i])<<8 | int(entropy[2*i+1])
+		blocks[i] = fmt.Sprintf("%05d%d", value, secretNumbersChecksum(i, value))
+	}
+	return blocks, nil
+}
+
+// DecodeSecretNumbers decodes secret numbers into the 16 bytes entropy of a family seed.
+// The blocks can be separated by spaces or any other non digit characters, or not separated at all.
+// Errors of a single block are returned as a SecretNumbersBlockError.
+func DecodeSecretNumbers(secretNumbers string) ([]byte, error) {
+	blocks := strings.FieldsFunc(secretNumbers, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if len(blocks) == 1 && len(blocks[0]) == SecretNumbersBlocks*SecretNumbersBlockLength {
+		digits := blocks[0]
+		blocks = make([]string, SecretNumbersBlocks)
+		for i := range blocks {
+			blocks[i] = digits[i*SecretNumbersBlockLength : (i+1)*SecretNumbersBlockLength]
+		}
+	}
+	if len(blocks) != SecretNumbersBlocks {
+		return nil, ErrInvalidSecretNumbersLength
+	}
+
+	entropy := make([]byte, 0, FamilySeedLength)
+	for i, block := range blocks {
+		value, err := decodeSecretNumbersBlock(i, block)
+		if err != nil {
+			return nil, err
+		}
+		entropy = append(entropy, byte(value>>8), byte(value))
+	}
+	return entropy, nil
+}
+
+// ValidateSecretNumbersBlock validates a single block of secret numbers at the given position, starting at 0.
+// It can be used to check the blocks one by one as they are entered.
+func ValidateSecretNumbersBlock(position int, block string) error {
+	_, err := decodeSecretNumbersBlock(position, block)
+	return err
+}
+
+// decodeSecretNumbersBlock decodes and validates the value of a block of secret numbers.
+func decodeSecretNumbersBlock(position int, block string) (int, error) {
+	if position < 0 || position >= SecretNumbersBlocks {
+		return 0, &SecretNumbersBlockError{Block: position, Value: block, Err: ErrInvalidSecretNumbersBlockPosition}
+	}
+	if len(block) != SecretNumbersBlockLength || strings.Trim(block, "0123456789") != "" {
+		return 0, &SecretNumbersBlockError{Block: position, Value: block, Err: ErrInvalidSecretNumbersBlock}
+	}
+
+	value, _ := strconv.Atoi(block[:SecretNumbersBlockLength-1])
+	checksum := int(block[SecretNumbersBlockLength-1] - '0')
+
+	if value > 0xFFFF {
+		return 0, &SecretNumbersBlockError{Block: position, Value: block, Err: ErrSecretNumbersBlockOverflow}
+	}
+	if checksum != secretNumbersChecksum(position, value) {
+		return 0, &SecretNumbersBlockError{Block: position, Value: block, Err: ErrSecretNumbersChecksum}
+	}
+	return value, nil
+}
+
+// secretNumbersChecksum computes the checksum digit of the value of a block at the given position.
+func secretNumbersChecksum(position, value int) int {
+	return value * (position*2 + 1) % 9
+}
@@ -0,0 +1,179 @@
+package addresscodec
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretNumbers        = "399150 474506 009147 088773 432160 282843 253738 605430"
+	testSecretNumbersEntropy = "9BEBB95A039222ADA8D06E7C631DEC7F"
+)
+
+func TestEncodeSecretNumbers(t *testing.T) {
+	tt := []struct {
+		name        string
+		entropy     string
+		expected    []string
+		expectedErr error
+	}{
+		{
+			name:     "pass - entropy",
+			entropy:  testSecretNumbersEntropy,
+			expected: strings.Fields(testSecretNumbers),
+		},
+		{
+			name:     "pass - zero entropy",
+			entropy:  "00000000000000000000000000000000",
+			expected: []string{"000000", "000000", "000000", "000000", "000000", "000000", "000000", "000000"},
+		},
+		{
+			name:     "pass - max entropy",
+			entropy:  "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			expected: []string{"655356", "655350", "655353", "655356", "655350", "655353", "655356", "655350"},
+		},
+		{
+			name:        "fail - invalid entropy length",
+			entropy:     "9BEBB95A039222ADA8D06E7C631DEC",
+			expectedErr: &EncodeLengthError{Instance: "Entropy", Input: 15, Expected: FamilySeedLength},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			entropy, err := hex.DecodeString(tc.entropy)
+			require.NoError(t, err)
+
+			blocks, err := EncodeSecretNumbers(entropy)
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, blocks)
+		})
+	}
+}
+
+func TestDecodeSecretNumbers(t *testing.T) {
+	tt := []struct {
+		name          string
+		secretNumbers string
+		expected      string
+		expectedErr   error
+		expectedBlock int
+	}{
+		{
+			name:          "pass - blocks separated by spaces",
+			secretNumbers: testSecretNumbers,
+			expected:      testSecretNumbersEntropy,
+		},
+		{
+			name:          "pass - blocks separated by dashes and new lines",
+			secretNumbers: "399150-474506-009147-088773\n432160-282843-253738-605430\n",
+			expected:      testSecretNumbersEntropy,
+		},
+		{
+			name:          "pass - blocks not separated",
+			secretNumbers: strings.ReplaceAll(testSecretNumbers, " ", ""),
+			expected:      testSecretNumbersEntropy,
+		},
+		{
+			name:          "fail - missing block",
+			secretNumbers: "399150 474506 009147 088773 432160 282843 253738",
+			expectedErr:   ErrInvalidSecretNumbersLength,
+		},
+		{
+			name:          "fail - checksum of the third block",
+			secretNumbers: "399150 474506 009148 088773 432160 282843 253738 605430",
+			expectedErr:   ErrSecretNumbersChecksum,
+			expectedBlock: 2,
+		},
+		{
+			name:          "fail - short fifth block",
+			secretNumbers: "399150 474506 009147 088773 43216 282843 253738 605430",
+			expectedErr:   ErrInvalidSecretNumbersBlock,
+			expectedBlock: 4,
+		},
+		{
+			name:          "fail - overflowing last block",
+			secretNumbers: "399150 474506 009147 088773 432160 282843 253738 655366",
+			expectedErr:   ErrSecretNumbersBlockOverflow,
+			expectedBlock: 7,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			entropy, err := DecodeSecretNumbers(tc.secretNumbers)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				var blockErr *SecretNumbersBlockError
+				if tc.expectedErr != ErrInvalidSecretNumbersLength {
+					require.ErrorAs(t, err, &blockErr)
+					require.Equal(t, tc.expectedBlock, blockErr.Block)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, strings.ToUpper(hex.EncodeToString(entropy)))
+		})
+	}
+}
+
+func TestValidateSecretNumbersBlock(t *testing.T) {
+	tt := []struct {
+		name        string
+		position    int
+		block       string
+		expectedErr error
+	}{
+		{
+			name:     "pass - first block",
+			position: 0,
+			block:    "399150",
+		},
+		{
+			name:     "pass - last block",
+			position: 7,
+			block:    "605430",
+		},
+		{
+			name:        "fail - block at another position",
+			position:    0,
+			block:       "474506",
+			expectedErr: ErrSecretNumbersChecksum,
+		},
+		{
+			name:        "fail - non digit",
+			position:    0,
+			block:       "39915a",
+			expectedErr: ErrInvalidSecretNumbersBlock,
+		},
+		{
+			name:        "fail - position out of range",
+			position:    8,
+			block:       "399150",
+			expectedErr: ErrInvalidSecretNumbersBlockPosition,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSecretNumbersBlock(tc.position, tc.block)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretNumbersBlockError(t *testing.T) {
+	err := &SecretNumbersBlockError{Block: 2, Value: "009148", Err: ErrSecretNumbersChecksum}
+	require.Equal(t, `secret numbers block 3 ("009148"): block checksum does not match`, err.Error())
+}
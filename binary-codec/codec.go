@@ -31,6 +31,10 @@ var (
 	ErrBatchFlagsNotUInt32 = errors.New("flags field must be a uint32")
 	// ErrBatchTxIDsLengthTooLong is returned when the 'txIDs' field is too long.
 	ErrBatchTxIDsLengthTooLong = errors.New("txIDs length exceeds maximum uint32 value")
+	// ErrUnsupportedFieldType is returned when a field has a serialized type the codec does not implement.
+	ErrUnsupportedFieldType = errors.New("unsupported field type")
+	// ErrNotAStruct is returned when EncodeStruct is given a value that is not a struct or a pointer to a struct.
+	ErrNotAStruct = errors.New("value must be a struct or a pointer to a struct")
 )
 
 const (
@@ -38,28 +42,49 @@ const (
 	paymentChannelClaimPrefix = "434C4D00"
 	txSigPrefix               = "53545800"
 	batchPrefix               = "42434800"
+	manifestPrefix            = "4D414E00"
+	validationPrefix          = "56414C00"
 )
 
-// Encode converts a JSON transaction object to a hex string in the canonical binary format.
-// The binary format is defined in XRPL's core codebase.
-func Encode(json map[string]any) (string, error) {
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
-
-	// Iterate over the keys in the provided JSON
-	for k := range json {
+// Codec encodes and decodes objects in the canonical binary format with a set of definitions.
+// The package-level functions use a Codec with the default definitions, embedded in the
+// definitions package. A Codec with other definitions, for example loaded from the
+// server_definitions method of a node running unreleased amendments, is created with NewCodec.
+type Codec struct {
+	definitions *definitions.Definitions
+}
 
-		// Get the FieldIdNameMap from the definitions package
-		fh := definitions.Get().Fields[k]
+// defaultCodec is the Codec used by the package-level functions.
+var defaultCodec = &Codec{}
 
-		// If the field is not found in the FieldIdNameMap, delete it from the JSON
+// NewCodec returns a new Codec that uses the given definitions.
+// If defs is nil, the default definitions are used.
+func NewCodec(defs *definitions.Definitions) *Codec {
+	return &Codec{definitions: defs}
+}
 
-		if fh == nil {
-			delete(json, k)
-			continue
-		}
+// Definitions returns the definitions used by the codec.
+func (c *Codec) Definitions() *definitions.Definitions {
+	if c.definitions == nil {
+		return definitions.Get()
 	}
+	return c.definitions
+}
+
+// Encode converts a JSON transaction object to a hex string in the canonical binary format.
+// The binary format is defined in XRPL's core codebase.
+// Fields that are not in the definitions are ignored. The JSON object is not modified.
+func Encode(json map[string]any) (string, error) {
+	return defaultCodec.Encode(json)
+}
 
-	b, err := st.FromJSON(json)
+// Encode converts a JSON transaction object to a hex string in the canonical binary format,
+// using the definitions of the codec.
+func (c *Codec) Encode(json map[string]any) (string, error) {
+	defs := c.Definitions()
+	st := types.NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(defs)), defs)
+
+	b, err := st.FromJSON(c.knownFields(json))
 	if err != nil {
 		return "", err
 	}
@@ -67,22 +92,46 @@ func Encode(json map[string]any) (string, error) {
 	return strings.ToUpper(hex.EncodeToString(b)), nil
 }
 
+// knownFields returns the fields of a JSON object that are in the definitions.
+// The object itself is returned if all of its fields are known, a copy otherwise.
+func (c *Codec) knownFields(json map[string]any) map[string]any {
+	defs := c.Definitions()
+	for k := range json {
+		if defs.Fields[k] != nil {
+			continue
+		}
+		known := make(map[string]any, len(json))
+		for k, v := range json {
+			if defs.Fields[k] != nil {
+				known[k] = v
+			}
+		}
+		return known
+	}
+	return json
+}
+
 // EncodeForMultisigning encodes a transaction into binary format in preparation for providing one
 // signature towards a multi-signed transaction.
-// Only encodes fields that are intended to be signed.
+// Only encodes fields that are intended to be signed. The JSON object is not modified.
 func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
-	st := &types.AccountID{}
-
-	// SigningPubKey is required for multi-signing but should be set to empty string.
+	return defaultCodec.EncodeForMultisigning(json, xrpAccountID)
+}
 
-	json["SigningPubKey"] = ""
+// EncodeForMultisigning encodes a transaction as EncodeForMultisigning, using the definitions of the codec.
+func (c *Codec) EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
+	st := &types.AccountID{}
 
 	suffix, err := st.FromJSON(xrpAccountID)
 	if err != nil {
 		return "", err
 	}
 
-	encoded, err := Encode(removeNonSigningFields(json))
+	signingFields := c.removeNonSigningFields(json)
+	// SigningPubKey is required for multi-signing but should be set to empty string.
+	signingFields["SigningPubKey"] = ""
+
+	encoded, err := c.Encode(signingFields)
 
 	if err != nil {
 		return "", err
@@ -92,9 +141,15 @@ func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, er
 }
 
 // EncodeForSigning encodes a transaction into binary format in preparation for signing.
+// The JSON object is not modified.
 func EncodeForSigning(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigning(json)
+}
+
+// EncodeForSigning encodes a transaction as EncodeForSigning, using the definitions of the codec.
+func (c *Codec) EncodeForSigning(json map[string]any) (string, error) {
 
-	encoded, err := Encode(removeNonSigningFields(json))
+	encoded, err := c.Encode(c.removeNonSigningFields(json))
 
 	if err != nil {
 		return "", err
@@ -186,27 +241,73 @@ func EncodeForSigningBatch(json map[string]any) (string, error) {
 	return strings.ToUpper(result), nil
 }
 
-// removeNonSigningFields removes the fields from a JSON transaction object that should not be signed.
-func removeNonSigningFields(json map[string]any) map[string]any {
-	for k := range json {
-		fi, _ := definitions.Get().GetFieldInstanceByFieldName(k)
+// EncodeForSigningManifest encodes a validator manifest into binary format in preparation for signing.
+// Both the master and the ephemeral keys sign the same data, which excludes the
+// Signature and MasterSignature fields.
+func EncodeForSigningManifest(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigningManifest(json)
+}
+
+// EncodeForSigningManifest encodes a validator manifest as EncodeForSigningManifest, using the definitions of the codec.
+func (c *Codec) EncodeForSigningManifest(json map[string]any) (string, error) {
+
+	encoded, err := c.Encode(c.removeNonSigningFields(json))
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(manifestPrefix + encoded), nil
+}
+
+// EncodeForSigningValidation encodes a ledger validation into binary format in preparation for signing.
+func EncodeForSigningValidation(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigningValidation(json)
+}
+
+// EncodeForSigningValidation encodes a ledger validation as EncodeForSigningValidation, using the definitions of the codec.
+func (c *Codec) EncodeForSigningValidation(json map[string]any) (string, error) {
+
+	encoded, err := c.Encode(c.removeNonSigningFields(json))
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(validationPrefix + encoded), nil
+}
+
+// removeNonSigningFields returns a copy of a JSON transaction object without the fields that should not be signed.
+func (c *Codec) removeNonSigningFields(json map[string]any) map[string]any {
+	defs := c.Definitions()
+	signingFields := make(map[string]any, len(json))
+	for k, v := range json {
+		fi, _ := defs.GetFieldInstanceByFieldName(k)
 
 		if fi != nil && !fi.IsSigningField {
-			delete(json, k)
+			continue
 		}
+		signingFields[k] = v
 	}
 
-	return json
+	return signingFields
 }
 
 // Decode decodes a hex string in the canonical binary format into a JSON transaction object.
 func Decode(hexEncoded string) (map[string]any, error) {
+	return defaultCodec.Decode(hexEncoded)
+}
+
+// Decode decodes a hex string in the canonical binary format into a JSON transaction object,
+// using the definitions of the codec.
+func (c *Codec) Decode(hexEncoded string) (map[string]any, error) {
 	b, err := hex.DecodeString(hexEncoded)
 	if err != nil {
 		return nil, err
 	}
-	p := serdes.NewBinaryParser(b, definitions.Get())
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+	defs := c.Definitions()
+	p := serdes.NewBinaryParser(b, defs)
+	st := types.NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(defs)), defs)
 	m, err := st.ToJSON(p)
 	if err != nil {
 		return nil, err
@@ -1,8 +1,12 @@
 package binarycodec
 
 import (
+	"encoding/json"
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -649,6 +653,66 @@ func TestEncodeForSigning(t *testing.T) {
 	}
 }
 
+func TestEncodeForSigningManifest(t *testing.T) {
+	tt := []struct {
+		description string
+		input       map[string]any
+		output      string
+		expectedErr error
+	}{
+		{
+			description: "serialize manifest for signing correctly",
+			input: map[string]any{
+				"Sequence":        uint32(1),
+				"PublicKey":       "ED264807102805220DA0F312E71FC2C69E1552C9C5790F6C25E3729DEB573D5860",
+				"SigningPubKey":   "0388935426E0D08083314842EDFBB2D517BD47699F9A4527318A8E10468C97C052",
+				"Domain":          "6578616D706C652E636F6D",
+				"Signature":       "30440220",
+				"MasterSignature": "ABCDEF",
+			},
+			output: "4D414E00" +
+				"2400000001" +
+				"7121ED264807102805220DA0F312E71FC2C69E1552C9C5790F6C25E3729DEB573D5860" +
+				"73210388935426E0D08083314842EDFBB2D517BD47699F9A4527318A8E10468C97C052" +
+				"770B6578616D706C652E636F6D",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := EncodeForSigningManifest(tc.input)
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				require.Empty(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, got)
+			}
+		})
+	}
+}
+
+func TestEncodeForSigningValidation(t *testing.T) {
+	got, err := EncodeForSigningValidation(map[string]any{
+		"Flags":          uint32(1),
+		"LedgerSequence": uint32(10),
+		"SigningTime":    uint32(770000000),
+		"LedgerHash":     "4B5E9C2B8FBB1C1B4F7D0D8E7E1F6D0C1A2B3C4D5E6F708192A3B4C5D6E7F809",
+		"SigningPubKey":  "0388935426E0D08083314842EDFBB2D517BD47699F9A4527318A8E10468C97C052",
+		"Signature":      "30440220",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "56414C00"+
+		"2200000001"+
+		"260000000A"+
+		"292DE54480"+
+		"514B5E9C2B8FBB1C1B4F7D0D8E7E1F6D0C1A2B3C4D5E6F708192A3B4C5D6E7F809"+
+		"73210388935426E0D08083314842EDFBB2D517BD47699F9A4527318A8E10468C97C052", got)
+}
+
 func TestEncodeForSigningBatch(t *testing.T) {
 	tt := []struct {
 		description string
@@ -755,3 +819,142 @@ func TestEncodeForSigningBatch(t *testing.T) {
 		})
 	}
 }
+
+// customDefinitions returns the default definitions with an extra UInt32 field, a transaction type
+// and the given fields.
+func customDefinitions(t *testing.T, fields ...definitions.FieldDefinition) *definitions.Definitions {
+	t.Helper()
+	b, err := os.ReadFile("definitions/definitions.json")
+	require.NoError(t, err)
+
+	var doc definitions.Document
+	require.NoError(t, json.Unmarshal(b, &doc))
+	doc.Fields = append(doc.Fields, definitions.FieldDefinition{
+		Name: "CustomField",
+		Info: definitions.FieldInfo{Nth: 99, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+	})
+	doc.Fields = append(doc.Fields, fields...)
+	doc.TransactionTypes["CustomTransaction"] = 250
+
+	defs, err := definitions.New(doc)
+	require.NoError(t, err)
+	return defs
+}
+
+func TestCodec(t *testing.T) {
+	tx := func() map[string]any {
+		return map[string]any{
+			"TransactionType": "CustomTransaction",
+			"CustomField":     uint32(1),
+			"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+			"TxnSignature":    "DEADBEEF",
+		}
+	}
+	c := NewCodec(customDefinitions(t))
+
+	t.Run("pass - encode and decode with custom definitions", func(t *testing.T) {
+		encoded, err := c.Encode(tx())
+		require.NoError(t, err)
+		require.Equal(t, "1200FA2063000000017404DEADBEEF8114DD76483FACDEE26E60D8A586BB58D09F27045C46", encoded)
+
+		decoded, err := c.Decode(encoded)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"TransactionType": "CustomTransaction",
+			"CustomField":     uint32(1),
+			"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+			"TxnSignature":    "DEADBEEF",
+		}, decoded)
+	})
+
+	t.Run("pass - encode for signing with custom definitions", func(t *testing.T) {
+		encoded, err := c.EncodeForSigning(tx())
+		require.NoError(t, err)
+		require.Equal(t, "535458001200FA2063000000018114DD76483FACDEE26E60D8A586BB58D09F27045C46", encoded)
+	})
+
+	t.Run("pass - nil definitions use the default definitions", func(t *testing.T) {
+		require.Same(t, definitions.Get(), NewCodec(nil).Definitions())
+	})
+
+	t.Run("fail - default codec does not know custom transaction types", func(t *testing.T) {
+		_, err := Encode(tx())
+		require.Error(t, err)
+
+		_, err = Decode("1200FA2063000000017404DEADBEEF8114DD76483FACDEE26E60D8A586BB58D09F27045C46")
+		require.Error(t, err)
+	})
+}
+
+func TestCodec_IntegerTypes(t *testing.T) {
+	field := func(name, typ string) definitions.FieldDefinition {
+		return definitions.FieldDefinition{
+			Name: name,
+			Info: definitions.FieldInfo{Nth: 1, IsSerialized: true, IsSigningField: true, Type: typ},
+		}
+	}
+	c := NewCodec(customDefinitions(t,
+		field("TestInt32", "Int32"),
+		field("TestInt64", "Int64"),
+		field("TestUInt96", "UInt96"),
+		field("TestUInt384", "UInt384"),
+		field("TestUInt512", "UInt512"),
+	))
+
+	tt := []struct {
+		description string
+		input       map[string]any
+		output      string
+		decoded     map[string]any
+	}{
+		{
+			description: "int32",
+			input:       map[string]any{"TestInt32": -2},
+			output:      "A1FFFFFFFE",
+			decoded:     map[string]any{"TestInt32": int32(-2)},
+		},
+		{
+			description: "int64 beyond float64 precision",
+			input:       map[string]any{"TestInt64": "-9007199254740993"},
+			output:      "B1FFDFFFFFFFFFFFFF",
+			decoded:     map[string]any{"TestInt64": "-9007199254740993"},
+		},
+		{
+			description: "uint96",
+			input:       map[string]any{"TestUInt96": "000102030405060708090A0B"},
+			output:      "0114000102030405060708090A0B",
+			decoded:     map[string]any{"TestUInt96": "000102030405060708090A0B"},
+		},
+		{
+			description: "all integer types in canonical order",
+			input: map[string]any{
+				"TestUInt512": strings.Repeat("CD", 64),
+				"TestUInt384": strings.Repeat("AB", 48),
+				"TestUInt96":  "000102030405060708090A0B",
+				"TestInt64":   "-9007199254740993",
+				"TestInt32":   -2,
+			},
+			output: "A1FFFFFFFE" + "B1FFDFFFFFFFFFFFFF" + "0114000102030405060708090A0B" +
+				"0116" + strings.Repeat("AB", 48) + "0117" + strings.Repeat("CD", 64),
+			decoded: map[string]any{
+				"TestUInt512": strings.Repeat("CD", 64),
+				"TestUInt384": strings.Repeat("AB", 48),
+				"TestUInt96":  "000102030405060708090A0B",
+				"TestInt64":   "-9007199254740993",
+				"TestInt32":   int32(-2),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			encoded, err := c.Encode(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, encoded)
+
+			decoded, err := c.Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.decoded, decoded)
+		})
+	}
+}
@@ -3,8 +3,6 @@ package definitions
 
 import (
 	_ "embed"
-
-	"github.com/ugorji/go/codec"
 )
 
 var (
@@ -27,103 +25,53 @@ type Definitions struct {
 	DelegatablePermissions map[string]int32
 }
 
-// Get returns the singleton instance of Definitions.
+// Get returns the singleton instance of Definitions, loaded from the definitions.json file embedded in the package.
+// Use New, Load or LoadFile to create other definitions, for example for a sidechain or an unreleased amendment.
 func Get() *Definitions {
 	return definitions
 }
 
-type definitionsDoc struct {
-	Types              map[string]int32 `json:"TYPES"`
-	LedgerEntryTypes   map[string]int32 `json:"LEDGER_ENTRY_TYPES"`
-	Fields             fieldInstanceMap `json:"FIELDS"`
-	TransactionResults map[string]int32 `json:"TRANSACTION_RESULTS"`
-	TransactionTypes   map[string]int32 `json:"TRANSACTION_TYPES"`
-}
-
 // Loads JSON from the definitions file and converts it to a preferred format.
 // The definitions file contains information required for the XRP Ledger's
 // canonical binary serialization format:
 // `Serialization <https://xrpl.org/serialization.html>`_
 func loadDefinitions() {
-
-	var jh codec.JsonHandle
-
-	jh.MapKeyAsString = true
-	jh.SignedInteger = true
-
-	dec := codec.NewDecoderBytes(docBytes, &jh)
-	var data definitionsDoc
-	dec.MustDecode(&data)
-
-	definitions = &Definitions{
-		Types:              data.Types,
-		Fields:             data.Fields,
-		LedgerEntryTypes:   data.LedgerEntryTypes,
-		TransactionResults: data.TransactionResults,
-		TransactionTypes:   data.TransactionTypes,
+	d, err := Load(docBytes)
+	if err != nil {
+		panic(err)
 	}
-
-	addFieldHeadersAndOrdinals()
-	createFieldIDNameMap()
-	initializePermissions()
+	definitions = d
 }
 
-func convertToFieldInstanceMap(m [][]interface{}) map[string]*FieldInstance {
-	nm := make(map[string]*FieldInstance, len(m))
-
-	for _, j := range m {
-		k := j[0].(string)
-		fi, _ := castFieldInfo(j[1])
-		nm[k] = &FieldInstance{
-			FieldName: k,
-			FieldInfo: &fi,
-			Ordinal:   fi.Nth,
+// addFieldHeadersAndOrdinals sets the field header and the ordinal of every field.
+func (d *Definitions) addFieldHeadersAndOrdinals() error {
+	for k, fi := range d.Fields {
+		t, err := d.GetTypeCodeByTypeName(fi.Type)
+		if err != nil {
+			return err
 		}
-	}
-	return nm
-}
-
-func castFieldInfo(v interface{}) (FieldInfo, error) {
-	if fi, ok := v.(map[string]interface{}); ok {
-		return FieldInfo{
-			// TODO: Check if this is still needed
-			//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-			Nth:            int32(fi["nth"].(int64)),
-			IsVLEncoded:    fi["isVLEncoded"].(bool),
-			IsSerialized:   fi["isSerialized"].(bool),
-			IsSigningField: fi["isSigningField"].(bool),
-			Type:           fi["type"].(string),
-		}, nil
-	}
-	return FieldInfo{}, ErrUnableToCastFieldInfo
-}
 
-func addFieldHeadersAndOrdinals() {
-	for k := range definitions.Fields {
-		t, _ := definitions.GetTypeCodeByTypeName(definitions.Fields[k].Type)
-
-		if fi, ok := definitions.Fields[k]; ok {
-			fi.FieldHeader = &FieldHeader{
-				TypeCode:  t,
-				FieldCode: definitions.Fields[k].Nth,
-			}
-			fi.Ordinal = (t<<16 | definitions.Fields[k].Nth)
+		fi.FieldHeader = &FieldHeader{
+			TypeCode:  t,
+			FieldCode: fi.Nth,
 		}
+		fi.Ordinal = (t<<16 | fi.Nth)
+		d.Fields[k] = fi
 	}
+	return nil
 }
 
-func createFieldIDNameMap() {
-	definitions.FieldIDNameMap = make(map[FieldHeader]string, len(definitions.Fields))
-	for k := range definitions.Fields {
-		fh, _ := definitions.GetFieldHeaderByFieldName(k)
-
-		definitions.FieldIDNameMap[*fh] = k
+// createFieldIDNameMap maps the field header of every field to its name.
+func (d *Definitions) createFieldIDNameMap() {
+	d.FieldIDNameMap = make(map[FieldHeader]string, len(d.Fields))
+	for k, fi := range d.Fields {
+		d.FieldIDNameMap[*fi.FieldHeader] = k
 	}
 }
 
 // Initializes granular permissions and delegatable permissions mappings for account permission delegation.
-func initializePermissions() {
-	definitions.GranularPermissions = map[string]int32{
+func (d *Definitions) initializePermissions() {
+	d.GranularPermissions = map[string]int32{
 		"TrustlineAuthorize":     65537,
 		"TrustlineFreeze":        65538,
 		"TrustlineUnfreeze":      65539,
@@ -138,13 +86,13 @@ func initializePermissions() {
 		"MPTokenIssuanceUnlock":  65548,
 	}
 
-	definitions.DelegatablePermissions = make(map[string]int32)
+	d.DelegatablePermissions = make(map[string]int32)
 
-	for name, value := range definitions.GranularPermissions {
-		definitions.DelegatablePermissions[name] = value
+	for name, value := range d.GranularPermissions {
+		d.DelegatablePermissions[name] = value
 	}
 
-	for txType, value := range definitions.TransactionTypes {
-		definitions.DelegatablePermissions[txType] = value + 1
+	for txType, value := range d.TransactionTypes {
+		d.DelegatablePermissions[txType] = value + 1
 	}
 }
@@ -10,6 +10,10 @@ var (
 
 	// ErrUnableToCastFieldInfo is returned when the field info cannot be cast.
 	ErrUnableToCastFieldInfo = errors.New("unable to cast to field info")
+	// ErrInvalidFieldDefinition is returned when a field definition is not a [name, info] pair.
+	ErrInvalidFieldDefinition = errors.New("field definition must be a [name, info] pair")
+	// ErrEmptyDefinitions is returned when definitions have no types or no fields.
+	ErrEmptyDefinitions = errors.New("definitions must have types and fields")
 )
 
 // Dynamic errors
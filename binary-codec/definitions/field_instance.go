@@ -1,7 +1,5 @@
 package definitions
 
-import "github.com/ugorji/go/codec"
-
 // FieldInstance is a struct that represents a field instance.
 type FieldInstance struct {
 	FieldName string
@@ -12,11 +10,11 @@ type FieldInstance struct {
 
 // FieldInfo is a struct that represents the field info.
 type FieldInfo struct {
-	Nth            int32
-	IsVLEncoded    bool
-	IsSerialized   bool
-	IsSigningField bool
-	Type           string
+	Nth            int32  `json:"nth"`
+	IsVLEncoded    bool   `json:"isVLEncoded"`
+	IsSerialized   bool   `json:"isSerialized"`
+	IsSigningField bool   `json:"isSigningField"`
+	Type           string `json:"type"`
 }
 
 // FieldHeader is a struct that represents the field header.
@@ -33,15 +31,5 @@ func (d *Definitions) CreateFieldHeader(tc, fc int32) FieldHeader {
 	}
 }
 
+// fieldInstanceMap maps the names of the fields to their instances.
 type fieldInstanceMap map[string]*FieldInstance
-
-// CodecEncodeSelf implements the codec.SelfEncoder interface.
-func (fi *fieldInstanceMap) CodecEncodeSelf(_ *codec.Encoder) {}
-
-// CodecDecodeSelf implements the codec.SelfDecoder interface.
-func (fi *fieldInstanceMap) CodecDecodeSelf(d *codec.Decoder) {
-	var x [][]interface{}
-	d.MustDecode(&x)
-	y := convertToFieldInstanceMap(x)
-	*fi = y
-}
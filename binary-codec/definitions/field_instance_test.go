@@ -13,7 +13,3 @@ func TestDefinitions_CreateFieldHeader(t *testing.T) {
 		FieldCode: 2,
 	}, Get().CreateFieldHeader(1, 2))
 }
-
-func TestFieldInstanceMap_CodecDecodeSelf(t *testing.T) {
-	loadDefinitions()
-}
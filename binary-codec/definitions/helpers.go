@@ -72,7 +72,7 @@ func (d *Definitions) GetFieldHeaderByFieldName(n string) (*FieldHeader, error)
 // GetFieldNameByFieldHeader returns the field name associated with the given field header struct.
 func (d *Definitions) GetFieldNameByFieldHeader(fh FieldHeader) (string, error) {
 
-	fim, ok := definitions.FieldIDNameMap[fh]
+	fim, ok := d.FieldIDNameMap[fh]
 
 	if !ok {
 		return "", &NotFoundErrorFieldHeader{
@@ -0,0 +1,95 @@
+package definitions
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Document is the JSON document of the binary serialization definitions, as found in the
+// definitions.json file and returned by the server_definitions method of rippled and clio.
+type Document struct {
+	Types              map[string]int32  `json:"TYPES"`
+	LedgerEntryTypes   map[string]int32  `json:"LEDGER_ENTRY_TYPES"`
+	Fields             []FieldDefinition `json:"FIELDS"`
+	TransactionResults map[string]int32  `json:"TRANSACTION_RESULTS"`
+	TransactionTypes   map[string]int32  `json:"TRANSACTION_TYPES"`
+}
+
+// FieldDefinition is a field of a Document. It is encoded in JSON as a [name, info] pair.
+type FieldDefinition struct {
+	Name string
+	Info FieldInfo
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f FieldDefinition) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{f.Name, f.Info})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (f *FieldDefinition) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return ErrInvalidFieldDefinition
+	}
+	if err := json.Unmarshal(pair[0], &f.Name); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &f.Info)
+}
+
+// New creates Definitions from a definitions Document.
+// It returns an error if the document has no types or fields, or if a field has an unknown type.
+func New(doc Document) (*Definitions, error) {
+	if len(doc.Types) == 0 || len(doc.Fields) == 0 {
+		return nil, ErrEmptyDefinitions
+	}
+
+	fields := make(fieldInstanceMap, len(doc.Fields))
+	for _, f := range doc.Fields {
+		info := f.Info
+		fields[f.Name] = &FieldInstance{
+			FieldName: f.Name,
+			FieldInfo: &info,
+			Ordinal:   info.Nth,
+		}
+	}
+
+	d := &Definitions{
+		Types:              doc.Types,
+		Fields:             fields,
+		LedgerEntryTypes:   doc.LedgerEntryTypes,
+		TransactionResults: doc.TransactionResults,
+		TransactionTypes:   doc.TransactionTypes,
+	}
+
+	if err := d.addFieldHeadersAndOrdinals(); err != nil {
+		return nil, err
+	}
+	d.createFieldIDNameMap()
+	d.initializePermissions()
+
+	return d, nil
+}
+
+// Load creates Definitions from a JSON definitions document, such as the definitions.json file of
+// xrpl.js and xrpl-py, or the result of the server_definitions method.
+func Load(data []byte) (*Definitions, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return New(doc)
+}
+
+// LoadFile creates Definitions from a JSON definitions file. See Load.
+func LoadFile(path string) (*Definitions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
@@ -0,0 +1,136 @@
+package definitions
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// customDocument returns the embedded definitions document with an extra field and transaction type.
+func customDocument(t *testing.T) Document {
+	t.Helper()
+	var doc Document
+	require.NoError(t, json.Unmarshal(docBytes, &doc))
+	doc.Fields = append(doc.Fields, FieldDefinition{
+		Name: "CustomField",
+		Info: FieldInfo{Nth: 99, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+	})
+	doc.TransactionTypes["CustomTransaction"] = 250
+	return doc
+}
+
+func TestFieldDefinition_JSON(t *testing.T) {
+	testcases := []struct {
+		name        string
+		input       string
+		expected    FieldDefinition
+		expectedErr error
+	}{
+		{
+			name:  "pass - field definition",
+			input: `["Sequence",{"isSerialized":true,"isSigningField":true,"isVLEncoded":false,"nth":4,"type":"UInt32"}]`,
+			expected: FieldDefinition{
+				Name: "Sequence",
+				Info: FieldInfo{Nth: 4, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+			},
+		},
+		{
+			name:        "fail - not a pair",
+			input:       `["Sequence"]`,
+			expectedErr: ErrInvalidFieldDefinition,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var f FieldDefinition
+			err := json.Unmarshal([]byte(tc.input), &f)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f)
+
+			b, err := json.Marshal(f)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.input, string(b))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("pass - embedded definitions", func(t *testing.T) {
+		d, err := Load(docBytes)
+		require.NoError(t, err)
+		require.Equal(t, Get().Types, d.Types)
+		require.Equal(t, Get().TransactionTypes, d.TransactionTypes)
+		require.Equal(t, Get().FieldIDNameMap, d.FieldIDNameMap)
+		require.Equal(t, Get().DelegatablePermissions, d.DelegatablePermissions)
+		require.Equal(t, len(Get().Fields), len(d.Fields))
+		for name, fi := range Get().Fields {
+			require.Equal(t, fi, d.Fields[name], name)
+		}
+	})
+
+	t.Run("pass - custom definitions", func(t *testing.T) {
+		b, err := json.Marshal(customDocument(t))
+		require.NoError(t, err)
+
+		d, err := Load(b)
+		require.NoError(t, err)
+
+		fi, err := d.GetFieldInstanceByFieldName("CustomField")
+		require.NoError(t, err)
+		require.Equal(t, &FieldHeader{TypeCode: 2, FieldCode: 99}, fi.FieldHeader)
+		require.Equal(t, int32(2<<16|99), fi.Ordinal)
+
+		name, err := d.GetFieldNameByFieldHeader(FieldHeader{TypeCode: 2, FieldCode: 99})
+		require.NoError(t, err)
+		require.Equal(t, "CustomField", name)
+
+		tc, err := d.GetTransactionTypeCodeByTransactionTypeName("CustomTransaction")
+		require.NoError(t, err)
+		require.Equal(t, int32(250), tc)
+		require.Equal(t, int32(251), d.DelegatablePermissions["CustomTransaction"])
+
+		// The default definitions are left untouched.
+		_, err = Get().GetFieldInstanceByFieldName("CustomField")
+		require.Error(t, err)
+	})
+
+	t.Run("fail - invalid json", func(t *testing.T) {
+		_, err := Load([]byte(`{"TYPES":`))
+		require.Error(t, err)
+	})
+
+	t.Run("fail - empty definitions", func(t *testing.T) {
+		_, err := Load([]byte(`{}`))
+		require.ErrorIs(t, err, ErrEmptyDefinitions)
+	})
+
+	t.Run("fail - unknown field type", func(t *testing.T) {
+		doc := customDocument(t)
+		doc.Fields = append(doc.Fields, FieldDefinition{
+			Name: "BadField",
+			Info: FieldInfo{Nth: 1, IsSerialized: true, Type: "UInt1024"},
+		})
+		_, err := New(doc)
+		require.Equal(t, &NotFoundError{Instance: "TypeName", Input: "UInt1024"}, err)
+	})
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "definitions.json")
+	require.NoError(t, os.WriteFile(path, docBytes, 0o600))
+
+	d, err := LoadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, Get().TransactionTypes, d.TransactionTypes)
+
+	_, err = LoadFile(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
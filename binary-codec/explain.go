@@ -0,0 +1,196 @@
+package binarycodec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types"
+)
+
+// ExplainedField is a field of an object in the canonical binary format, annotated with its wire layout.
+type ExplainedField struct {
+	// Offset is the position of the field header in the blob.
+	Offset int `json:"offset"`
+	// Name is the name of the field.
+	Name string `json:"name"`
+	// Type is the name of the serialized type of the field.
+	Type string `json:"type"`
+	// TypeCode and FieldCode are the field header.
+	TypeCode  int32 `json:"type_code"`
+	FieldCode int32 `json:"field_code"`
+	// Header is the hex encoded field header, of 1 to 3 bytes.
+	Header string `json:"header"`
+	// VLLength is the length read from the length prefix of variable length fields, nil otherwise.
+	VLLength *int `json:"vl_length,omitempty"`
+	// Raw is the hex encoded value of the field, without its header and length prefix.
+	// It is empty for STObject and STArray fields, whose content is in Fields.
+	Raw string `json:"raw,omitempty"`
+	// Value is the decoded value of the field, as returned by Decode.
+	Value any `json:"value,omitempty"`
+	// Fields are the nested fields of STObject and STArray fields, end markers included.
+	Fields []ExplainedField `json:"fields,omitempty"`
+}
+
+// Explanation is the annotated wire layout of an object in the canonical binary format.
+type Explanation struct {
+	// Length is the length of the blob in bytes.
+	Length int `json:"length"`
+	// Fields are the top level fields of the object.
+	Fields []ExplainedField `json:"fields"`
+}
+
+// Explain decodes a hex string in the canonical binary format as Decode, but returns the wire layout of
+// every field: its offset, header, length prefix, raw bytes and decoded value, nested for STObject and
+// STArray fields. If the blob cannot be fully decoded, the fields decoded up to the error are returned
+// along with the error, which usually points right at the faulty bytes.
+func Explain(hexEncoded string) (*Explanation, error) {
+	return defaultCodec.Explain(hexEncoded)
+}
+
+// Explain returns the wire layout of a hex string in the canonical binary format, as Explain,
+// using the definitions of the codec.
+func (c *Codec) Explain(hexEncoded string) (*Explanation, error) {
+	b, err := hex.DecodeString(hexEncoded)
+	if err != nil {
+		return nil, err
+	}
+	e := &explainer{
+		data:        b,
+		parser:      serdes.NewBinaryParser(b, c.Definitions()),
+		definitions: c.Definitions(),
+	}
+	fields, err := e.explainObject()
+	return &Explanation{Length: len(b), Fields: fields}, err
+}
+
+// explainer walks a blob and annotates its fields.
+type explainer struct {
+	data        []byte
+	parser      *serdes.BinaryParser
+	definitions *definitions.Definitions
+}
+
+// explainObject explains fields until the end of the data or an object or array end marker, which is included.
+func (e *explainer) explainObject() ([]ExplainedField, error) {
+	var fields []ExplainedField
+	for e.parser.HasMore() {
+		f, err := e.explainField()
+		if f != nil {
+			fields = append(fields, *f)
+		}
+		if err != nil {
+			return fields, err
+		}
+		if isEndMarker(f.Name) {
+			break
+		}
+	}
+	return fields, nil
+}
+
+// explainField explains the next field. The field is returned along with the error if its header was read.
+func (e *explainer) explainField() (*ExplainedField, error) {
+	start := e.parser.Offset()
+	fi, err := e.parser.ReadField()
+	if err != nil {
+		return nil, err
+	}
+	f := &ExplainedField{
+		Offset:    start,
+		Name:      fi.FieldName,
+		Type:      fi.Type,
+		TypeCode:  fi.FieldHeader.TypeCode,
+		FieldCode: fi.FieldHeader.FieldCode,
+		Header:    e.hex(start, e.parser.Offset()),
+	}
+
+	switch {
+	case isEndMarker(fi.FieldName):
+		return f, nil
+	case fi.Type == "STObject" || fi.Type == "STArray":
+		// Array elements are STObject fields, so both are explained as a list of fields up to their end marker.
+		f.Fields, err = e.explainObject()
+		return f, err
+	}
+
+	var opts []int
+	if fi.IsVLEncoded {
+		vl, err := e.parser.ReadVariableLength()
+		if err != nil {
+			return f, err
+		}
+		f.VLLength = &vl
+		opts = append(opts, vl)
+	}
+
+	st := types.GetSerializedTypeWithDefinitions(fi.Type, e.definitions)
+	if st == nil {
+		return f, ErrUnsupportedFieldType
+	}
+	valueStart := e.parser.Offset()
+	value, err := st.ToJSON(e.parser, opts...)
+	f.Raw = e.hex(valueStart, e.parser.Offset())
+	if err != nil {
+		return f, err
+	}
+	f.Value, err = types.EnumToString(e.definitions, fi.FieldName, value)
+	return f, err
+}
+
+// hex returns the upper case hex encoding of the data between two offsets.
+func (e *explainer) hex(from, to int) string {
+	return strings.ToUpper(hex.EncodeToString(e.data[from:to]))
+}
+
+// isEndMarker reports whether a field is an object or array end marker.
+func isEndMarker(fieldName string) bool {
+	return fieldName == "ObjectEndMarker" || fieldName == "ArrayEndMarker"
+}
+
+// String renders the explanation as a text tree, with one field per line:
+//
+//	0000 TransactionType (UInt16 1:2) header=12 raw=0007 value="OfferCreate"
+//
+// The offset comes first, then the field name, its type and header codes, the hex header,
+// the length prefix of variable length fields, the raw bytes and the decoded value.
+func (e *Explanation) String() string {
+	var sb strings.Builder
+	writeExplainedFields(&sb, e.Fields, "", true)
+	return sb.String()
+}
+
+// writeExplainedFields writes fields as lines of a text tree, nested fields being indented under their parent.
+func writeExplainedFields(sb *strings.Builder, fields []ExplainedField, indent string, top bool) {
+	for i, f := range fields {
+		branch, next := "├─ ", "│  "
+		if i == len(fields)-1 {
+			branch, next = "└─ ", "   "
+		}
+		if top {
+			branch, next = "", ""
+		}
+
+		fmt.Fprintf(sb, "%04X %s%s%s (%s %d:%d)", f.Offset, indent, branch, f.Name, f.Type, f.TypeCode, f.FieldCode)
+		fmt.Fprintf(sb, " header=%s", f.Header)
+		if f.VLLength != nil {
+			fmt.Fprintf(sb, " vl=%d", *f.VLLength)
+		}
+		if f.Raw != "" {
+			fmt.Fprintf(sb, " raw=%s", f.Raw)
+		}
+		if f.Value != nil {
+			v, err := json.Marshal(f.Value)
+			if err != nil {
+				v = []byte(fmt.Sprint(f.Value))
+			}
+			fmt.Fprintf(sb, " value=%s", v)
+		}
+		sb.WriteByte('\n')
+
+		writeExplainedFields(sb, f.Fields, indent+next, false)
+	}
+}
@@ -0,0 +1,123 @@
+package binarycodec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/stretchr/testify/require"
+)
+
+const explainTx = "12000024000000016140000000000003E873008114DD76483FACDEE26E60D8A586BB58D09F27045C46F9EA7C02ABCD7D0101E1F1"
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestExplain(t *testing.T) {
+	memoFields := []ExplainedField{
+		{Offset: 43, Name: "MemoType", Type: "Blob", TypeCode: 7, FieldCode: 12, Header: "7C", VLLength: intPtr(2), Raw: "ABCD", Value: "ABCD"},
+		{Offset: 47, Name: "MemoData", Type: "Blob", TypeCode: 7, FieldCode: 13, Header: "7D", VLLength: intPtr(1), Raw: "01", Value: "01"},
+		{Offset: 50, Name: "ObjectEndMarker", Type: "STObject", TypeCode: 14, FieldCode: 1, Header: "E1"},
+	}
+	fields := []ExplainedField{
+		{Offset: 0, Name: "TransactionType", Type: "UInt16", TypeCode: 1, FieldCode: 2, Header: "12", Raw: "0000", Value: "Payment"},
+		{Offset: 3, Name: "Sequence", Type: "UInt32", TypeCode: 2, FieldCode: 4, Header: "24", Raw: "00000001", Value: uint32(1)},
+		{Offset: 8, Name: "Amount", Type: "Amount", TypeCode: 6, FieldCode: 1, Header: "61", Raw: "40000000000003E8", Value: "1000"},
+		{Offset: 17, Name: "SigningPubKey", Type: "Blob", TypeCode: 7, FieldCode: 3, Header: "73", VLLength: intPtr(0), Value: ""},
+		{Offset: 19, Name: "Account", Type: "AccountID", TypeCode: 8, FieldCode: 1, Header: "81", VLLength: intPtr(20), Raw: "DD76483FACDEE26E60D8A586BB58D09F27045C46", Value: "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys"},
+		{Offset: 41, Name: "Memos", Type: "STArray", TypeCode: 15, FieldCode: 9, Header: "F9", Fields: []ExplainedField{
+			{Offset: 42, Name: "Memo", Type: "STObject", TypeCode: 14, FieldCode: 10, Header: "EA", Fields: memoFields},
+			{Offset: 51, Name: "ArrayEndMarker", Type: "STArray", TypeCode: 15, FieldCode: 1, Header: "F1"},
+		}},
+	}
+
+	tt := []struct {
+		description string
+		input       string
+		expected    *Explanation
+		expectedErr error
+	}{
+		{
+			description: "pass - transaction with nested fields",
+			input:       explainTx,
+			expected:    &Explanation{Length: 52, Fields: fields},
+		},
+		{
+			description: "fail - truncated blob returns the fields decoded so far",
+			input:       explainTx[:len(explainTx)-6],
+			expected: &Explanation{Length: 49, Fields: append(fields[:5:5], ExplainedField{
+				Offset: 41, Name: "Memos", Type: "STArray", TypeCode: 15, FieldCode: 9, Header: "F9", Fields: []ExplainedField{
+					{Offset: 42, Name: "Memo", Type: "STObject", TypeCode: 14, FieldCode: 10, Header: "EA", Fields: []ExplainedField{
+						memoFields[0],
+						{Offset: 47, Name: "MemoData", Type: "Blob", TypeCode: 7, FieldCode: 13, Header: "7D", VLLength: intPtr(1)},
+					}},
+				},
+			})},
+			expectedErr: serdes.ErrParserOutOfBound,
+		},
+		{
+			description: "fail - invalid hex",
+			input:       "ZZ",
+			expectedErr: hex.InvalidByteError('Z'),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := Explain(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestExplanation_String(t *testing.T) {
+	e, err := Explain(explainTx)
+	require.NoError(t, err)
+
+	expected := `0000 TransactionType (UInt16 1:2) header=12 raw=0000 value="Payment"
+0003 Sequence (UInt32 2:4) header=24 raw=00000001 value=1
+0008 Amount (Amount 6:1) header=61 raw=40000000000003E8 value="1000"
+0011 SigningPubKey (Blob 7:3) header=73 vl=0 value=""
+0013 Account (AccountID 8:1) header=81 vl=20 raw=DD76483FACDEE26E60D8A586BB58D09F27045C46 value="rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys"
+0029 Memos (STArray 15:9) header=F9
+002A ├─ Memo (STObject 14:10) header=EA
+002B │  ├─ MemoType (Blob 7:12) header=7C vl=2 raw=ABCD value="ABCD"
+002F │  ├─ MemoData (Blob 7:13) header=7D vl=1 raw=01 value="01"
+0032 │  └─ ObjectEndMarker (STObject 14:1) header=E1
+0033 └─ ArrayEndMarker (STArray 15:1) header=F1
+`
+	require.Equal(t, expected, e.String())
+}
+
+func TestExplanation_JSON(t *testing.T) {
+	e, err := Explain("1200002400000001")
+	require.NoError(t, err)
+
+	b, err := json.Marshal(e)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"length": 8,
+		"fields": [
+			{"offset": 0, "name": "TransactionType", "type": "UInt16", "type_code": 1, "field_code": 2, "header": "12", "raw": "0000", "value": "Payment"},
+			{"offset": 3, "name": "Sequence", "type": "UInt32", "type_code": 2, "field_code": 4, "header": "24", "raw": "00000001", "value": 1}
+		]
+	}`, string(b))
+}
+
+func TestCodec_Explain(t *testing.T) {
+	c := NewCodec(customDefinitions(t))
+
+	e, err := c.Explain("1200FA206300000001")
+	require.NoError(t, err)
+	require.Equal(t, &Explanation{Length: 9, Fields: []ExplainedField{
+		{Offset: 0, Name: "TransactionType", Type: "UInt16", TypeCode: 1, FieldCode: 2, Header: "12", Raw: "00FA", Value: "CustomTransaction"},
+		{Offset: 3, Name: "CustomField", Type: "UInt32", TypeCode: 2, FieldCode: 99, Header: "2063", Raw: "00000001", Value: uint32(1)},
+	}}, e)
+}
@@ -22,6 +22,7 @@ var (
 // BinaryParser parses binary-encoded XRPL data into field instances based on definitions.
 type BinaryParser struct {
 	data        []byte
+	size        int
 	definitions interfaces.Definitions
 }
 
@@ -29,10 +30,16 @@ type BinaryParser struct {
 func NewBinaryParser(d []byte, definitions interfaces.Definitions) *BinaryParser {
 	return &BinaryParser{
 		data:        d,
+		size:        len(d),
 		definitions: definitions,
 	}
 }
 
+// Offset returns the number of bytes read so far, which is the position of the next byte in the data.
+func (p *BinaryParser) Offset() int {
+	return p.size - len(p.data)
+}
+
 // ReadField reads the next field in the data.
 // It reads the field's header, fetches the field's name based on its header,
 // and then gets the FieldInstance for that field name.
@@ -176,6 +176,27 @@ func TestBinaryParser_HasMore(t *testing.T) {
 	}
 }
 
+func TestBinaryParser_Offset(t *testing.T) {
+	p := NewBinaryParser([]byte{0x12, 0x00, 0x07, 0xC1, 0x01}, definitions.Get())
+	require.Equal(t, 0, p.Offset())
+
+	_, err := p.ReadField()
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Offset())
+
+	_, err = p.ReadBytes(2)
+	require.NoError(t, err)
+	require.Equal(t, 3, p.Offset())
+
+	_, err = p.ReadVariableLength()
+	require.NoError(t, err)
+	require.Equal(t, 5, p.Offset())
+
+	_, err = p.ReadByte()
+	require.ErrorIs(t, err, ErrParserOutOfBound)
+	require.Equal(t, 5, p.Offset())
+}
+
 func TestBinaryParser_Peek(t *testing.T) {
 	testcases := []struct {
 		name        string
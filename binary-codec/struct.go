@@ -0,0 +1,430 @@
+package binarycodec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types"
+)
+
+// structField is a field of a struct that maps to a serialized field.
+type structField struct {
+	index    []int
+	instance *definitions.FieldInstance
+	// always is set for numeric and boolean fields without omitempty, which are encoded even if zero.
+	always bool
+}
+
+// structPlanKey identifies the fields of a struct type for a set of definitions.
+type structPlanKey struct {
+	t           reflect.Type
+	definitions *definitions.Definitions
+}
+
+// structPlans caches the serialized fields of struct types, sorted in canonical order.
+var structPlans sync.Map
+
+// flattener is implemented by values that convert themselves to their JSON form, such as currency amounts.
+type flattener interface {
+	Flatten() any
+}
+
+// structOverride is a field value given in JSON form that replaces or adds a field of a struct.
+type structOverride struct {
+	instance *definitions.FieldInstance
+	value    any
+}
+
+// EncodeStruct encodes a struct, such as a typed transaction, into the canonical binary format without
+// building an intermediate map. Fields are named after their json tag or, if it has no name, after the
+// Go field, and embedded structs are flattened. As with omitempty, nil pointers and zero values are
+// omitted, except for numeric and boolean fields whose json tag has no omitempty option, like required
+// fields where zero is a valid value. Fields that are not in the definitions are ignored.
+//
+// Values are encoded from their JSON form: values with a Flatten() any method, like currency amounts,
+// are flattened, strings are used as is, and nested structs are encoded as STObject fields. Slices of
+// structs are encoded as STArray fields, each element holding the wrapper object of the array.
+//
+// fields, which may be nil, replaces or adds top level fields, given in JSON form as with Encode. A nil
+// value removes the field. Neither v nor fields are modified.
+func EncodeStruct(v any, fields map[string]any) ([]byte, error) {
+	return defaultCodec.EncodeStruct(v, fields)
+}
+
+// EncodeStructForSigning encodes a struct as EncodeStruct, keeping only the signing fields, and prefixes
+// it as EncodeForSigning does.
+func EncodeStructForSigning(v any, fields map[string]any) ([]byte, error) {
+	return defaultCodec.EncodeStructForSigning(v, fields)
+}
+
+// EncodeStruct encodes a struct as EncodeStruct, using the definitions of the codec.
+func (c *Codec) EncodeStruct(v any, fields map[string]any) ([]byte, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return c.encodeStruct(rv, fields, false)
+}
+
+// EncodeStructForSigning encodes a struct as EncodeStructForSigning, using the definitions of the codec.
+func (c *Codec) EncodeStructForSigning(v any, fields map[string]any) ([]byte, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.encodeStruct(rv, fields, true)
+	if err != nil {
+		return nil, err
+	}
+	prefix, _ := hex.DecodeString(txSigPrefix)
+	return append(prefix, b...), nil
+}
+
+// structValue returns the struct v points to.
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}, ErrNotAStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, ErrNotAStruct
+	}
+	return rv, nil
+}
+
+// encodeStruct encodes the fields of a struct value, merged with the overriding fields, in canonical order.
+func (c *Codec) encodeStruct(v reflect.Value, fields map[string]any, signingOnly bool) ([]byte, error) {
+	defs := c.Definitions()
+	plan := c.structPlan(v.Type())
+
+	overrides := make([]structOverride, 0, len(fields))
+	for name, value := range fields {
+		fi, err := defs.GetFieldInstanceByFieldName(name)
+		if err != nil {
+			continue
+		}
+		overrides = append(overrides, structOverride{instance: fi, value: value})
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].instance.Ordinal < overrides[j].instance.Ordinal
+	})
+
+	bs := serdes.NewBinarySerializer(serdes.NewFieldIDCodec(defs))
+	i, j := 0, 0
+	for i < len(plan) || j < len(overrides) {
+		if j < len(overrides) && (i == len(plan) || overrides[j].instance.Ordinal <= plan[i].instance.Ordinal) {
+			o := overrides[j]
+			j++
+			if i < len(plan) && plan[i].instance.Ordinal == o.instance.Ordinal {
+				i++
+			}
+			if o.value == nil || !o.instance.IsSerialized || (signingOnly && !o.instance.IsSigningField) {
+				continue
+			}
+			b, err := c.encodeJSONValue(o.value, o.instance)
+			if err != nil {
+				return nil, err
+			}
+			if err := bs.WriteFieldAndValue(*o.instance, b); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		f := plan[i]
+		i++
+		if signingOnly && !f.instance.IsSigningField {
+			continue
+		}
+		fv, ok := presentValue(v.FieldByIndex(f.index))
+		if !ok && !f.always {
+			continue
+		}
+		b, err := c.encodeStructValue(fv, f.instance)
+		if err != nil {
+			return nil, err
+		}
+		if err := bs.WriteFieldAndValue(*f.instance, b); err != nil {
+			return nil, err
+		}
+	}
+	return bs.GetSink(), nil
+}
+
+// encodeStructValue encodes the value of a struct field.
+func (c *Codec) encodeStructValue(v reflect.Value, fi *definitions.FieldInstance) ([]byte, error) {
+	defs := c.Definitions()
+
+	switch fi.Type {
+	case "STObject":
+		if v.Kind() == reflect.Struct {
+			return c.encodeStruct(v, nil, false)
+		}
+	case "STArray":
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			var sink []byte
+			for k := 0; k < v.Len(); k++ {
+				elem, ok := presentValue(v.Index(k))
+				if !ok {
+					continue
+				}
+				var b []byte
+				var err error
+				if elem.Kind() == reflect.Struct {
+					b, err = c.encodeStruct(elem, nil, false)
+				} else {
+					b, err = types.NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(defs)), defs).FromJSON(jsonValue(defs, elem, "STObject"))
+				}
+				if err != nil {
+					return nil, err
+				}
+				sink = append(sink, b...)
+			}
+			return append(sink, types.ArrayEndMarker), nil
+		}
+	}
+
+	return c.encodeJSONValue(jsonValue(defs, v, fi.Type), fi)
+}
+
+// encodeJSONValue encodes the JSON form of the value of a field with its serialized type.
+func (c *Codec) encodeJSONValue(value any, fi *definitions.FieldInstance) ([]byte, error) {
+	defs := c.Definitions()
+	st := types.GetSerializedTypeWithDefinitions(fi.Type, defs)
+	if st == nil {
+		return nil, ErrUnsupportedFieldType
+	}
+	value, err := types.ParseSpecialField(defs, fi.FieldName, value)
+	if err != nil {
+		return nil, err
+	}
+	return st.FromJSON(value)
+}
+
+// structPlan returns the serialized fields of a struct type, sorted in canonical order.
+func (c *Codec) structPlan(t reflect.Type) []structField {
+	key := structPlanKey{t: t, definitions: c.Definitions()}
+	if p, ok := structPlans.Load(key); ok {
+		return p.([]structField)
+	}
+
+	var fields []structField
+	collectStructFields(c.Definitions(), t, nil, make(map[string]bool), &fields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].instance.Ordinal < fields[j].instance.Ordinal
+	})
+
+	p, _ := structPlans.LoadOrStore(key, fields)
+	return p.([]structField)
+}
+
+// collectStructFields collects the serialized fields of a struct type. Fields of embedded structs are
+// collected after the fields of the struct, so the fields of the struct take precedence.
+func collectStructFields(defs *definitions.Definitions, t reflect.Type, index []int, seen map[string]bool, fields *[]structField) {
+	var embedded []int
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, omitEmpty, skip := jsonFieldName(sf)
+		if skip {
+			continue
+		}
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, i)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		fi, err := defs.GetFieldInstanceByFieldName(name)
+		if err != nil || !fi.IsSerialized {
+			continue
+		}
+		*fields = append(*fields, structField{
+			index:    append(append([]int(nil), index...), i),
+			instance: fi,
+			always:   !omitEmpty && isScalarKind(sf.Type.Kind()),
+		})
+	}
+	for _, i := range embedded {
+		collectStructFields(defs, t.Field(i).Type, append(append([]int(nil), index...), i), seen, fields)
+	}
+}
+
+// isScalarKind reports whether a kind is numeric or boolean.
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonFieldName returns the name and the omitempty option of the json tag of a struct field,
+// and whether the field is skipped.
+func jsonFieldName(sf reflect.StructField) (string, bool, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	return name, strings.Contains(opts, "omitempty"), false
+}
+
+// presentValue dereferences pointers and interfaces, and reports whether the value is present:
+// nil pointers, interfaces and empty values are not, while pointers to empty values are.
+func presentValue(v reflect.Value) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		return v.Elem(), true
+	case reflect.Slice, reflect.Map:
+		return v, v.Len() > 0
+	default:
+		return v, !v.IsZero()
+	}
+}
+
+// jsonValue converts a value to the JSON form expected by the serialized type typ, as produced by the
+// Flatten methods of the xrpl package. Nested fields are converted with the type of their definition.
+func jsonValue(defs *definitions.Definitions, v reflect.Value, typ string) any {
+	if v.CanInterface() {
+		if f, ok := v.Interface().(flattener); ok {
+			return f.Flatten()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return jsonValue(defs, v.Elem(), typ)
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedJSONValue(v.Uint(), typ)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch typ {
+		case "UInt32":
+			//nolint:gosec // G115: the serialized type checks the range.
+			return uint32(v.Int())
+		case "Int32", "Int64":
+			return v.Int()
+		default:
+			return int(v.Int())
+		}
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return strings.ToUpper(hex.EncodeToString(b))
+		}
+		if v.Type().Elem().Kind() == reflect.String {
+			s := make([]string, v.Len())
+			for i := range s {
+				s[i] = v.Index(i).String()
+			}
+			return s
+		}
+		s := make([]any, v.Len())
+		for i := range s {
+			s[i] = jsonValue(defs, v.Index(i), "")
+		}
+		return s
+	case reflect.Map:
+		if m, ok := v.Interface().(map[string]any); ok {
+			return m
+		}
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[iter.Key().String()] = jsonValue(defs, iter.Value(), fieldType(defs, iter.Key().String()))
+		}
+		return m
+	case reflect.Struct:
+		m := make(map[string]any, v.NumField())
+		structJSONValue(defs, v, m)
+		return m
+	default:
+		return v.Interface()
+	}
+}
+
+// unsignedJSONValue converts an unsigned integer to the JSON form expected by the serialized type typ.
+func unsignedJSONValue(u uint64, typ string) any {
+	switch typ {
+	case "UInt32":
+		//nolint:gosec // G115: the serialized type checks the range.
+		return uint32(u)
+	case "UInt64":
+		return fmt.Sprintf("%016X", u)
+	case "Int32", "Int64":
+		return u
+	default:
+		//nolint:gosec // G115: values are at most 64-bit wide.
+		return int(u)
+	}
+}
+
+// structJSONValue adds the fields of a struct to m, as encoding/json would, embedded structs included.
+func structJSONValue(defs *definitions.Definitions, v reflect.Value, m map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, omitEmpty, skip := jsonFieldName(sf)
+		if skip {
+			continue
+		}
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			structJSONValue(defs, v.Field(i), m)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fv, ok := presentValue(v.Field(i))
+		if !ok && (omitEmpty || fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface) {
+			continue
+		}
+		m[name] = jsonValue(defs, fv, fieldType(defs, name))
+	}
+}
+
+// fieldType returns the serialized type of a field, or an empty type if it is not in the definitions.
+func fieldType(defs *definitions.Definitions, name string) string {
+	fi, err := defs.GetFieldInstanceByFieldName(name)
+	if err != nil {
+		return ""
+	}
+	return fi.Type
+}
@@ -0,0 +1,53 @@
+package binarycodec
+
+import "testing"
+
+// nolint
+func BenchmarkEncodeStruct(b *testing.B) {
+	tx := &testPayment{
+		testBaseTx: testBaseTx{
+			Account:         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+			TransactionType: "Payment",
+			Fee:             "10",
+			Sequence:        1752792,
+			Memos: []testMemoWrapper{
+				{Memo: testMemo{MemoType: "687474703A2F2F6578616D706C652E636F6D", MemoData: "72656E74"}},
+			},
+			SigningPubKey: "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+		},
+		Amount:      testAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "7072.8"},
+		Destination: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+	}
+	flatten := func() map[string]any {
+		return map[string]any{
+			"Account":         tx.Account,
+			"TransactionType": tx.TransactionType,
+			"Fee":             tx.Fee,
+			"Sequence":        tx.Sequence,
+			"Memos": []any{
+				map[string]any{"Memo": map[string]any{"MemoType": tx.Memos[0].Memo.MemoType, "MemoData": tx.Memos[0].Memo.MemoData}},
+			},
+			"SigningPubKey": tx.SigningPubKey,
+			"Amount":        tx.Amount.Flatten(),
+			"Destination":   tx.Destination,
+		}
+	}
+
+	b.Run("Encode flattened struct", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := Encode(flatten()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("EncodeStruct", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := EncodeStruct(tx, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
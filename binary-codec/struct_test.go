@@ -0,0 +1,351 @@
+package binarycodec
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAmount struct {
+	Currency string `json:"currency"`
+	Issuer   string `json:"issuer"`
+	Value    string `json:"value"`
+}
+
+func (a testAmount) Flatten() any {
+	return map[string]any{"currency": a.Currency, "issuer": a.Issuer, "value": a.Value}
+}
+
+type testPathStep struct {
+	Account  string `json:"account,omitempty"`
+	Currency string `json:"currency,omitempty"`
+	Issuer   string `json:"issuer,omitempty"`
+}
+
+type testMemo struct {
+	MemoData string `json:",omitempty"`
+	MemoType string `json:",omitempty"`
+}
+
+type testMemoWrapper struct {
+	Memo testMemo
+}
+
+type testBaseTx struct {
+	Account         string
+	TransactionType string
+	Fee             string            `json:",omitempty"`
+	Sequence        uint32            `json:",omitempty"`
+	Flags           uint32            `json:",omitempty"`
+	Memos           []testMemoWrapper `json:",omitempty"`
+	SigningPubKey   string            `json:",omitempty"`
+	TxnSignature    string            `json:",omitempty"`
+}
+
+type testPayment struct {
+	testBaseTx
+	Amount         testAmount
+	Destination    string
+	DestinationTag *uint32          `json:",omitempty"`
+	Paths          [][]testPathStep `json:",omitempty"`
+	Ignored        string           `json:"-"`
+	NotAField      string           `json:",omitempty"`
+}
+
+type testNFTokenMint struct {
+	testBaseTx
+	NFTokenTaxon uint32
+	TransferFee  *uint16  `json:",omitempty"`
+	Hashes       []string `json:"Amendments,omitempty"`
+}
+
+type testPriceData struct {
+	BaseAsset  string
+	QuoteAsset string
+	AssetPrice uint64 `json:",omitempty"`
+	Scale      uint8  `json:",omitempty"`
+}
+
+type testPriceDataWrapper struct {
+	PriceData testPriceData
+}
+
+type testOracleSet struct {
+	testBaseTx
+	OracleDocumentID uint32
+	LastUpdatedTime  uint32
+	PriceDataSeries  []testPriceDataWrapper `json:",omitempty"`
+}
+
+type testOracleSetMap struct {
+	testBaseTx
+	OracleDocumentID uint32
+	LastUpdatedTime  uint32
+	PriceDataSeries  []map[string]testPriceData `json:",omitempty"`
+}
+
+func TestEncodeStruct(t *testing.T) {
+	zero := uint32(0)
+	transferFee := uint16(500)
+	base := testBaseTx{
+		Account:         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		TransactionType: "Payment",
+		Fee:             "10",
+		Sequence:        1752792,
+		Memos: []testMemoWrapper{
+			{Memo: testMemo{MemoType: "687474703A2F2F6578616D706C652E636F6D", MemoData: "72656E74"}},
+		},
+		SigningPubKey: "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+	}
+	baseJSON := map[string]any{
+		"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		"TransactionType": "Payment",
+		"Fee":             "10",
+		"Sequence":        uint32(1752792),
+		"Memos": []any{
+			map[string]any{"Memo": map[string]any{"MemoType": "687474703A2F2F6578616D706C652E636F6D", "MemoData": "72656E74"}},
+		},
+		"SigningPubKey": "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+	}
+	withBase := func(fields map[string]any) map[string]any {
+		m := make(map[string]any, len(baseJSON)+len(fields))
+		for k, v := range baseJSON {
+			m[k] = v
+		}
+		for k, v := range fields {
+			m[k] = v
+		}
+		return m
+	}
+	usd := testAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "7072.8"}
+	oracleBase := testBaseTx{
+		Account:         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		TransactionType: "OracleSet",
+	}
+	priceData := testPriceData{BaseAsset: "XRP", QuoteAsset: "USD", AssetPrice: 740, Scale: 3}
+	oracleJSON := map[string]any{
+		"Account":          "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		"TransactionType":  "OracleSet",
+		"OracleDocumentID": uint32(1),
+		"LastUpdatedTime":  uint32(743609014),
+		"PriceDataSeries": []any{
+			map[string]any{"PriceData": map[string]any{
+				"BaseAsset":  "XRP",
+				"QuoteAsset": "USD",
+				"AssetPrice": "00000000000002E4",
+				"Scale":      3,
+			}},
+		},
+	}
+
+	tt := []struct {
+		description string
+		input       any
+		fields      map[string]any
+		expected    map[string]any
+		expectedErr error
+	}{
+		{
+			description: "pass - payment with paths and pointer to zero",
+			input: &testPayment{
+				testBaseTx:     base,
+				Amount:         usd,
+				Destination:    "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+				DestinationTag: &zero,
+				Paths: [][]testPathStep{
+					{{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}},
+					{{Account: "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys"}},
+				},
+				Ignored:   "ignored",
+				NotAField: "ignored",
+			},
+			expected: withBase(map[string]any{
+				"Amount":         usd.Flatten(),
+				"Destination":    "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+				"DestinationTag": uint32(0),
+				"Paths": []any{
+					[]any{map[string]any{"currency": "USD", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}},
+					[]any{map[string]any{"account": "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys"}},
+				},
+			}),
+		},
+		{
+			description: "pass - struct value",
+			input: testPayment{
+				testBaseTx:  base,
+				Amount:      usd,
+				Destination: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+			},
+			expected: withBase(map[string]any{
+				"Amount":      usd.Flatten(),
+				"Destination": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+			}),
+		},
+		{
+			description: "pass - fields override, add and remove top level fields",
+			input: &testPayment{
+				testBaseTx:  base,
+				Amount:      usd,
+				Destination: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+			},
+			fields: map[string]any{
+				"TransactionType": "Payment",
+				"Fee":             "12",
+				"TxnSignature":    "ABCD",
+				"Memos":           nil,
+				"UnknownField":    "ignored",
+			},
+			expected: map[string]any{
+				"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+				"TransactionType": "Payment",
+				"Fee":             "12",
+				"Sequence":        uint32(1752792),
+				"SigningPubKey":   "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+				"TxnSignature":    "ABCD",
+				"Amount":          usd.Flatten(),
+				"Destination":     "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+			},
+		},
+		{
+			description: "pass - zero numeric field without omitempty and uint16 pointer",
+			input: &testNFTokenMint{
+				testBaseTx: testBaseTx{
+					Account:         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+					TransactionType: "NFTokenMint",
+				},
+				TransferFee: &transferFee,
+				Hashes:      []string{"73734B611DDA23D3F5F62E20A173B78AB8406AC5015094DA53F53D39B9EDB06C"},
+			},
+			expected: map[string]any{
+				"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+				"TransactionType": "NFTokenMint",
+				"NFTokenTaxon":    uint32(0),
+				"TransferFee":     500,
+				"Amendments":      []string{"73734B611DDA23D3F5F62E20A173B78AB8406AC5015094DA53F53D39B9EDB06C"},
+			},
+		},
+		{
+			description: "pass - nested UInt64 field in an array of structs",
+			input: &testOracleSet{
+				testBaseTx:       oracleBase,
+				OracleDocumentID: 1,
+				LastUpdatedTime:  743609014,
+				PriceDataSeries:  []testPriceDataWrapper{{PriceData: priceData}},
+			},
+			expected: oracleJSON,
+		},
+		{
+			description: "pass - nested UInt64 field in an array of maps",
+			input: &testOracleSetMap{
+				testBaseTx:       oracleBase,
+				OracleDocumentID: 1,
+				LastUpdatedTime:  743609014,
+				PriceDataSeries:  []map[string]testPriceData{{"PriceData": priceData}},
+			},
+			expected: oracleJSON,
+		},
+		{
+			description: "fail - not a struct",
+			input:       map[string]any{"Account": "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys"},
+			expectedErr: ErrNotAStruct,
+		},
+		{
+			description: "fail - nil pointer",
+			input:       (*testPayment)(nil),
+			expectedErr: ErrNotAStruct,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			b, err := EncodeStruct(tc.input, tc.fields)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			expected, err := Encode(tc.expected)
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+		})
+	}
+}
+
+func TestEncodeStructForSigning(t *testing.T) {
+	tx := &testPayment{
+		testBaseTx: testBaseTx{
+			Account:         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+			TransactionType: "Payment",
+			Fee:             "10",
+			Sequence:        1752792,
+			SigningPubKey:   "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+			TxnSignature:    "ABCD",
+		},
+		Amount:      testAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "1"},
+		Destination: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+	}
+
+	b, err := EncodeStructForSigning(tx, map[string]any{"Signers": []any{}})
+	require.NoError(t, err)
+
+	expected, err := EncodeForSigning(map[string]any{
+		"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		"TransactionType": "Payment",
+		"Fee":             "10",
+		"Sequence":        uint32(1752792),
+		"SigningPubKey":   "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+		"TxnSignature":    "ABCD",
+		"Amount":          tx.Amount.Flatten(),
+		"Destination":     "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+}
+
+func TestEncode_DoesNotModifyInput(t *testing.T) {
+	input := func() map[string]any {
+		return map[string]any{
+			"Account":         "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+			"TransactionType": "Payment",
+			"Fee":             "10",
+			"Sequence":        uint32(1752792),
+			"Amount":          "1000",
+			"Destination":     "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+			"SigningPubKey":   "03EE83BB432547885C219634A1BC407A9DB0474145D69737D09CCDC63E1DEE7FE3",
+			"TxnSignature":    "ABCD",
+			"UnknownField":    "kept",
+		}
+	}
+
+	tt := []struct {
+		description string
+		encode      func(map[string]any) (string, error)
+	}{
+		{
+			description: "pass - Encode",
+			encode:      Encode,
+		},
+		{
+			description: "pass - EncodeForSigning",
+			encode:      EncodeForSigning,
+		},
+		{
+			description: "pass - EncodeForMultisigning",
+			encode: func(json map[string]any) (string, error) {
+				return EncodeForMultisigning(json, "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			json := input()
+			_, err := tc.encode(json)
+			require.NoError(t, err)
+			require.Equal(t, input(), json)
+		})
+	}
+}
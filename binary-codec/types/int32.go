@@ -0,0 +1,40 @@
+//revive:disable:var-naming
+package types
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+)
+
+// Int32 represents a 32-bit signed integer.
+type Int32 struct{}
+
+// FromJSON converts a JSON value into a serialized byte slice representing a 32-bit signed integer,
+// in two's complement big-endian order. The input value is a Go integer, an integral float64 or
+// json.Number, or a decimal string. If the value does not fit in 32 bits, an error is returned.
+func (i *Int32) FromJSON(value any) ([]byte, error) {
+	v, err := toInt64(value)
+	if err != nil {
+		return nil, err
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return nil, ErrSignedIntegerOutOfRange
+	}
+	b := make([]byte, 4)
+	//nolint:gosec // G115: two's complement conversion is intended.
+	binary.BigEndian.PutUint32(b, uint32(int32(v)))
+	return b, nil
+}
+
+// ToJSON takes a BinaryParser and optional parameters, and converts the serialized byte data
+// back into a JSON integer value. If the parsing fails, an error is returned.
+func (i *Int32) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	b, err := p.ReadBytes(4)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:gosec // G115: two's complement conversion is intended.
+	return int32(binary.BigEndian.Uint32(b)), nil
+}
@@ -0,0 +1,105 @@
+//revive:disable:var-naming
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+)
+
+var (
+	// ErrInvalidSignedInteger is returned when a value is not a valid signed integer.
+	ErrInvalidSignedInteger = errors.New("invalid signed integer, value should be an integer or a decimal string")
+	// ErrSignedIntegerOutOfRange is returned when a signed integer does not fit in the serialized type.
+	ErrSignedIntegerOutOfRange = errors.New("signed integer out of range")
+)
+
+// maxSafeFloatInteger is the largest integer a float64 represents exactly, as JSON numbers decoded into any.
+const maxSafeFloatInteger = 1<<53 - 1
+
+// Int64 represents a 64-bit signed integer.
+type Int64 struct{}
+
+// FromJSON converts a JSON value into a serialized byte slice representing a 64-bit signed integer,
+// in two's complement big-endian order. The input value is a Go integer, an integral float64 or
+// json.Number, or a decimal string, which is the only representation of values beyond 2^53.
+// If the serialization fails, an error is returned.
+func (i *Int64) FromJSON(value any) ([]byte, error) {
+	v, err := toInt64(value)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8)
+	//nolint:gosec // G115: two's complement conversion is intended.
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b, nil
+}
+
+// ToJSON takes a BinaryParser and optional parameters, and converts the serialized byte data
+// back into a JSON decimal string, as xrpl.js does, since JSON numbers cannot represent every
+// 64-bit integer. If the parsing fails, an error is returned.
+func (i *Int64) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	b, err := p.ReadBytes(8)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:gosec // G115: two's complement conversion is intended.
+	return strconv.FormatInt(int64(binary.BigEndian.Uint64(b)), 10), nil
+}
+
+// toInt64 converts the JSON representations of a signed integer to an int64.
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, ErrSignedIntegerOutOfRange
+		}
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, ErrInvalidSignedInteger
+		}
+		if math.Abs(v) > maxSafeFloatInteger {
+			return 0, ErrSignedIntegerOutOfRange
+		}
+		return int64(v), nil
+	case json.Number:
+		return parseInt64(string(v))
+	case string:
+		return parseInt64(v)
+	default:
+		return 0, ErrInvalidSignedInteger
+	}
+}
+
+// parseInt64 parses a decimal string into an int64.
+func parseInt64(s string) (int64, error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrSignedIntegerOutOfRange
+	}
+	if err != nil {
+		return 0, ErrInvalidSignedInteger
+	}
+	return v, nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/testutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64_FromJson(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       any
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:     "pass - positive int",
+			input:    1,
+			expected: []byte{0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "pass - negative int",
+			input:    -1,
+			expected: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:     "pass - max int64 decimal string",
+			input:    "9223372036854775807",
+			expected: []byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:     "pass - min int64 decimal string",
+			input:    "-9223372036854775808",
+			expected: []byte{0x80, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "pass - max safe float64",
+			input:    float64(9007199254740991),
+			expected: []byte{0, 0x1F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:     "pass - json.Number",
+			input:    json.Number("-256"),
+			expected: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00},
+		},
+		{
+			name:        "fail - decimal string out of range",
+			input:       "9223372036854775808",
+			expectedErr: ErrSignedIntegerOutOfRange,
+		},
+		{
+			name:        "fail - uint64 out of range",
+			input:       uint64(9223372036854775808),
+			expectedErr: ErrSignedIntegerOutOfRange,
+		},
+		{
+			name:        "fail - unsafe float64",
+			input:       float64(9007199254740993),
+			expectedErr: ErrSignedIntegerOutOfRange,
+		},
+		{
+			name:        "fail - invalid string",
+			input:       "1e3",
+			expectedErr: ErrInvalidSignedInteger,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int64{}
+			actual, err := class.FromJSON(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestInt64_ToJson(t *testing.T) {
+	defs := definitions.Get()
+
+	tt := []struct {
+		name        string
+		malleate    func(t *testing.T) interfaces.BinaryParser
+		expected    any
+		expectedErr error
+	}{
+		{
+			name: "fail - binary parser has no data",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				parserMock := testutil.NewMockBinaryParser(gomock.NewController(t))
+				parserMock.EXPECT().ReadBytes(gomock.Any()).Return([]byte{}, errors.New("binary parser has no data"))
+				return parserMock
+			},
+			expectedErr: errors.New("binary parser has no data"),
+		},
+		{
+			name: "pass - max int64",
+			malleate: func(_ *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, defs)
+			},
+			expected: "9223372036854775807",
+		},
+		{
+			name: "pass - negative",
+			malleate: func(_ *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00}, defs)
+			},
+			expected: "-256",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int64{}
+			actual, err := class.ToJSON(tc.malleate(t))
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
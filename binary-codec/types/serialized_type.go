@@ -23,15 +23,33 @@ type SerializedType interface {
 // the appropriate methods of that type to be called.
 // If the input string does not match a known type, the function returns nil.
 func GetSerializedType(t string) SerializedType {
+	return GetSerializedTypeWithDefinitions(t, nil)
+}
+
+// GetSerializedTypeWithDefinitions returns the SerializedType instance described by the
+// string parameter, as GetSerializedType, using the given definitions to resolve field
+// names and enumerated values instead of the default ones. If defs is nil, the default
+// definitions are used.
+func GetSerializedTypeWithDefinitions(t string, defs *definitions.Definitions) SerializedType {
 	switch t {
 	case "UInt8":
-		return &UInt8{}
+		return &UInt8{definitions: defs}
 	case "UInt16":
-		return &UInt16{}
+		return &UInt16{definitions: defs}
 	case "UInt32":
 		return &UInt32{}
 	case "UInt64":
 		return &UInt64{}
+	case "UInt96":
+		return NewUInt96()
+	case "UInt384":
+		return NewUInt384()
+	case "UInt512":
+		return NewUInt512()
+	case "Int32":
+		return &Int32{}
+	case "Int64":
+		return &Int64{}
 	case "Hash128":
 		return NewHash128()
 	case "Hash160":
@@ -49,9 +67,9 @@ func GetSerializedType(t string) SerializedType {
 	case "Blob":
 		return &Blob{}
 	case "STObject":
-		return NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		return NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitionsOrDefault(defs))), defs)
 	case "STArray":
-		return &STArray{}
+		return &STArray{definitions: defs}
 	case "PathSet":
 		return &PathSet{}
 	case "XChainBridge":
@@ -63,3 +81,11 @@ func GetSerializedType(t string) SerializedType {
 	}
 	return nil
 }
+
+// definitionsOrDefault returns d, or the default definitions if d is nil.
+func definitionsOrDefault(d *definitions.Definitions) *definitions.Definitions {
+	if d == nil {
+		return definitions.Get()
+	}
+	return d
+}
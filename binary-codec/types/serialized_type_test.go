@@ -34,6 +34,31 @@ func TestGetSerializedType(t *testing.T) {
 			input:    "UInt64",
 			expected: &UInt64{},
 		},
+		{
+			name:     "pass - uint96",
+			input:    "UInt96",
+			expected: NewUInt96(),
+		},
+		{
+			name:     "pass - uint384",
+			input:    "UInt384",
+			expected: NewUInt384(),
+		},
+		{
+			name:     "pass - uint512",
+			input:    "UInt512",
+			expected: NewUInt512(),
+		},
+		{
+			name:     "pass - int32",
+			input:    "Int32",
+			expected: &Int32{},
+		},
+		{
+			name:     "pass - int64",
+			input:    "Int64",
+			expected: &Int64{},
+		},
 		{
 			name:     "pass - hash128",
 			input:    "Hash128",
@@ -17,7 +17,9 @@ const (
 )
 
 // STArray represents an array of STObject instances.
-type STArray struct{}
+type STArray struct {
+	definitions *definitions.Definitions
+}
 
 // ErrNotSTObjectInSTArray is returned when a non-STObject value is found in an STArray.
 var ErrNotSTObjectInSTArray = errors.New("not STObject in STArray. Array fields must be STObjects")
@@ -40,9 +42,10 @@ func (t *STArray) FromJSON(json any) ([]byte, error) {
 		return nil, ErrNotSTObjectInSTArray
 	}
 
+	defs := definitionsOrDefault(t.definitions)
 	var sink []byte
 	for _, v := range json.([]any) {
-		st := NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		st := NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(defs)), defs)
 		b, err := st.FromJSON(v)
 		if err != nil {
 			return nil, err
@@ -59,6 +62,7 @@ func (t *STArray) FromJSON(json any) ([]byte, error) {
 // The method loops until the BinaryParser has no more data, and for each loop,
 // it calls the ToJSON method of an STObject, appending the resulting JSON value to a "value" slice.
 func (t *STArray) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	defs := definitionsOrDefault(t.definitions)
 	var value []any
 	count := 0
 
@@ -75,7 +79,7 @@ func (t *STArray) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			break
 		}
 		fn := fi.FieldName
-		st := GetSerializedType(fi.Type)
+		st := GetSerializedTypeWithDefinitions(fi.Type, defs)
 		res, err := st.ToJSON(p)
 		if err != nil {
 			return nil, err
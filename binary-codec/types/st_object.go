@@ -13,6 +13,7 @@ import (
 // and complex structures of the Ripple protocol.
 type STObject struct {
 	binarySerializer interfaces.BinarySerializer
+	definitions      *definitions.Definitions
 }
 
 // NewSTObject returns a new STObject with the given binary serializer.
@@ -20,6 +21,13 @@ func NewSTObject(bs interfaces.BinarySerializer) *STObject {
 	return &STObject{binarySerializer: bs}
 }
 
+// NewSTObjectWithDefinitions returns a new STObject with the given binary serializer, which resolves
+// field names and enumerated values with the given definitions instead of the default ones.
+// The binary serializer is expected to use the same definitions.
+func NewSTObjectWithDefinitions(bs interfaces.BinarySerializer, defs *definitions.Definitions) *STObject {
+	return &STObject{binarySerializer: bs, definitions: defs}
+}
+
 // FromJSON converts a JSON object into a serialized byte slice.
 // It works by converting the JSON object into a map of field instances (which include the field definition
 // and value), and then serializing each field instance.
@@ -28,7 +36,8 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 	if _, ok := json.(map[string]any); !ok {
 		return nil, errNotValidJSON
 	}
-	fimap, err := createFieldInstanceMapFromJson(json.(map[string]any))
+	defs := definitionsOrDefault(t.definitions)
+	fimap, err := createFieldInstanceMapFromJson(defs, json.(map[string]any))
 
 	if err != nil {
 		return nil, err
@@ -41,7 +50,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 			continue
 		}
 
-		st := GetSerializedType(v.Type)
+		st := GetSerializedTypeWithDefinitions(v.Type, defs)
 		b, err := st.FromJSON(fimap[v])
 		if err != nil {
 			return nil, err
@@ -58,6 +67,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 // back to a JSON value. It will continue parsing until it encounters an end marker for an object
 // or an array, or until the parser has no more data.
 func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	defs := definitionsOrDefault(t.definitions)
 	m := make(map[string]any)
 
 	for p.HasMore() {
@@ -71,7 +81,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			break
 		}
 
-		st := GetSerializedType(fi.Type)
+		st := GetSerializedTypeWithDefinitions(fi.Type, defs)
 
 		var res any
 		if fi.IsVLEncoded {
@@ -90,7 +100,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 				return nil, err
 			}
 		}
-		res, err = enumToStr(fi.FieldName, res)
+		res, err = enumToStr(defs, fi.FieldName, res)
 		if err != nil {
 			return nil, err
 		}
@@ -107,17 +117,17 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 // Special handling for PermissionValue fields: converts string permission names to numeric values.
 //
 //lint:ignore U1000 // ignore this for now
-func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldInstance]any, error) {
+func createFieldInstanceMapFromJson(defs *definitions.Definitions, json map[string]any) (map[definitions.FieldInstance]any, error) {
 	m := make(map[definitions.FieldInstance]any, len(json))
 
 	for k, v := range json {
-		fi, err := definitions.Get().GetFieldInstanceByFieldName(k)
+		fi, err := defs.GetFieldInstanceByFieldName(k)
 
 		if err != nil {
 			return nil, err
 		}
 
-		v, err = parseSpecialFields(k, v)
+		v, err = parseSpecialFields(defs, k, v)
 		if err != nil {
 			return nil, err
 		}
@@ -127,11 +137,18 @@ func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldI
 	return m, nil
 }
 
+// ParseSpecialField converts the value of a field given by name, as STObject does before encoding it.
+// For example, the name of a PermissionValue is converted to its numeric value. Other values are returned as is.
+// If defs is nil, the default definitions are used.
+func ParseSpecialField(defs *definitions.Definitions, fieldName string, value any) (any, error) {
+	return parseSpecialFields(definitionsOrDefault(defs), fieldName, value)
+}
+
 // parseSpecialFields is a helper function that handles special fields that need type parsing.
-func parseSpecialFields(k string, v any) (any, error) {
+func parseSpecialFields(defs *definitions.Definitions, k string, v any) (any, error) {
 	if k == "PermissionValue" {
 		if strValue, ok := v.(string); ok {
-			permissionValue, err := definitions.Get().GetDelegatablePermissionValueByName(strValue)
+			permissionValue, err := defs.GetDelegatablePermissionValueByName(strValue)
 			if err != nil {
 				return nil, err
 			}
@@ -163,28 +180,35 @@ func getSortedKeys(m map[definitions.FieldInstance]any) []definitions.FieldInsta
 	return keys
 }
 
+// EnumToString returns the name of the value of an enumerated field (TransactionType, TransactionResult,
+// LedgerEntryType or PermissionValue) as decoded by STObject, or the value itself for other fields.
+// If defs is nil, the default definitions are used.
+func EnumToString(defs *definitions.Definitions, fieldName string, value any) (any, error) {
+	return enumToStr(definitionsOrDefault(defs), fieldName, value)
+}
+
 // enumToStr is a helper function that takes a field name and its associated value,
 // and returns a string representation of the value if the field is an enumerated type
 // (i.e., TransactionType, TransactionResult, LedgerEntryType, PermissionValue).
 // If the field is not an enumerated type, the original value is returned.
-func enumToStr(fieldName string, value any) (any, error) {
+func enumToStr(defs *definitions.Definitions, fieldName string, value any) (any, error) {
 	switch fieldName {
 	case "TransactionType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
+		return defs.GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
 	case "TransactionResult":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
+		return defs.GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
 	case "LedgerEntryType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
+		return defs.GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
 	case "PermissionValue":
 		// Convert permission value to permission name if available, otherwise return numeric value
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		if name, err := definitions.Get().GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
+		if name, err := defs.GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
 			return name, nil
 		}
 		return value, nil
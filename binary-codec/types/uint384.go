@@ -0,0 +1,12 @@
+//revive:disable:var-naming
+package types
+
+// UInt384 struct represents a 384-bit unsigned integer, encoded in JSON as a hex string like hashes.
+type UInt384 struct {
+	hashI
+}
+
+// NewUInt384 is a constructor for creating a new 384-bit unsigned integer.
+func NewUInt384() *UInt384 {
+	return &UInt384{newHash(48)}
+}
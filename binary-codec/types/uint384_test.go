@@ -0,0 +1,12 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUInt384(t *testing.T) {
+	u := NewUInt384()
+	require.Equal(t, 48, u.getLength())
+}
@@ -0,0 +1,12 @@
+//revive:disable:var-naming
+package types
+
+// UInt512 struct represents a 512-bit unsigned integer, encoded in JSON as a hex string like hashes.
+type UInt512 struct {
+	hashI
+}
+
+// NewUInt512 is a constructor for creating a new 512-bit unsigned integer.
+func NewUInt512() *UInt512 {
+	return &UInt512{newHash(64)}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUInt512(t *testing.T) {
+	u := NewUInt512()
+	require.Equal(t, 64, u.getLength())
+}
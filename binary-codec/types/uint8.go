@@ -10,14 +10,16 @@ import (
 )
 
 // UInt8 represents an 8-bit unsigned integer.
-type UInt8 struct{}
+type UInt8 struct {
+	definitions *definitions.Definitions
+}
 
 // FromJSON converts a JSON value into a serialized byte slice representing an 8-bit unsigned integer.
 // If the input value is a string, it's assumed to be a transaction result name, and the method will
 // attempt to convert it into a transaction result type code. If the conversion fails, an error is returned.
 func (u *UInt8) FromJSON(value any) ([]byte, error) {
 	if s, ok := value.(string); ok {
-		tc, err := definitions.Get().GetTransactionResultTypeCodeByTransactionResultName(s)
+		tc, err := definitionsOrDefault(u.definitions).GetTransactionResultTypeCodeByTransactionResultName(s)
 		if err != nil {
 			return nil, err
 		}
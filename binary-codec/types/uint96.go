@@ -0,0 +1,12 @@
+//revive:disable:var-naming
+package types
+
+// UInt96 struct represents a 96-bit unsigned integer, encoded in JSON as a hex string like hashes.
+type UInt96 struct {
+	hashI
+}
+
+// NewUInt96 is a constructor for creating a new 96-bit unsigned integer.
+func NewUInt96() *UInt96 {
+	return &UInt96{newHash(12)}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUInt96(t *testing.T) {
+	u := NewUInt96()
+	require.Equal(t, 12, u.getLength())
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+)
+
+// xAddressOutput is the output of the classic-to-x command.
+type xAddressOutput struct {
+	XAddress string `json:"x_address"`
+}
+
+// classicAddressOutput is the output of the x-to-classic command.
+type classicAddressOutput struct {
+	ClassicAddress string  `json:"classic_address"`
+	Tag            *uint32 `json:"tag,omitempty"`
+	Testnet        bool    `json:"testnet"`
+}
+
+func runClassicToX(e *env, args []string) error {
+	fs := newFlagSet(e, "address classic-to-x")
+	tag := fs.Uint("tag", 0, "destination tag to encode in the X-address")
+	testnet := fs.Bool("testnet", false, "encode a test network X-address")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	address, err := readValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	hasTag := false
+	fs.Visit(func(f *flag.Flag) {
+		hasTag = hasTag || f.Name == "tag"
+	})
+	if *tag > math.MaxUint32 {
+		return fmt.Errorf("%w: tag out of range", errUsage)
+	}
+	x, err := addresscodec.ClassicAddressToXAddress(address, uint32(*tag), hasTag, *testnet)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, xAddressOutput{XAddress: x})
+}
+
+func runXToClassic(e *env, args []string) error {
+	fs := newFlagSet(e, "address x-to-classic")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	x, err := readValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	classic, tag, testnet, err := addresscodec.XAddressToClassicAddress(x)
+	if err != nil {
+		return err
+	}
+	out := classicAddressOutput{ClassicAddress: classic, Testnet: testnet}
+	if hasXAddressTag(x) {
+		out.Tag = &tag
+	}
+	return writeJSON(e, out)
+}
+
+// hasXAddressTag reports whether a valid X-address has a tag, which is the flag byte after its account ID.
+func hasXAddressTag(x string) bool {
+	b := addresscodec.DecodeBase58(x)
+	return len(b) > 22 && b[22] == 1
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddress_XToClassic(t *testing.T) {
+	tt := []struct {
+		description string
+		xAddress    string
+		expected    map[string]any
+	}{
+		{
+			description: "pass - mainnet without tag",
+			xAddress:    "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ",
+			expected:    map[string]any{"classic_address": "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", "testnet": false},
+		},
+		{
+			description: "pass - testnet with tag",
+			xAddress:    "T719a5UwUCnEs54UsxG9CJYYDhwmFCvzHM39KcuJw6gp2gS",
+			expected:    map[string]any{"classic_address": "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", "tag": float64(22), "testnet": true},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Equal(t, tc.expected, runJSON(t, "", nil, "address", "x-to-classic", tc.xAddress))
+		})
+	}
+}
+
+func TestAddress_ClassicToX(t *testing.T) {
+	tt := []struct {
+		description string
+		args        []string
+		expected    string
+	}{
+		{
+			description: "pass - mainnet without tag",
+			args:        []string{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"},
+			expected:    "X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ",
+		},
+		{
+			description: "pass - testnet with tag",
+			args:        []string{"-tag", "22", "-testnet", "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"},
+			expected:    "T719a5UwUCnEs54UsxG9CJYYDhwmFCvzHM39KcuJw6gp2gS",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			out := runJSON(t, "", nil, append([]string{"address", "classic-to-x"}, tc.args...)...)
+			require.Equal(t, tc.expected, out["x_address"])
+		})
+	}
+}
+
+func TestAddress_Errors(t *testing.T) {
+	code, _, _ := runCommand(t, "", nil, "address", "classic-to-x", "-tag", "4294967296", "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59")
+	require.Equal(t, 2, code)
+
+	code, _, _ = runCommand(t, "", nil, "address", "x-to-classic", "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59")
+	require.Equal(t, 1, code)
+}
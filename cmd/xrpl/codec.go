@@ -0,0 +1,87 @@
+package main
+
+import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+// blobOutput is the output of the commands returning a hex blob.
+type blobOutput struct {
+	Blob string `json:"blob"`
+}
+
+// hashOutput is the output of the hash command.
+type hashOutput struct {
+	Hash string `json:"hash"`
+}
+
+func runCodecEncode(e *env, args []string) error {
+	fs := newFlagSet(e, "codec encode")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	obj, err := readObject(e, fs.Args())
+	if err != nil {
+		return err
+	}
+	blob, err := binarycodec.Encode(obj)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, blobOutput{Blob: blob})
+}
+
+func runCodecDecode(e *env, args []string) error {
+	fs := newFlagSet(e, "codec decode")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	blob, err := readValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+	obj, err := binarycodec.Decode(blob)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, obj)
+}
+
+func runCodecEncodeForSigning(e *env, args []string) error {
+	fs := newFlagSet(e, "codec encode-for-signing")
+	signer := fs.String("signer", "", "address of the multisigner to encode the transaction for")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	obj, err := readObject(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	var blob string
+	if *signer != "" {
+		blob, err = binarycodec.EncodeForMultisigning(obj, *signer)
+	} else {
+		blob, err = binarycodec.EncodeForSigning(obj)
+	}
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, blobOutput{Blob: blob})
+}
+
+func runHash(e *env, args []string) error {
+	fs := newFlagSet(e, "hash")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	blob, err := readValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+	h, err := hash.SignTxBlob(blob)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, hashOutput{Hash: h})
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPayment = `{
+	"TransactionType": "Payment",
+	"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+	"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+	"Amount": "1000000",
+	"Fee": "12",
+	"Flags": 2147483648,
+	"Sequence": 7,
+	"DestinationTag": 13
+}`
+
+func TestCodec_EncodeDecode(t *testing.T) {
+	encoded := runJSON(t, testPayment, nil, "codec", "encode")
+	blob, ok := encoded["blob"].(string)
+	require.True(t, ok)
+	require.NotEmpty(t, blob)
+
+	decoded := runJSON(t, "", nil, "codec", "decode", blob)
+	require.Equal(t, "Payment", decoded["TransactionType"])
+	require.Equal(t, "1000000", decoded["Amount"])
+	require.EqualValues(t, 7, decoded["Sequence"])
+	require.EqualValues(t, 13, decoded["DestinationTag"])
+	require.EqualValues(t, 2147483648, decoded["Flags"])
+}
+
+func TestCodec_EncodeForSigning(t *testing.T) {
+	single := runJSON(t, testPayment, nil, "codec", "encode-for-signing")
+	require.True(t, strings.HasPrefix(single["blob"].(string), "53545800"))
+
+	multi := runJSON(t, testPayment, nil, "codec", "encode-for-signing", "-signer", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	require.True(t, strings.HasPrefix(multi["blob"].(string), "534D5400"))
+}
+
+func TestCodec_Errors(t *testing.T) {
+	tt := []struct {
+		description string
+		args        []string
+		stdin       string
+		code        int
+	}{
+		{description: "fail - invalid JSON", args: []string{"codec", "encode"}, stdin: `{"Sequence":`, code: 1},
+		{description: "fail - not an object", args: []string{"codec", "encode"}, stdin: `"Payment"`, code: 1},
+		{description: "fail - invalid blob", args: []string{"codec", "decode", "XYZ"}, code: 1},
+		{description: "fail - missing file", args: []string{"codec", "encode", "does-not-exist.json"}, code: 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			code, stdout, _ := runCommand(t, tc.stdin, nil, tc.args...)
+			require.Equal(t, tc.code, code)
+			require.Empty(t, stdout)
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket"
+)
+
+const (
+	// defaultNetwork is the network used when neither flags, environment nor config file select one.
+	defaultNetwork = "testnet"
+	// configEnv is the environment variable of the path of the config file.
+	configEnv = "XRPL_CONFIG"
+	// networkEnv is the environment variable of the network to connect to.
+	networkEnv = "XRPL_NETWORK"
+)
+
+var (
+	// errUnknownNetwork is returned when the selected network is not in the config.
+	errUnknownNetwork = errors.New("unknown network")
+	// errUnsupportedURL is returned when the URL of a network is not an http, https, ws or wss URL.
+	errUnsupportedURL = errors.New("unsupported URL, expected http, https, ws or wss")
+	// errWebsocketRequired is returned when a command requires a websocket connection.
+	errWebsocketRequired = errors.New("a websocket URL is required")
+)
+
+// network is a network the tool connects to.
+type network struct {
+	// URL is the JSON-RPC (http, https) or websocket (ws, wss) URL of the node.
+	URL string `json:"url"`
+	// NetworkID is set on the transactions autofilled for the network. It is required by networks with an ID
+	// above 1024.
+	NetworkID uint32 `json:"network_id,omitempty"`
+}
+
+// config is the JSON config file of the tool, as documented in the package documentation. Its networks are added
+// to the mainnet, testnet and devnet networks, which they can override.
+type config struct {
+	Default  string             `json:"default,omitempty"`
+	Networks map[string]network `json:"networks,omitempty"`
+}
+
+// defaultConfig returns the config of the public networks.
+func defaultConfig() config {
+	return config{
+		Default: defaultNetwork,
+		Networks: map[string]network{
+			"mainnet": {URL: "wss://xrplcluster.com", NetworkID: 0},
+			"testnet": {URL: "wss://s.altnet.rippletest.net:51233", NetworkID: 1},
+			"devnet":  {URL: "wss://s.devnet.rippletest.net:51233", NetworkID: 2},
+		},
+	}
+}
+
+// loadConfig returns the default config merged with the config file at path. If path is empty, the file of the
+// XRPL_CONFIG environment variable is loaded, or xrpl/config.json in the user config directory if it exists.
+func loadConfig(e *env, path string) (config, error) {
+	cfg := defaultConfig()
+
+	optional := false
+	if path == "" {
+		path = e.getenv(configEnv)
+	}
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return cfg, nil
+		}
+		path, optional = filepath.Join(dir, "xrpl", "config.json"), true
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return cfg, nil
+		}
+		return config{}, err
+	}
+	var file config
+	if err := json.Unmarshal(b, &file); err != nil {
+		return config{}, fmt.Errorf("config %s: %w", path, err)
+	}
+
+	if file.Default != "" {
+		cfg.Default = file.Default
+	}
+	for name, n := range file.Networks {
+		cfg.Networks[name] = n
+	}
+	return cfg, nil
+}
+
+// networkNames returns the names of the networks of the config, sorted.
+func (c config) networkNames() []string {
+	names := make([]string, 0, len(c.Networks))
+	for name := range c.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// networkFlags are the flags of the commands connecting to a network.
+type networkFlags struct {
+	config  *string
+	network *string
+	url     *string
+}
+
+// addNetworkFlags adds the network flags to the flag set of a command.
+func addNetworkFlags(fs *flag.FlagSet) *networkFlags {
+	return &networkFlags{
+		config:  fs.String("config", "", "config file (default $XRPL_CONFIG or <user config dir>/xrpl/config.json)"),
+		network: fs.String("network", "", "network of the config to connect to (default $XRPL_NETWORK or the config default)"),
+		url:     fs.String("url", "", "URL of the node to connect to, instead of a network of the config"),
+	}
+}
+
+// resolve returns the network selected by the flags: the -url flag, or the network named by the -network flag, the
+// XRPL_NETWORK environment variable or the default of the config, in this order.
+func (f *networkFlags) resolve(e *env) (network, error) {
+	if *f.url != "" {
+		if *f.network != "" {
+			return network{}, fmt.Errorf("%w: -url and -network are mutually exclusive", errUsage)
+		}
+		return network{URL: *f.url}, nil
+	}
+
+	cfg, err := loadConfig(e, *f.config)
+	if err != nil {
+		return network{}, err
+	}
+	name := *f.network
+	if name == "" {
+		name = e.getenv(networkEnv)
+	}
+	if name == "" {
+		name = cfg.Default
+	}
+	n, ok := cfg.Networks[name]
+	if !ok {
+		return network{}, fmt.Errorf("%w %q", errUnknownNetwork, name)
+	}
+	return n, nil
+}
+
+// dial resolves the network selected by the flags and connects to it.
+func (f *networkFlags) dial(e *env) (*connection, error) {
+	n, err := f.resolve(e)
+	if err != nil {
+		return nil, err
+	}
+	return dial(n)
+}
+
+// connection is a connection to a node, over JSON-RPC or websocket depending on the URL of its network.
+type connection struct {
+	client xrpl.Client
+	rpc    *rpc.Client
+	ws     *websocket.Client
+	// errs receives the first error reported by the websocket client outside of a request.
+	errs chan error
+}
+
+// dial connects to the node of a network. The websocket connection is opened, and must be closed.
+func dial(n network) (*connection, error) {
+	u, err := url.Parse(n.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		cfg, err := rpc.NewClientConfig(n.URL)
+		if err != nil {
+			return nil, err
+		}
+		client := rpc.NewClient(cfg)
+		client.NetworkID = n.NetworkID
+		return &connection{client: client, rpc: client}, nil
+	case "ws", "wss":
+		client := websocket.NewClient(websocket.NewClientConfig().WithHost(n.URL))
+		client.NetworkID = n.NetworkID
+		c := &connection{client: client, ws: client, errs: make(chan error, 1)}
+		client.OnError(func(err error) {
+			select {
+			case c.errs <- err:
+			default:
+			}
+		})
+		if err := client.Connect(); err != nil {
+			return nil, err
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedURL, n.URL)
+	}
+}
+
+// close closes the websocket connection. Errors are ignored, as the command is done with the connection.
+func (c *connection) close() {
+	if c.ws != nil && c.ws.IsConnected() {
+		_ = c.ws.Disconnect()
+	}
+}
+
+// request sends a request of any method and returns its result.
+func (c *connection) request(req rawRequest) (map[string]any, error) {
+	var res interface{ GetResult(v any) error }
+	var err error
+	if c.ws != nil {
+		res, err = c.ws.Request(req)
+	} else {
+		res, err = c.rpc.Request(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := res.GetResult(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rawRequest is a request of any method: the method in "command" and its params in the other fields, as in the
+// websocket API. It is sent as is over websocket, and without "command" in the params of the JSON-RPC request.
+type rawRequest map[string]any
+
+// newRawRequest returns the request of a method with params.
+func newRawRequest(method string, params map[string]any) rawRequest {
+	req := make(rawRequest, len(params)+1)
+	for k, v := range params {
+		req[k] = v
+	}
+	req["command"] = method
+	return req
+}
+
+// Method returns the method of the request.
+func (r rawRequest) Method() string {
+	method, _ := r["command"].(string)
+	return method
+}
+
+// Validate checks that the request has a method.
+func (r rawRequest) Validate() error {
+	if r.Method() == "" {
+		return fmt.Errorf("%w: missing method", errUsage)
+	}
+	return nil
+}
+
+// APIVersion returns the api_version param of the request, or version 2.
+func (r rawRequest) APIVersion() int {
+	switch v := r["api_version"].(type) {
+	case int:
+		return v
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n)
+		}
+	}
+	return version.RippledAPIV2
+}
+
+// SetAPIVersion sets the api_version param of the request.
+func (r rawRequest) SetAPIVersion(apiVersion int) {
+	r["api_version"] = apiVersion
+}
+
+// MarshalJSON encodes the params of the request, without its method.
+func (r rawRequest) MarshalJSON() ([]byte, error) {
+	params := make(map[string]any, len(r))
+	for k, v := range r {
+		if k != "command" {
+			params[k] = v
+		}
+	}
+	return json.Marshal(params)
+}
+
+// networkOutput is a network of the output of the networks command.
+type networkOutput struct {
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	NetworkID uint32 `json:"network_id"`
+	Default   bool   `json:"default,omitempty"`
+}
+
+func runNetworks(e *env, args []string) error {
+	fs := newFlagSet(e, "networks")
+	path := fs.String("config", "", "config file (default $XRPL_CONFIG or <user config dir>/xrpl/config.json)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: unexpected arguments", errUsage)
+	}
+
+	cfg, err := loadConfig(e, *path)
+	if err != nil {
+		return err
+	}
+	out := make([]networkOutput, 0, len(cfg.Networks))
+	for _, name := range cfg.networkNames() {
+		n := cfg.Networks[name]
+		out = append(out, networkOutput{Name: name, URL: n.URL, NetworkID: n.NetworkID, Default: name == cfg.Default})
+	}
+	return writeJSON(e, out)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfig writes a config file and returns its path.
+func writeConfig(t *testing.T, cfg config) string {
+	t.Helper()
+	b, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+	return path
+}
+
+func TestNetworkFlags_Resolve(t *testing.T) {
+	path := writeConfig(t, config{
+		Default: "local",
+		Networks: map[string]network{
+			"local":   {URL: "ws://localhost:6006"},
+			"testnet": {URL: "https://testnet.example.com", NetworkID: 1},
+			"side":    {URL: "https://side.example.com", NetworkID: 21338},
+		},
+	})
+
+	tt := []struct {
+		description string
+		args        []string
+		vars        map[string]string
+		expected    network
+		expectedErr string
+	}{
+		{
+			description: "pass - builtin default",
+			vars:        map[string]string{configEnv: ""},
+			expected:    defaultConfig().Networks[defaultNetwork],
+		},
+		{
+			description: "pass - config default",
+			args:        []string{"-config", path},
+			expected:    network{URL: "ws://localhost:6006"},
+		},
+		{
+			description: "pass - config from environment",
+			vars:        map[string]string{configEnv: path},
+			expected:    network{URL: "ws://localhost:6006"},
+		},
+		{
+			description: "pass - network flag",
+			args:        []string{"-config", path, "-network", "side"},
+			vars:        map[string]string{networkEnv: "local"},
+			expected:    network{URL: "https://side.example.com", NetworkID: 21338},
+		},
+		{
+			description: "pass - network from environment",
+			args:        []string{"-config", path},
+			vars:        map[string]string{networkEnv: "side"},
+			expected:    network{URL: "https://side.example.com", NetworkID: 21338},
+		},
+		{
+			description: "pass - overridden builtin network",
+			args:        []string{"-config", path, "-network", "testnet"},
+			expected:    network{URL: "https://testnet.example.com", NetworkID: 1},
+		},
+		{
+			description: "pass - builtin network",
+			args:        []string{"-config", path, "-network", "mainnet"},
+			expected:    defaultConfig().Networks["mainnet"],
+		},
+		{
+			description: "pass - url",
+			args:        []string{"-url", "http://localhost:5005"},
+			expected:    network{URL: "http://localhost:5005"},
+		},
+		{
+			description: "fail - url and network",
+			args:        []string{"-url", "http://localhost:5005", "-network", "side"},
+			expectedErr: "invalid usage: -url and -network are mutually exclusive",
+		},
+		{
+			description: "fail - unknown network",
+			args:        []string{"-config", path, "-network", "betanet"},
+			expectedErr: `unknown network "betanet"`,
+		},
+		{
+			description: "fail - missing config file",
+			args:        []string{"-config", filepath.Join(t.TempDir(), "missing.json")},
+			expectedErr: "no such file or directory",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+			t.Setenv("HOME", t.TempDir())
+			e := &env{getenv: func(key string) string { return tc.vars[key] }}
+			fs := newFlagSet(e, "test")
+			flags := addNetworkFlags(fs)
+			require.NoError(t, fs.Parse(tc.args))
+
+			n, err := flags.resolve(e)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, n)
+		})
+	}
+}
+
+func TestNetworks(t *testing.T) {
+	path := writeConfig(t, config{Default: "local", Networks: map[string]network{"local": {URL: "ws://localhost:6006", NetworkID: 7}}})
+
+	code, stdout, stderr := runCommand(t, "", nil, "networks", "-config", path)
+	require.Equal(t, 0, code, stderr)
+	var out []networkOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	require.Equal(t, []networkOutput{
+		{Name: "devnet", URL: "wss://s.devnet.rippletest.net:51233", NetworkID: 2},
+		{Name: "local", URL: "ws://localhost:6006", NetworkID: 7, Default: true},
+		{Name: "mainnet", URL: "wss://xrplcluster.com"},
+		{Name: "testnet", URL: "wss://s.altnet.rippletest.net:51233", NetworkID: 1},
+	}, out)
+
+	code, _, _ = runCommand(t, "", nil, "networks", "-config", filepath.Join(t.TempDir(), "missing.json"))
+	require.Equal(t, 1, code)
+}
+
+func TestRawRequest(t *testing.T) {
+	req := newRawRequest("account_info", map[string]any{"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "api_version": json.Number("1")})
+	require.Equal(t, "account_info", req.Method())
+	require.Equal(t, 1, req.APIVersion())
+	require.NoError(t, req.Validate())
+
+	b, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","api_version":1}`, string(b))
+
+	require.Equal(t, 2, newRawRequest("server_info", nil).APIVersion())
+	require.ErrorIs(t, newRawRequest("", nil).Validate(), errUsage)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+)
+
+var (
+	// errEmptyInput is returned when a command reads an empty input.
+	errEmptyInput = errors.New("empty input")
+	// errNotAnObject is returned when a JSON input is not an object.
+	errNotAnObject = errors.New("input is not a JSON object")
+)
+
+// readValue returns the single positional argument of a command, or its input from stdin if there is none,
+// trimmed of spaces.
+func readValue(e *env, args []string) (string, error) {
+	switch len(args) {
+	case 0:
+		b, err := io.ReadAll(e.stdin)
+		if err != nil {
+			return "", err
+		}
+		v := strings.TrimSpace(string(b))
+		if v == "" {
+			return "", errEmptyInput
+		}
+		return v, nil
+	case 1:
+		return args[0], nil
+	default:
+		return "", fmt.Errorf("%w: too many arguments", errUsage)
+	}
+}
+
+// readFile returns the content of the file of the single positional argument of a command, or stdin if there is
+// none or it is "-".
+func readFile(e *env, args []string) ([]byte, error) {
+	switch {
+	case len(args) > 1:
+		return nil, fmt.Errorf("%w: too many arguments", errUsage)
+	case len(args) == 0 || args[0] == "-":
+		return io.ReadAll(e.stdin)
+	default:
+		return os.ReadFile(args[0])
+	}
+}
+
+// readObject reads a JSON object from the file of the positional argument of a command or stdin, with the
+// numbers of unsigned integer fields converted to the Go types the binary codec encodes.
+func readObject(e *env, args []string) (map[string]any, error) {
+	b, err := readFile(e, args)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObject(b)
+}
+
+// decodeObject decodes a JSON object, with the numbers of unsigned integer fields converted to the Go types the
+// binary codec encodes.
+func decodeObject(b []byte) (map[string]any, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, errEmptyInput
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, errNotAnObject
+	}
+	if err := normalizeObject(obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// normalizeObject converts the json.Number values of the UInt8, UInt16 and UInt32 fields of an object, and of its
+// nested objects and arrays, to int, int and uint32, which the binary codec encodes. Other numbers are left as
+// json.Number.
+func normalizeObject(obj map[string]any) error {
+	defs := definitions.Get()
+	for field, value := range obj {
+		typeName, err := defs.GetTypeNameByFieldName(field)
+		if err != nil {
+			continue
+		}
+
+		switch v := value.(type) {
+		case json.Number:
+			n, err := normalizeNumber(typeName, v)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", field, err)
+			}
+			obj[field] = n
+		case map[string]any:
+			if err := normalizeObject(v); err != nil {
+				return err
+			}
+		case []any:
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					if err := normalizeObject(m); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeNumber converts a number to the Go type of its unsigned integer field type.
+func normalizeNumber(typeName string, n json.Number) (any, error) {
+	var bitSize int
+	switch typeName {
+	case "UInt8":
+		bitSize = 8
+	case "UInt16":
+		bitSize = 16
+	case "UInt32":
+		bitSize = 32
+	default:
+		return n, nil
+	}
+
+	v, err := strconv.ParseUint(n.String(), 10, bitSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %s", typeName, n)
+	}
+	if bitSize == 32 {
+		return uint32(v), nil
+	}
+	return int(v), nil
+}
+
+// writeJSON writes a value as indented JSON.
+func writeJSON(e *env, v any) error {
+	enc := json.NewEncoder(e.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// readSecret returns a secret from a flag, the file of a flag, or an environment variable, in this order, so
+// that secrets can be kept out of the command line.
+func readSecret(e *env, value, file, envKey string) (string, error) {
+	switch {
+	case value != "":
+		return value, nil
+	case file != "":
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	default:
+		return strings.TrimSpace(e.getenv(envKey)), nil
+	}
+}
+
+// writeJSONLine writes a value as JSON on a single line, for commands printing a stream of values.
+func writeJSONLine(e *env, v any) error {
+	return json.NewEncoder(e.stdout).Encode(v)
+}
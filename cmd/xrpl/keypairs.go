@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/random"
+)
+
+// keypairOutput is the output of the generate and derive commands.
+type keypairOutput struct {
+	Seed           string `json:"seed,omitempty"`
+	PublicKey      string `json:"public_key"`
+	PrivateKey     string `json:"private_key"`
+	ClassicAddress string `json:"classic_address,omitempty"`
+	NodePublicKey  string `json:"node_public_key,omitempty"`
+}
+
+// signatureOutput is the output of the sign command.
+type signatureOutput struct {
+	Signature string `json:"signature"`
+}
+
+// verifyOutput is the output of the verify command.
+type verifyOutput struct {
+	Valid bool `json:"valid"`
+}
+
+func runKeypairsGenerate(e *env, args []string) error {
+	fs := newFlagSet(e, "keypairs generate")
+	algorithm := fs.String("algorithm", "secp256k1", "key algorithm: secp256k1 or ed25519")
+	validator := fs.Bool("validator", false, "derive validator keys")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: unexpected arguments", errUsage)
+	}
+
+	var alg interfaces.KeypairCryptoAlg
+	switch strings.ToLower(*algorithm) {
+	case "secp256k1":
+		alg = crypto.SECP256K1()
+	case "ed25519":
+		alg = crypto.ED25519()
+	default:
+		return fmt.Errorf("%w: unknown algorithm %q", errUsage, *algorithm)
+	}
+
+	seed, err := keypairs.GenerateSeed("", alg, random.NewRandomizer())
+	if err != nil {
+		return err
+	}
+	out, err := deriveKeypair(seed, *validator)
+	if err != nil {
+		return err
+	}
+	out.Seed = seed
+	return writeJSON(e, out)
+}
+
+func runKeypairsDerive(e *env, args []string) error {
+	fs := newFlagSet(e, "keypairs derive")
+	validator := fs.Bool("validator", false, "derive validator keys")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	seed, err := readValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+	out, err := deriveKeypair(seed, *validator)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, out)
+}
+
+// deriveKeypair derives the keys of a seed, with the classic address of account keys and the node public key of
+// validator keys.
+func deriveKeypair(seed string, validator bool) (keypairOutput, error) {
+	if validator {
+		private, public, err := keypairs.DeriveNodeKeypair(seed)
+		if err != nil {
+			return keypairOutput{}, err
+		}
+		node, err := keypairs.EncodeNodePublicKey(public)
+		if err != nil {
+			return keypairOutput{}, err
+		}
+		return keypairOutput{PublicKey: public, PrivateKey: private, NodePublicKey: node}, nil
+	}
+
+	private, public, err := keypairs.DeriveKeypair(seed, false)
+	if err != nil {
+		return keypairOutput{}, err
+	}
+	address, err := keypairs.DeriveClassicAddress(public)
+	if err != nil {
+		return keypairOutput{}, err
+	}
+	return keypairOutput{PublicKey: public, PrivateKey: private, ClassicAddress: address}, nil
+}
+
+func runKeypairsSign(e *env, args []string) error {
+	fs := newFlagSet(e, "keypairs sign")
+	privateKey := fs.String("private-key", "", "hex private key")
+	privateKeyFile := fs.String("private-key-file", "", "file of the hex private key")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	key, err := readSecret(e, *privateKey, *privateKeyFile, "XRPL_PRIVATE_KEY")
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		return fmt.Errorf("%w: missing private key", errUsage)
+	}
+	msg, err := readHexValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	priv, err := keypairs.ParsePrivateKey(key)
+	if err != nil {
+		return err
+	}
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, signatureOutput{Signature: strings.ToUpper(hex.EncodeToString(sig))})
+}
+
+func runKeypairsVerify(e *env, args []string) error {
+	fs := newFlagSet(e, "keypairs verify")
+	publicKey := fs.String("public-key", "", "hex public key")
+	signature := fs.String("signature", "", "hex signature")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *publicKey == "" || *signature == "" {
+		return fmt.Errorf("%w: missing public key or signature", errUsage)
+	}
+	msg, err := readHexValue(e, fs.Args())
+	if err != nil {
+		return err
+	}
+	sig, err := hex.DecodeString(*signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	pub, err := keypairs.ParsePublicKey(*publicKey)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, verifyOutput{Valid: pub.Verify(msg, sig)})
+}
+
+// readHexValue reads a hex value, such as a message to sign, and decodes it.
+func readHexValue(e *env, args []string) ([]byte, error) {
+	v, err := readValue(e, args)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex message: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSeed       = "sEdTjrdnJaPE2NNjmavQqXQdrf71NiH"
+	testPublicKey  = "ED4924A9045FE5ED8B22BAA7B6229A72A287CCF3EA287AADD3A032A24C0F008FA6"
+	testPrivateKey = "EDBB3ECA8985E1484FA6A28C4B30FB0042A2CC5DF3EC8DC37B5F3D126DDFD3CA14"
+	testSignature  = "C001CB8A9883497518917DD16391930F4FEE39CEA76C846CFF4330BA44ED19DC4730056C2C6D7452873DE8120A5023C6807135C6329A89A13BA1D476FE8E7100"
+)
+
+var testMessage = hex.EncodeToString([]byte("test message"))
+
+func TestKeypairs_Derive(t *testing.T) {
+	out := runJSON(t, testSeed+"\n", nil, "keypairs", "derive")
+	require.Equal(t, testPublicKey, out["public_key"])
+	require.Equal(t, testPrivateKey, out["private_key"])
+	require.NotEmpty(t, out["classic_address"])
+	require.NotContains(t, out, "seed")
+	require.NotContains(t, out, "node_public_key")
+}
+
+func TestKeypairs_Generate(t *testing.T) {
+	tt := []struct {
+		description string
+		flags       []string
+		seedPrefix  string
+		key         string
+	}{
+		{description: "pass - secp256k1", seedPrefix: "s", key: "classic_address"},
+		{description: "pass - ed25519", flags: []string{"-algorithm", "ed25519"}, seedPrefix: "sEd", key: "classic_address"},
+		{description: "pass - validator", flags: []string{"-validator"}, seedPrefix: "s", key: "node_public_key"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			generated := runJSON(t, "", nil, append([]string{"keypairs", "generate"}, tc.flags...)...)
+			seed, ok := generated["seed"].(string)
+			require.True(t, ok)
+			require.Regexp(t, "^"+tc.seedPrefix, seed)
+			require.NotEmpty(t, generated[tc.key])
+
+			validator := []string{}
+			if tc.key == "node_public_key" {
+				validator = append(validator, "-validator")
+			}
+			derived := runJSON(t, "", nil, append(append([]string{"keypairs", "derive"}, validator...), seed)...)
+			delete(generated, "seed")
+			require.Equal(t, generated, derived)
+		})
+	}
+
+	code, _, stderr := runCommand(t, "", nil, "keypairs", "generate", "-algorithm", "rsa")
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr, `unknown algorithm "rsa"`)
+}
+
+func TestKeypairs_Sign(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(testPrivateKey+"\n"), 0o600))
+
+	tt := []struct {
+		description string
+		args        []string
+		vars        map[string]string
+	}{
+		{description: "pass - flag", args: []string{"-private-key", testPrivateKey}},
+		{description: "pass - file", args: []string{"-private-key-file", keyFile}},
+		{description: "pass - environment", vars: map[string]string{"XRPL_PRIVATE_KEY": testPrivateKey}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			out := runJSON(t, testMessage, tc.vars, append([]string{"keypairs", "sign"}, tc.args...)...)
+			require.Equal(t, map[string]any{"signature": testSignature}, out)
+		})
+	}
+
+	code, _, stderr := runCommand(t, testMessage, nil, "keypairs", "sign")
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr, "missing private key")
+}
+
+func TestKeypairs_Verify(t *testing.T) {
+	tt := []struct {
+		description string
+		message     string
+		valid       bool
+	}{
+		{description: "pass - valid signature", message: testMessage, valid: true},
+		{description: "pass - other message", message: hex.EncodeToString([]byte("other message")), valid: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			out := runJSON(t, "", nil, "keypairs", "verify", "-public-key", testPublicKey, "-signature", testSignature, tc.message)
+			require.Equal(t, map[string]any{"valid": tc.valid}, out)
+		})
+	}
+
+	code, _, _ := runCommand(t, "", nil, "keypairs", "verify", "-public-key", testPublicKey, "-signature", testSignature, "not hex")
+	require.Equal(t, 1, code)
+}
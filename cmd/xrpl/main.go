@@ -0,0 +1,177 @@
+// Command xrpl is a command-line tool for the XRP Ledger. It encodes and decodes transactions, converts
+// addresses, generates and derives keys, and signs, multisigns and hashes transactions, without a network
+// connection, so it can be used on air-gapped machines. Its online commands query a node, autofill and submit
+// transactions, and watch accounts and ledgers, over JSON-RPC or websocket.
+//
+// Inputs are read from a file or argument, or from stdin when omitted, and results are written to stdout as JSON.
+//
+// Online commands connect to the URL of the -url flag, or to a network of the config file, selected by the -network
+// flag, the XRPL_NETWORK environment variable or the default of the config file. The config file, set by the
+// -config flag or the XRPL_CONFIG environment variable, defaults to xrpl/config.json in the user config directory:
+//
+//	{
+//	  "default": "local",
+//	  "networks": {
+//	    "local": {"url": "ws://localhost:6006"},
+//	    "sidechain": {"url": "https://sidechain.example.com:51234", "network_id": 21338}
+//	  }
+//	}
+//
+// The mainnet, testnet and devnet networks are always available, and testnet is the default.
+//
+// Usage:
+//
+//	xrpl <command> [flags] [input]
+//
+// Run "xrpl help" for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// env is the environment of a command.
+type env struct {
+	// ctx is canceled when the tool is interrupted.
+	ctx    context.Context
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(key string) string
+}
+
+// command is a command of the tool, such as "codec encode".
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(e *env, args []string) error
+}
+
+// commands returns the commands of the tool.
+func commands() []command {
+	return []command{
+		{name: "codec encode", usage: "[file]", summary: "encode a JSON transaction or ledger object into a hex blob", run: runCodecEncode},
+		{name: "codec decode", usage: "[blob]", summary: "decode a hex blob into JSON", run: runCodecDecode},
+		{name: "codec encode-for-signing", usage: "[-signer address] [file]", summary: "encode a JSON transaction for signing, or for multisigning by signer", run: runCodecEncodeForSigning},
+		{name: "address classic-to-x", usage: "[-tag n] [-testnet] [address]", summary: "convert a classic address to an X-address", run: runClassicToX},
+		{name: "address x-to-classic", usage: "[x-address]", summary: "convert an X-address to a classic address and tag", run: runXToClassic},
+		{name: "keypairs generate", usage: "[-algorithm secp256k1|ed25519] [-validator]", summary: "generate a random seed and derive its keys", run: runKeypairsGenerate},
+		{name: "keypairs derive", usage: "[-validator] [seed]", summary: "derive the keys and address of a seed", run: runKeypairsDerive},
+		{name: "keypairs sign", usage: "(-private-key key | -private-key-file file) [message]", summary: "sign a hex message", run: runKeypairsSign},
+		{name: "keypairs verify", usage: "-public-key key -signature signature [message]", summary: "verify the signature of a hex message", run: runKeypairsVerify},
+		{name: "wallet sign", usage: "(-seed seed | -seed-file file) [-account address] [-multisign] [file]", summary: "sign a JSON transaction, or sign it as one of its multisigners", run: runWalletSign},
+		{name: "multisign", usage: "[blob...]", summary: "combine transaction blobs signed by each multisigner into one blob", run: runMultisign},
+		{name: "hash", usage: "[blob]", summary: "compute the hash of a signed transaction blob", run: runHash},
+		{name: "query", usage: "[network flags] method [params | -]", summary: "send a request of any method with JSON params, and print its result", run: runQuery},
+		{name: "autofill", usage: "[network flags] [-signers n] [file]", summary: "fill in the sequence, fee, last ledger sequence and network ID of a JSON transaction", run: runAutofill},
+		{name: "submit", usage: "[network flags] [-fail-hard] [-no-wait] [blob]", summary: "submit a signed transaction blob and wait for its validation", run: runSubmit},
+		{name: "watch", usage: "[network flags] [-ledger] [-count n] [address...]", summary: "print the transactions and balance changes of accounts, or the closed ledgers", run: runWatch},
+		{name: "networks", usage: "[-config file]", summary: "print the networks of the config file", run: runNetworks},
+	}
+}
+
+// errUsage is returned when a command is called with invalid arguments. Its usage is printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(os.Args[1:], &env{ctx: ctx, stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr, getenv: os.Getenv})
+	stop()
+	os.Exit(code)
+}
+
+// run runs the command of args, and returns the exit code: 0 on success, 1 on error and 2 on invalid usage.
+func run(args []string, e *env) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(e.stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	cmd, rest, ok := findCommand(args)
+	if !ok {
+		fmt.Fprintf(e.stderr, "xrpl: unknown command %q\n\n", strings.Join(args[:min(2, len(args))], " "))
+		printUsage(e.stderr)
+		return 2
+	}
+
+	err := cmd.run(e, rest)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(e.stderr, "xrpl: %v\nusage: xrpl %s %s\n", err, cmd.name, cmd.usage)
+		return 2
+	default:
+		fmt.Fprintf(e.stderr, "xrpl: %v\n", err)
+		return 1
+	}
+}
+
+// findCommand returns the command named by the first one or two arguments, and the remaining arguments.
+func findCommand(args []string) (command, []string, bool) {
+	cmds := commands()
+	if len(args) >= 2 {
+		for _, c := range cmds {
+			if c.name == args[0]+" "+args[1] {
+				return c, args[2:], true
+			}
+		}
+	}
+	for _, c := range cmds {
+		if c.name == args[0] {
+			return c, args[1:], true
+		}
+	}
+	return command{}, nil, false
+}
+
+// printUsage prints the commands of the tool.
+func printUsage(w io.Writer) {
+	cmds := commands()
+	sort.SliceStable(cmds, func(i, j int) bool {
+		return strings.Fields(cmds[i].name)[0] < strings.Fields(cmds[j].name)[0]
+	})
+
+	fmt.Fprintln(w, "usage: xrpl <command> [flags] [input]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Inputs are read from stdin when omitted. Flags must come before the input.")
+	fmt.Fprintln(w, "Network flags: [-config file] [-network name | -url url]. See \"xrpl <command> -h\".")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range cmds {
+		fmt.Fprintf(w, "  %-26s %s\n", c.name, c.summary)
+	}
+}
+
+// newFlagSet returns the flag set of a command, which reports errors instead of exiting.
+func newFlagSet(e *env, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("xrpl "+name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	return fs
+}
+
+// parseFlags parses the flags of a command, and wraps parsing errors in errUsage.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	return nil
+}
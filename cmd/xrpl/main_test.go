@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runCommand runs the tool with args, stdin and environment variables, and returns its exit code, stdout and stderr.
+func runCommand(t *testing.T, stdin string, vars map[string]string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, &env{
+		ctx:    context.Background(),
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+		getenv: func(key string) string { return vars[key] },
+	})
+	return code, stdout.String(), stderr.String()
+}
+
+// runJSON runs the tool, requires it to succeed, and decodes its JSON output.
+func runJSON(t *testing.T, stdin string, vars map[string]string, args ...string) map[string]any {
+	t.Helper()
+	code, stdout, stderr := runCommand(t, stdin, vars, args...)
+	require.Equal(t, 0, code, stderr)
+	var out map[string]any
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	return out
+}
+
+func TestRun(t *testing.T) {
+	tt := []struct {
+		description string
+		args        []string
+		stdin       string
+		code        int
+		stderr      string
+	}{
+		{description: "pass - help", args: []string{"help"}, code: 0, stderr: "codec encode"},
+		{description: "fail - no command", args: nil, code: 2, stderr: "usage: xrpl"},
+		{description: "fail - unknown command", args: []string{"codec", "explode"}, code: 2, stderr: `unknown command "codec explode"`},
+		{description: "fail - unknown flag", args: []string{"hash", "-foo"}, code: 2, stderr: "usage: xrpl hash [blob]"},
+		{description: "fail - too many arguments", args: []string{"hash", "AA", "BB"}, code: 2, stderr: "too many arguments"},
+		{description: "fail - empty input", args: []string{"codec", "decode"}, stdin: " \n", code: 1, stderr: "empty input"},
+		{description: "fail - command error", args: []string{"codec", "decode", "ZZ"}, code: 1, stderr: "xrpl: "},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			code, _, stderr := runCommand(t, tc.stdin, nil, tc.args...)
+			require.Equal(t, tc.code, code)
+			require.Contains(t, stderr, tc.stderr)
+		})
+	}
+}
+
+func TestDecodeObject(t *testing.T) {
+	tt := []struct {
+		description string
+		input       string
+		expected    map[string]any
+		expectedErr string
+	}{
+		{
+			description: "pass - unsigned integer fields",
+			input:       `{"TransactionType":"Payment","Sequence":5,"TickSize":3,"SignerWeight":1,"Memos":[{"Memo":{"MemoData":"AB"}}],"Amount":"10"}`,
+			expected: map[string]any{
+				"TransactionType": "Payment",
+				"Sequence":        uint32(5),
+				"TickSize":        3,
+				"SignerWeight":    1,
+				"Memos":           []any{map[string]any{"Memo": map[string]any{"MemoData": "AB"}}},
+				"Amount":          "10",
+			},
+		},
+		{
+			description: "pass - nested objects and unknown fields",
+			input:       `{"Signers":[{"Signer":{"Account":"r","SignerWeight":2}}],"Custom":7}`,
+			expected: map[string]any{
+				"Signers": []any{map[string]any{"Signer": map[string]any{"Account": "r", "SignerWeight": 2}}},
+				"Custom":  json.Number("7"),
+			},
+		},
+		{description: "fail - out of range", input: `{"TickSize":256}`, expectedErr: "field TickSize: invalid UInt8 256"},
+		{description: "fail - negative", input: `{"Sequence":-1}`, expectedErr: "field Sequence: invalid UInt32 -1"},
+		{description: "fail - not an object", input: `[1]`, expectedErr: errNotAnObject.Error()},
+		{description: "fail - empty", input: ``, expectedErr: errEmptyInput.Error()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			obj, err := decodeObject([]byte(tc.input))
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, obj)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+func runQuery(e *env, args []string) error {
+	fs := newFlagSet(e, "query")
+	net := addNetworkFlags(fs)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: missing method", errUsage)
+	}
+	if fs.NArg() > 2 {
+		return fmt.Errorf("%w: too many arguments", errUsage)
+	}
+
+	params := map[string]any{}
+	if fs.NArg() == 2 {
+		var b []byte
+		if fs.Arg(1) == "-" {
+			var err error
+			if b, err = io.ReadAll(e.stdin); err != nil {
+				return err
+			}
+		} else {
+			b = []byte(fs.Arg(1))
+		}
+		var err error
+		if params, err = decodeObject(b); err != nil {
+			return fmt.Errorf("params: %w", err)
+		}
+	}
+
+	conn, err := net.dial(e)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	result, err := conn.request(newRawRequest(fs.Arg(0), params))
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, result)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode is a node answering requests with the result of their method, over JSON-RPC and websocket. Over
+// websocket, its stream messages are sent after the subscribe response.
+type testNode struct {
+	results map[string]map[string]any
+	streams []map[string]any
+
+	mu       sync.Mutex
+	requests []map[string]any
+}
+
+// result returns the result of a request, and records it with its method in "command".
+func (n *testNode) result(req map[string]any) map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.requests = append(n.requests, req)
+
+	result := map[string]any{"status": "success"}
+	for k, v := range n.results[req["command"].(string)] {
+		result[k] = v
+	}
+	return result
+}
+
+// received returns the requests received.
+func (n *testNode) received() []map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]map[string]any(nil), n.requests...)
+}
+
+// methods returns the methods of the requests received.
+func (n *testNode) methods() []string {
+	var methods []string
+	for _, req := range n.received() {
+		methods = append(methods, req["command"].(string))
+	}
+	return methods
+}
+
+// rpcURL starts a JSON-RPC server of the node and returns its URL.
+func (n *testNode) rpcURL(t *testing.T) string {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Method string           `json:"method"`
+			Params []map[string]any `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req := map[string]any{}
+		if len(body.Params) > 0 {
+			req = body.Params[0]
+		}
+		req["command"] = body.Method
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": n.result(req)})
+	}))
+	t.Cleanup(s.Close)
+	return s.URL
+}
+
+// wsURL starts a websocket server of the node and returns its URL.
+func (n *testNode) wsURL(t *testing.T) string {
+	t.Helper()
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		for {
+			var req map[string]any
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			res := map[string]any{"id": req["id"], "type": "response", "status": "success", "result": n.result(req)}
+			if err := c.WriteJSON(res); err != nil {
+				return
+			}
+			if req["command"] == "subscribe" {
+				for _, m := range n.streams {
+					if err := c.WriteJSON(m); err != nil {
+						return
+					}
+				}
+			}
+		}
+	})
+	t.Cleanup(s.Close)
+	url, err := testutil.ConvertHTTPToWS(s.URL)
+	require.NoError(t, err)
+	return url
+}
+
+func TestQuery(t *testing.T) {
+	results := map[string]map[string]any{
+		"account_info": {"account_data": map[string]any{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Sequence": 7}},
+		"server_info":  {"info": map[string]any{"build_version": "2.4.0"}},
+	}
+
+	tt := []struct {
+		description string
+		websocket   bool
+		args        []string
+		stdin       string
+		expected    map[string]any
+		params      map[string]any
+	}{
+		{
+			description: "pass - JSON-RPC params argument",
+			args:        []string{"account_info", `{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","ledger_index":"validated"}`},
+			expected:    map[string]any{"status": "success", "account_data": map[string]any{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Sequence": float64(7)}},
+			params:      map[string]any{"command": "account_info", "account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "ledger_index": "validated", "api_version": float64(2)},
+		},
+		{
+			description: "pass - websocket params from stdin",
+			websocket:   true,
+			args:        []string{"account_info", "-"},
+			stdin:       `{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","api_version":1}`,
+			expected:    map[string]any{"status": "success", "account_data": map[string]any{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "Sequence": float64(7)}},
+			params:      map[string]any{"command": "account_info", "account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "api_version": float64(1)},
+		},
+		{
+			description: "pass - websocket without params",
+			websocket:   true,
+			args:        []string{"server_info"},
+			expected:    map[string]any{"status": "success", "info": map[string]any{"build_version": "2.4.0"}},
+			params:      map[string]any{"command": "server_info", "api_version": float64(2)},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			node := &testNode{results: results}
+			url := node.rpcURL(t)
+			if tc.websocket {
+				url = node.wsURL(t)
+			}
+			out := runJSON(t, tc.stdin, nil, append([]string{"query", "-url", url}, tc.args...)...)
+			require.Equal(t, tc.expected, out)
+			requests := node.received()
+			require.Len(t, requests, 1)
+			params := map[string]any{}
+			for k, v := range requests[0] {
+				if k != "id" {
+					params[k] = v
+				}
+			}
+			require.Equal(t, tc.params, params)
+		})
+	}
+}
+
+func TestQuery_Errors(t *testing.T) {
+	tt := []struct {
+		description string
+		args        []string
+		code        int
+		stderr      string
+	}{
+		{description: "fail - missing method", args: []string{"query"}, code: 2, stderr: "missing method"},
+		{description: "fail - invalid params", args: []string{"query", "-url", "http://localhost:1", "tx", "[1]"}, code: 1, stderr: "params: " + errNotAnObject.Error()},
+		{description: "fail - unsupported URL", args: []string{"query", "-url", "ftp://localhost", "server_info"}, code: 1, stderr: errUnsupportedURL.Error()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			code, _, stderr := runCommand(t, "", nil, tc.args...)
+			require.Equal(t, tc.code, code)
+			require.Contains(t, stderr, tc.stderr)
+		})
+	}
+}
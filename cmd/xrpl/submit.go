@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// errMissingTxBlob is returned when the JSON of a signed transaction has no tx_blob.
+var errMissingTxBlob = errors.New("missing tx_blob")
+
+func runAutofill(e *env, args []string) error {
+	fs := newFlagSet(e, "autofill")
+	net := addNetworkFlags(fs)
+	signers := fs.Uint64("signers", 0, "number of multisigners, to compute the fee of a multisigned transaction")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	obj, err := readObject(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.dial(e)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	tx := transaction.FlatTransaction(obj)
+	if *signers > 0 {
+		err = conn.client.AutofillMultisigned(&tx, *signers)
+	} else {
+		err = conn.client.Autofill(&tx)
+	}
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, tx)
+}
+
+func runSubmit(e *env, args []string) error {
+	fs := newFlagSet(e, "submit")
+	net := addNetworkFlags(fs)
+	failHard := fs.Bool("fail-hard", false, "do not retry or relay the transaction if it fails locally")
+	noWait := fs.Bool("no-wait", false, "print the submit result without waiting for the transaction to be validated")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	blob, err := readSignedBlob(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.dial(e)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	if *noWait {
+		res, err := conn.client.SubmitTxBlob(blob, *failHard)
+		if err != nil {
+			return err
+		}
+		return writeJSON(e, res)
+	}
+	res, err := conn.client.SubmitTxBlobAndWait(blob, *failHard)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, res)
+}
+
+// readSignedBlob reads a signed transaction blob from the positional argument of a command or stdin, either as
+// is or as the JSON output of wallet sign or multisign.
+func readSignedBlob(e *env, args []string) (string, error) {
+	v, err := readValue(e, args)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(v, "{") {
+		return v, nil
+	}
+
+	var out signedOutput
+	if err := json.Unmarshal([]byte(v), &out); err != nil {
+		return "", err
+	}
+	if out.TxBlob == "" {
+		return "", errMissingTxBlob
+	}
+	return out.TxBlob, nil
+}
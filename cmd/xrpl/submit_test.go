@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutofill(t *testing.T) {
+	node := &testNode{results: map[string]map[string]any{
+		"ledger":      {"ledger_index": 10, "ledger_hash": "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A652", "validated": true},
+		"server_info": {"info": map[string]any{"load_factor": 1, "validated_ledger": map[string]any{"base_fee_xrp": 0.00001}}},
+	}}
+	tx := strings.Replace(testPayment, `"Fee": "12",`, "", 1)
+	path := writeConfig(t, config{Networks: map[string]network{"side": {URL: node.rpcURL(t), NetworkID: 21338}}})
+
+	tt := []struct {
+		description string
+		args        []string
+		expectedFee string
+	}{
+		{description: "pass - single signed", args: nil, expectedFee: "12"},
+		{description: "pass - multisigned", args: []string{"-signers", "2"}, expectedFee: "36"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			out := runJSON(t, tx, nil, append([]string{"autofill", "-config", path, "-network", "side"}, tc.args...)...)
+			require.EqualValues(t, 21338, out["NetworkID"])
+			require.EqualValues(t, 30, out["LastLedgerSequence"])
+			require.EqualValues(t, 7, out["Sequence"])
+			require.Equal(t, tc.expectedFee, out["Fee"])
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	tx := `{
+		"TransactionType": "Payment",
+		"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount": "1000000",
+		"Fee": "12",
+		"Sequence": 7,
+		"LastLedgerSequence": 20
+	}`
+	code, signed, stderr := runCommand(t, tx, nil, "wallet", "sign", "-seed", testSeed)
+	require.Equal(t, 0, code, stderr)
+	var signedOut signedOutput
+	require.NoError(t, json.Unmarshal([]byte(signed), &signedOut))
+
+	node := &testNode{results: map[string]map[string]any{
+		"submit": {"engine_result": "tesSUCCESS", "engine_result_code": 0, "accepted": true},
+		"ledger": {"ledger_index": 15, "ledger_hash": "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A652", "validated": true},
+		"tx":     {"hash": signedOut.Hash, "ledger_index": 20, "validated": true, "meta": map[string]any{"TransactionResult": "tesSUCCESS"}},
+	}}
+	url := node.rpcURL(t)
+
+	out := runJSON(t, signed, nil, "submit", "-url", url)
+	require.Equal(t, signedOut.Hash, out["hash"])
+	require.Equal(t, true, out["validated"])
+	require.Equal(t, []string{"submit", "ledger", "tx"}, node.methods())
+
+	out = runJSON(t, signed, nil, "submit", "-url", url, "-no-wait")
+	require.Equal(t, "tesSUCCESS", out["engine_result"])
+	require.Equal(t, []string{"submit", "ledger", "tx", "submit"}, node.methods())
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// signedOutput is the output of the commands returning a signed transaction blob.
+type signedOutput struct {
+	TxBlob string `json:"tx_blob"`
+	Hash   string `json:"hash"`
+}
+
+func runWalletSign(e *env, args []string) error {
+	fs := newFlagSet(e, "wallet sign")
+	seed := fs.String("seed", "", "seed of the signing key")
+	seedFile := fs.String("seed-file", "", "file of the seed of the signing key")
+	account := fs.String("account", "", "address of the account, when signing with its regular key")
+	multisign := fs.Bool("multisign", false, "sign as one of the multisigners of the transaction")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	s, err := readSecret(e, *seed, *seedFile, "XRPL_SEED")
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		return fmt.Errorf("%w: missing seed", errUsage)
+	}
+	tx, err := readObject(e, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	w, err := wallet.FromSeed(s, *account)
+	if err != nil {
+		return err
+	}
+	var blob, h string
+	if *multisign {
+		blob, h, err = w.Multisign(tx)
+	} else {
+		blob, h, err = w.Sign(tx)
+	}
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, signedOutput{TxBlob: blob, Hash: h})
+}
+
+func runMultisign(e *env, args []string) error {
+	fs := newFlagSet(e, "multisign")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	blobs := fs.Args()
+	if len(blobs) == 0 {
+		var err error
+		if blobs, err = readBlobs(e); err != nil {
+			return err
+		}
+	}
+
+	blob, err := xrpl.Multisign(blobs...)
+	if err != nil {
+		return err
+	}
+	h, err := hash.SignTxBlob(blob)
+	if err != nil {
+		return err
+	}
+	return writeJSON(e, signedOutput{TxBlob: blob, Hash: h})
+}
+
+// readBlobs reads transaction blobs from stdin: a JSON array of blobs, the JSON outputs of wallet sign, or blobs
+// separated by spaces or new lines.
+func readBlobs(e *env) ([]string, error) {
+	b, err := readFile(e, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []string
+	if err := json.Unmarshal(b, &blobs); err == nil {
+		return blobs, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(string(b)))
+	for dec.More() {
+		var out signedOutput
+		if err := dec.Decode(&out); err != nil {
+			return strings.Fields(string(b)), nil
+		}
+		blobs = append(blobs, out.TxBlob)
+	}
+	if len(blobs) == 0 {
+		return nil, errEmptyInput
+	}
+	return blobs, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWallet_Sign(t *testing.T) {
+	signed := runJSON(t, testPayment, nil, "wallet", "sign", "-seed", testSeed)
+	blob, ok := signed["tx_blob"].(string)
+	require.True(t, ok)
+
+	hashed := runJSON(t, "", nil, "hash", blob)
+	require.Equal(t, signed["hash"], hashed["hash"])
+
+	decoded := runJSON(t, "", nil, "codec", "decode", blob)
+	require.Equal(t, testPublicKey, decoded["SigningPubKey"])
+	require.NotEmpty(t, decoded["TxnSignature"])
+
+	fromEnv := runJSON(t, testPayment, map[string]string{"XRPL_SEED": testSeed}, "wallet", "sign")
+	require.Equal(t, signed, fromEnv)
+
+	code, _, stderr := runCommand(t, testPayment, nil, "wallet", "sign")
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr, "missing seed")
+}
+
+func TestMultisign(t *testing.T) {
+	tx := strings.Replace(testPayment, `"Fee": "12"`, `"Fee": "36", "SigningPubKey": ""`, 1)
+	other := runJSON(t, "", nil, "keypairs", "generate")
+
+	var outputs []string
+	for _, seed := range []string{testSeed, other["seed"].(string)} {
+		code, stdout, stderr := runCommand(t, tx, nil, "wallet", "sign", "-multisign", "-seed", seed)
+		require.Equal(t, 0, code, stderr)
+		outputs = append(outputs, stdout)
+	}
+
+	var blobs []string
+	for _, o := range outputs {
+		var out signedOutput
+		require.NoError(t, json.Unmarshal([]byte(o), &out))
+		blobs = append(blobs, out.TxBlob)
+	}
+	arrayInput, err := json.Marshal(blobs)
+	require.NoError(t, err)
+
+	tt := []struct {
+		description string
+		stdin       string
+		args        []string
+	}{
+		{description: "pass - arguments", args: blobs},
+		{description: "pass - wallet sign outputs", stdin: strings.Join(outputs, "")},
+		{description: "pass - JSON array", stdin: string(arrayInput)},
+		{description: "pass - blobs by line", stdin: strings.Join(blobs, "\n")},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			combined := runJSON(t, tc.stdin, nil, append([]string{"multisign"}, tc.args...)...)
+			decoded := runJSON(t, "", nil, "codec", "decode", combined["tx_blob"].(string))
+			require.Len(t, decoded["Signers"], 2)
+			require.Equal(t, "", decoded["SigningPubKey"])
+		})
+	}
+
+	code, _, _ := runCommand(t, " ", nil, "multisign")
+	require.Equal(t, 1, code)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// transactionEvent is the output of the watch command for a transaction of a watched account.
+type transactionEvent struct {
+	Type            string                              `json:"type"`
+	Hash            common.LedgerHash                   `json:"hash"`
+	LedgerIndex     common.LedgerIndex                  `json:"ledger_index"`
+	TransactionType any                                 `json:"transaction_type"`
+	Account         any                                 `json:"account"`
+	Result          string                              `json:"result"`
+	BalanceChanges  []transaction.AccountBalanceChanges `json:"balance_changes"`
+}
+
+// ledgerEvent is the output of the watch command for a closed ledger.
+type ledgerEvent struct {
+	Type        string             `json:"type"`
+	LedgerIndex common.LedgerIndex `json:"ledger_index"`
+	LedgerHash  common.LedgerHash  `json:"ledger_hash"`
+	LedgerTime  uint64             `json:"ledger_time"`
+	TxnCount    int                `json:"txn_count"`
+}
+
+func runWatch(e *env, args []string) error {
+	fs := newFlagSet(e, "watch")
+	net := addNetworkFlags(fs)
+	ledgers := fs.Bool("ledger", false, "print the ledgers as they close")
+	count := fs.Int("count", 0, "exit after printing count events, or run until interrupted if 0")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 && !*ledgers {
+		return fmt.Errorf("%w: missing account or -ledger", errUsage)
+	}
+	accounts := make([]types.Address, 0, fs.NArg())
+	for _, a := range fs.Args() {
+		if !addresscodec.IsValidClassicAddress(a) {
+			return fmt.Errorf("%w: invalid address %q", errUsage, a)
+		}
+		accounts = append(accounts, types.Address(a))
+	}
+
+	conn, err := net.dial(e)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+	if conn.ws == nil {
+		return errWebsocketRequired
+	}
+
+	w := newWatcher(e, accounts, *count)
+	conn.ws.OnTransactions(w.handleTransaction)
+	req := &subscribe.Request{Accounts: accounts}
+	if *ledgers {
+		conn.ws.OnLedgerClosed(w.handleLedger)
+		req.Streams = []string{"ledger"}
+	}
+	if _, err := conn.ws.Subscribe(req); err != nil {
+		return err
+	}
+
+	select {
+	case <-e.ctx.Done():
+		return nil
+	case <-w.done:
+		return nil
+	case err := <-w.errs:
+		return err
+	case err := <-conn.errs:
+		return err
+	}
+}
+
+// watcher prints the events of the watch command, one JSON object per line, until it printed count events.
+type watcher struct {
+	e        *env
+	accounts map[types.Address]bool
+	count    int
+
+	mu      sync.Mutex
+	printed int
+	done    chan struct{}
+	errs    chan error
+}
+
+func newWatcher(e *env, accounts []types.Address, count int) *watcher {
+	w := &watcher{
+		e:        e,
+		accounts: make(map[types.Address]bool, len(accounts)),
+		count:    count,
+		done:     make(chan struct{}),
+		errs:     make(chan error, 1),
+	}
+	for _, a := range accounts {
+		w.accounts[a] = true
+	}
+	return w
+}
+
+// handleTransaction prints a transaction of the watched accounts, with their balance changes.
+func (w *watcher) handleTransaction(tx *streamtypes.TransactionStream) {
+	changes, err := transaction.GetBalanceChanges(&tx.Meta)
+	if err != nil {
+		w.fail(fmt.Errorf("transaction %s: %w", tx.Hash, err))
+		return
+	}
+	watched := make([]transaction.AccountBalanceChanges, 0, len(changes))
+	for _, c := range changes {
+		if w.accounts[c.Account] {
+			watched = append(watched, c)
+		}
+	}
+
+	w.print(transactionEvent{
+		Type:            "transaction",
+		Hash:            tx.Hash,
+		LedgerIndex:     tx.LedgerIndex,
+		TransactionType: tx.Transaction["TransactionType"],
+		Account:         tx.Transaction["Account"],
+		Result:          tx.EngineResult,
+		BalanceChanges:  watched,
+	})
+}
+
+// handleLedger prints a closed ledger.
+func (w *watcher) handleLedger(l *streamtypes.LedgerStream) {
+	w.print(ledgerEvent{
+		Type:        "ledger",
+		LedgerIndex: l.LedgerIndex,
+		LedgerHash:  l.LedgerHash,
+		LedgerTime:  l.LedgerTime,
+		TxnCount:    l.TxnCount,
+	})
+}
+
+// print prints an event, unless the watcher is done, and is done once it printed count events.
+func (w *watcher) print(event any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.count > 0 && w.printed >= w.count {
+		return
+	}
+
+	if err := writeJSONLine(w.e, event); err != nil {
+		w.fail(err)
+		return
+	}
+	w.printed++
+	if w.count > 0 && w.printed == w.count {
+		close(w.done)
+	}
+}
+
+// fail reports the first error of the watcher.
+func (w *watcher) fail(err error) {
+	select {
+	case w.errs <- err:
+	default:
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	watchAlice = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	watchBob   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+)
+
+// accountRootNode returns a modified AccountRoot node of an account with its previous and final balances.
+func accountRootNode(account, previous, final string) map[string]any {
+	return map[string]any{"ModifiedNode": map[string]any{
+		"LedgerEntryType": "AccountRoot",
+		"LedgerIndex":     strings.Repeat("A", 63) + account[1:2],
+		"FinalFields":     map[string]any{"Account": account, "Balance": final},
+		"PreviousFields":  map[string]any{"Balance": previous},
+	}}
+}
+
+func TestWatch(t *testing.T) {
+	payment := map[string]any{
+		"type":               "transaction",
+		"engine_result":      "tesSUCCESS",
+		"engine_result_code": 0,
+		"hash":               strings.Repeat("B", 64),
+		"ledger_index":       11,
+		"validated":          true,
+		"tx_json":            map[string]any{"TransactionType": "Payment", "Account": watchAlice, "Destination": watchBob},
+		"meta": map[string]any{
+			"TransactionResult": "tesSUCCESS",
+			"AffectedNodes": []any{
+				accountRootNode(watchAlice, "100000000", "98999988"),
+				accountRootNode(watchBob, "50000000", "51000000"),
+			},
+		},
+	}
+	ledger := map[string]any{
+		"type":         "ledgerClosed",
+		"ledger_index": 11,
+		"ledger_hash":  strings.Repeat("C", 64),
+		"ledger_time":  800000000,
+		"txn_count":    4,
+	}
+
+	tt := []struct {
+		description string
+		args        []string
+		streams     []map[string]any
+		subscribe   map[string]any
+		expected    []map[string]any
+	}{
+		{
+			description: "pass - account",
+			args:        []string{"-count", "1", watchAlice},
+			streams:     []map[string]any{payment},
+			subscribe:   map[string]any{"accounts": []any{watchAlice}},
+			expected: []map[string]any{{
+				"type":             "transaction",
+				"hash":             strings.Repeat("B", 64),
+				"ledger_index":     float64(11),
+				"transaction_type": "Payment",
+				"account":          watchAlice,
+				"result":           "tesSUCCESS",
+				"balance_changes": []any{map[string]any{
+					"account":  watchAlice,
+					"balances": []any{map[string]any{"amount": "-1.000012", "currency": "XRP"}},
+				}},
+			}},
+		},
+		{
+			description: "pass - ledger",
+			args:        []string{"-ledger", "-count", "1"},
+			streams:     []map[string]any{ledger},
+			subscribe:   map[string]any{"streams": []any{"ledger"}},
+			expected: []map[string]any{{
+				"type":         "ledger",
+				"ledger_index": float64(11),
+				"ledger_hash":  strings.Repeat("C", 64),
+				"ledger_time":  float64(800000000),
+				"txn_count":    float64(4),
+			}},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			node := &testNode{streams: tc.streams}
+			code, stdout, stderr := runCommand(t, "", nil, append([]string{"watch", "-url", node.wsURL(t)}, tc.args...)...)
+			require.Equal(t, 0, code, stderr)
+
+			var events []map[string]any
+			for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+				var event map[string]any
+				require.NoError(t, json.Unmarshal([]byte(line), &event))
+				events = append(events, event)
+			}
+			require.Equal(t, tc.expected, events)
+
+			requests := node.received()
+			require.Len(t, requests, 1)
+			require.Equal(t, "subscribe", requests[0]["command"])
+			for k, v := range tc.subscribe {
+				require.Equal(t, v, requests[0][k])
+			}
+		})
+	}
+}
+
+func TestWatch_Errors(t *testing.T) {
+	node := &testNode{}
+	tt := []struct {
+		description string
+		args        []string
+		code        int
+		stderr      string
+	}{
+		{description: "fail - nothing to watch", args: []string{"watch"}, code: 2, stderr: "missing account or -ledger"},
+		{description: "fail - invalid address", args: []string{"watch", "rNotAnAddress"}, code: 2, stderr: `invalid address "rNotAnAddress"`},
+		{description: "fail - JSON-RPC URL", args: []string{"watch", "-url", node.rpcURL(t), watchAlice}, code: 1, stderr: errWebsocketRequired.Error()},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			code, _, stderr := runCommand(t, "", nil, tc.args...)
+			require.Equal(t, tc.code, code)
+			require.Contains(t, stderr, tc.stderr)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package keypairs
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+var benchmarkKeys = []struct {
+	description string
+	privKey     string
+	pubKey      string
+}{
+	{
+		description: "secp256k1",
+		privKey:     "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+		pubKey:      "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+	},
+	{
+		description: "ed25519",
+		privKey:     "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+		pubKey:      "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+	},
+}
+
+// benchmarkMessage has the size of a typical encoded Payment.
+var benchmarkMessage = make([]byte, 200)
+
+// nolint
+func BenchmarkSign(b *testing.B) {
+	for _, tc := range benchmarkKeys {
+		b.Run(tc.description+"/string", func(b *testing.B) {
+			msg := hex.EncodeToString(benchmarkMessage)
+			for i := 0; i < b.N; i++ {
+				raw, _ := hex.DecodeString(msg)
+				Sign(string(raw), tc.privKey)
+			}
+		})
+		b.Run(tc.description+"/bytes", func(b *testing.B) {
+			key, err := ParsePrivateKey(tc.privKey)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				key.Sign(benchmarkMessage)
+			}
+		})
+	}
+}
+
+// nolint
+func BenchmarkValidate(b *testing.B) {
+	for _, tc := range benchmarkKeys {
+		key, err := ParsePrivateKey(tc.privKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sig, err := key.Sign(benchmarkMessage)
+		if err != nil {
+			b.Fatal(err)
+		}
+		hexSig := hex.EncodeToString(sig)
+
+		b.Run(tc.description+"/string", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Validate(string(benchmarkMessage), tc.pubKey, hexSig)
+			}
+		})
+		b.Run(tc.description+"/bytes", func(b *testing.B) {
+			pubKey, err := ParsePublicKey(tc.pubKey)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pubKey.Verify(benchmarkMessage, sig)
+			}
+		})
+	}
+}
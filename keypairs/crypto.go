@@ -8,10 +8,20 @@ import (
 	"github.com/Peersyst/xrpl-go/pkg/crypto"
 )
 
+const (
+	// secp256k1 compressed public keys are prefixed with 0x02 or 0x03, depending on the parity of the y coordinate.
+	secp256k1EvenPublicKeyPrefix byte = 0x02
+	secp256k1OddPublicKeyPrefix  byte = 0x03
+)
+
 // GetCryptoImplementationFromKey returns the CryptoImplementation based on the key.
+// The key can be a private key or a public key.
 // It returns nil if the key does not match any crypto implementation.
 // Currently, only ED25519 and SECP256K1 are supported.
 func getCryptoImplementationFromKey(k string) interfaces.KeypairCryptoAlg {
+	if len(k) < 2 {
+		return nil
+	}
 	prefix, err := hex.DecodeString(k[:2])
 	if err != nil {
 		return nil
@@ -20,7 +30,8 @@ func getCryptoImplementationFromKey(k string) interfaces.KeypairCryptoAlg {
 	if ed25519 := crypto.ED25519(); prefix[0] == ed25519.Prefix() {
 		return ed25519
 	}
-	if secp256k1 := crypto.SECP256K1(); prefix[0] == secp256k1.Prefix() {
+	if secp256k1 := crypto.SECP256K1(); prefix[0] == secp256k1.Prefix() ||
+		prefix[0] == secp256k1EvenPublicKeyPrefix || prefix[0] == secp256k1OddPublicKeyPrefix {
 		return secp256k1
 	}
 	return nil
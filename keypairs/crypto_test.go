@@ -29,6 +29,16 @@ func TestGetCryptoImplementationFromKey(t *testing.T) {
 			input:    "0003540DE0F1438F58C4822F99795AD3D1F83C8D123C7767228E04185C542C41680D",
 			expected: crypto.SECP256K1(),
 		},
+		{
+			name:     "pass - get SECP256K1 implementation from public key",
+			input:    "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+			expected: crypto.SECP256K1(),
+		},
+		{
+			name:     "fail - empty key",
+			input:    "",
+			expected: nil,
+		},
 		{
 			name:     "pass - get nil implementation",
 			input:    "0103540DE0F1438F58C4822F99795AD3D1F83C8D123C7767228E04185C542C41680D",
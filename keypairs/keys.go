@@ -0,0 +1,137 @@
+package keypairs
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+// PrivateKey is a parsed private key. It keeps the parsed form of the key, so signing
+// with it does not decode the key nor detect its algorithm on every call.
+// It is safe for concurrent use.
+type PrivateKey struct {
+	key crypto.PrivateKey
+}
+
+// NewPrivateKey creates a PrivateKey from its bytes, prefixed with the algorithm prefix:
+// 0xED for ED25519 keys and 0x00 for SECP256K1 keys.
+func NewPrivateKey(b []byte) (*PrivateKey, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidCryptoImplementation
+	}
+
+	var (
+		key crypto.PrivateKey
+		err error
+	)
+	switch b[0] {
+	case crypto.ED25519().Prefix():
+		key, err = crypto.ED25519().ParsePrivateKey(b)
+	case crypto.SECP256K1().Prefix():
+		key, err = crypto.SECP256K1().ParsePrivateKey(b)
+	default:
+		return nil, ErrInvalidCryptoImplementation
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{key: key}, nil
+}
+
+// ParsePrivateKey creates a PrivateKey from its hex representation, as returned by DeriveKeypair.
+func ParsePrivateKey(privKey string) (*PrivateKey, error) {
+	b, err := hex.DecodeString(privKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewPrivateKey(b)
+}
+
+// Sign signs a message with the private key.
+// SECP256K1 signatures are DER encoded, and SECP256K1 keys can not sign empty messages.
+func (k *PrivateKey) Sign(msg []byte) ([]byte, error) {
+	return k.key.Sign(msg)
+}
+
+// PublicKey returns the public key of the private key.
+func (k *PrivateKey) PublicKey() *PublicKey {
+	return &PublicKey{key: k.key.Public()}
+}
+
+// Bytes returns the private key, prefixed with the algorithm prefix.
+func (k *PrivateKey) Bytes() []byte {
+	return k.key.Bytes()
+}
+
+// Hex returns the private key as an uppercase hex string, as returned by DeriveKeypair.
+func (k *PrivateKey) Hex() string {
+	return strings.ToUpper(hex.EncodeToString(k.Bytes()))
+}
+
+// Zero overwrites the private key with zeros, so it does not stay in memory once it is no longer needed.
+// The key must not be used afterwards. Copies returned by Bytes and Hex are not cleared.
+func (k *PrivateKey) Zero() {
+	k.key.Zero()
+}
+
+// PublicKey is a parsed public key. It keeps the parsed form of the key, so verifying
+// signatures with it does not decode the key nor detect its algorithm on every call.
+// It is safe for concurrent use.
+type PublicKey struct {
+	key crypto.PublicKey
+}
+
+// NewPublicKey creates a PublicKey from its bytes: ED25519 keys are prefixed with 0xED
+// and SECP256K1 keys are compressed, so prefixed with 0x02 or 0x03.
+func NewPublicKey(b []byte) (*PublicKey, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidCryptoImplementation
+	}
+
+	var (
+		key crypto.PublicKey
+		err error
+	)
+	switch b[0] {
+	case crypto.ED25519().Prefix():
+		key, err = crypto.ED25519().ParsePublicKey(b)
+	case secp256k1EvenPublicKeyPrefix, secp256k1OddPublicKeyPrefix:
+		key, err = crypto.SECP256K1().ParsePublicKey(b)
+	default:
+		return nil, ErrInvalidCryptoImplementation
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{key: key}, nil
+}
+
+// ParsePublicKey creates a PublicKey from its hex representation, as returned by DeriveKeypair.
+func ParsePublicKey(pubKey string) (*PublicKey, error) {
+	b, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewPublicKey(b)
+}
+
+// Verify reports whether sig is a valid signature of msg by the public key.
+func (k *PublicKey) Verify(msg, sig []byte) bool {
+	return k.key.Verify(msg, sig)
+}
+
+// Bytes returns the public key, as encoded in the SigningPubKey field of transactions.
+func (k *PublicKey) Bytes() []byte {
+	return k.key.Bytes()
+}
+
+// Hex returns the public key as an uppercase hex string, as returned by DeriveKeypair.
+func (k *PublicKey) Hex() string {
+	return strings.ToUpper(hex.EncodeToString(k.Bytes()))
+}
+
+// ClassicAddress returns the classic address of the public key.
+func (k *PublicKey) ClassicAddress() (string, error) {
+	return DeriveClassicAddress(k.Hex())
+}
@@ -0,0 +1,177 @@
+package keypairs
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrivateKey(t *testing.T) {
+	tt := []struct {
+		name           string
+		privKey        string
+		expectedPubKey string
+		expectedErr    error
+	}{
+		{
+			name:           "pass - secp256k1",
+			privKey:        "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedPubKey: "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+		},
+		{
+			name:           "pass - ed25519",
+			privKey:        "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expectedPubKey: "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+		},
+		{
+			name:        "fail - unknown prefix",
+			privKey:     "01395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedErr: ErrInvalidCryptoImplementation,
+		},
+		{
+			name:        "fail - empty key",
+			privKey:     "",
+			expectedErr: ErrInvalidCryptoImplementation,
+		},
+		{
+			name:        "fail - invalid length",
+			privKey:     "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4",
+			expectedErr: crypto.ErrInvalidPrivateKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tc.privKey)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.privKey, key.Hex())
+			require.Equal(t, tc.expectedPubKey, key.PublicKey().Hex())
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	tt := []struct {
+		name            string
+		pubKey          string
+		expectedAddress string
+		expectedErr     error
+	}{
+		{
+			name:            "pass - secp256k1",
+			pubKey:          "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+			expectedAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		},
+		{
+			name:            "pass - ed25519",
+			pubKey:          "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+			expectedAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		},
+		{
+			name:        "fail - private key prefix",
+			pubKey:      "0030E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+			expectedErr: ErrInvalidCryptoImplementation,
+		},
+		{
+			name:        "fail - invalid length",
+			pubKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8",
+			expectedErr: crypto.ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := ParsePublicKey(tc.pubKey)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.pubKey, key.Hex())
+
+			address, err := key.ClassicAddress()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAddress, address)
+		})
+	}
+}
+
+func TestPrivateKey_Sign(t *testing.T) {
+	tt := []struct {
+		name string
+		seed string
+	}{
+		{
+			name: "pass - secp256k1",
+			seed: "sp5fghtJtpUorTwvof1NpDXAzNwf5",
+		},
+		{
+			name: "pass - ed25519",
+			seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r",
+		},
+	}
+
+	msg := []byte("This message is signed with a parsed key.")
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			priv, pub, err := DeriveKeypair(tc.seed, false)
+			require.NoError(t, err)
+
+			privKey, err := ParsePrivateKey(priv)
+			require.NoError(t, err)
+			pubKey, err := ParsePublicKey(pub)
+			require.NoError(t, err)
+
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+			require.True(t, pubKey.Verify(msg, sig))
+			require.False(t, pubKey.Verify(msg[1:], sig))
+
+			// The string API produces the same signatures.
+			expected, err := Sign(string(msg), priv)
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(sig)))
+
+			valid, err := Validate(string(msg), pub, strings.ToUpper(hex.EncodeToString(sig)))
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestPrivateKey_Zero(t *testing.T) {
+	tt := []struct {
+		name     string
+		privKey  string
+		expected string
+	}{
+		{
+			name:     "pass - secp256k1",
+			privKey:  "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expected: "00" + strings.Repeat("00", 32),
+		},
+		{
+			name:     "pass - ed25519",
+			privKey:  "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expected: "ED" + strings.Repeat("00", 32),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tc.privKey)
+			require.NoError(t, err)
+
+			key.Zero()
+			require.Equal(t, tc.expected, key.Hex())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keypairs
+
+import (
+	"encoding/hex"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+// DeriveNodeKeypair derives a node (validator) key pair from a given seed. Returns a tuple of private key and public key.
+// For SECP256K1 seeds, it is the root key pair of the seed. For ED25519 seeds, it is the same as the account key pair.
+// The seed has to be encoded using the addresscodec package. Otherwise, it returns an error.
+func DeriveNodeKeypair(seed string) (private, public string, err error) {
+	return DeriveKeypair(seed, true)
+}
+
+// EncodeNodePublicKey encodes a hex public key as a base58 node public key, as used by rippled to identify validators.
+func EncodeNodePublicKey(pubKey string) (string, error) {
+	b, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return "", err
+	}
+	return addresscodec.EncodeNodePublicKey(b)
+}
+
+// DecodeNodePublicKey decodes a base58 node public key into a hex public key.
+func DecodeNodePublicKey(nodePubKey string) (string, error) {
+	b, err := addresscodec.DecodeNodePublicKey(nodePubKey)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(b)), nil
+}
+
+// EncodeNodePrivateKey encodes a hex private key as a base58 node private key.
+// The algorithm prefix of the private key is not part of the encoding.
+func EncodeNodePrivateKey(privKey string) (string, error) {
+	if getCryptoImplementationFromKey(privKey) == nil {
+		return "", ErrInvalidCryptoImplementation
+	}
+	b, err := hex.DecodeString(privKey)
+	if err != nil {
+		return "", err
+	}
+	return addresscodec.EncodeNodePrivateKey(b[1:])
+}
+
+// DecodeNodePrivateKey decodes a base58 node private key into a hex private key of the given algorithm.
+// The encoding does not include the algorithm, so it has to be provided.
+func DecodeNodePrivateKey(nodePrivKey string, alg crypto.Algorithm) (string, error) {
+	b, err := addresscodec.DecodeNodePrivateKey(nodePrivKey)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(append([]byte{alg.Prefix()}, b...))), nil
+}
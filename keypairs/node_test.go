@@ -0,0 +1,102 @@
+package keypairs
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveNodeKeypair(t *testing.T) {
+	tt := []struct {
+		name            string
+		seed            string
+		expectedPrivKey string
+		expectedPubKey  string
+		expectedNodeKey string
+	}{
+		{
+			name:            "pass - secp256k1",
+			seed:            "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+			expectedPrivKey: "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedPubKey:  "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+			expectedNodeKey: "n94a1u4jAz288pZLtw6yFWVbi89YamiC6JBXPVUj5zmExe5fTVg9",
+		},
+		{
+			name:            "pass - secp256k1 differs from the account keypair",
+			seed:            "sp5fghtJtpUorTwvof1NpDXAzNwf5",
+			expectedPrivKey: "001A6B48BF0DE7C7E425B61E0444E3921182B6529867685257CEDC3E7EF13F0F18",
+			expectedPubKey:  "03B462771E99AAE9C7912AF47D6120C0B0DA972A4043A17F26320A52056DA46EA8",
+			expectedNodeKey: "n9MigjRnnVeGMbYJxW3k4cJa2jom6hnCesPJuToFwzYwczpUfbrK",
+		},
+		{
+			name:            "pass - ed25519",
+			seed:            "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r",
+			expectedPrivKey: "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expectedPubKey:  "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+			expectedNodeKey: "nHB4JiHScG1RWKhfzXTFahpv1gcTn3BkZLRqhVGj4rNRoN9MQ43P",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			priv, pub, err := DeriveNodeKeypair(tc.seed)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrivKey, priv)
+			require.Equal(t, tc.expectedPubKey, pub)
+
+			nodeKey, err := EncodeNodePublicKey(pub)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNodeKey, nodeKey)
+
+			decoded, err := DecodeNodePublicKey(nodeKey)
+			require.NoError(t, err)
+			require.Equal(t, pub, decoded)
+		})
+	}
+}
+
+func TestEncodeDecodeNodePrivateKey(t *testing.T) {
+	tt := []struct {
+		name        string
+		privKey     string
+		alg         crypto.Algorithm
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - secp256k1",
+			privKey:  "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			alg:      crypto.SECP256K1(),
+			expected: "pnen77YEeUd4fFKG7iycBWcwKpTaeFRkW2WFostaATy1DSupwXe",
+		},
+		{
+			name:    "pass - ed25519",
+			privKey: "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			alg:     crypto.ED25519(),
+		},
+		{
+			name:        "fail - unknown algorithm",
+			privKey:     "01395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedErr: ErrInvalidCryptoImplementation,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := EncodeNodePrivateKey(tc.privKey)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expected != "" {
+				require.Equal(t, tc.expected, encoded)
+			}
+
+			decoded, err := DecodeNodePrivateKey(encoded, tc.alg)
+			require.NoError(t, err)
+			require.Equal(t, tc.privKey, decoded)
+		})
+	}
+}
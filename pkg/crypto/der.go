@@ -87,7 +87,12 @@ func DERHexToSig(hexSignature string) ([]byte, []byte, error) {
 	if err != nil {
 		return nil, nil, ErrInvalidHexString
 	}
+	return DERToSig(data)
+}
 
+// DERToSig converts a DER-encoded signature to r and s byte slices.
+// It returns the r and s byte slices and an error if any occurred during the process.
+func DERToSig(data []byte) ([]byte, []byte, error) {
 	if len(data) < 2 || data[0] != 0x30 {
 		return nil, nil, ErrInvalidDERSignature
 	}
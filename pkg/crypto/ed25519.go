@@ -40,10 +40,8 @@ func (c ED25519CryptoAlgorithm) FamilySeedPrefix() byte {
 }
 
 // DeriveKeypair derives a keypair from a seed.
-func (c ED25519CryptoAlgorithm) DeriveKeypair(decodedSeed []byte, validator bool) (string, string, error) {
-	if validator {
-		return "", "", ErrValidatorNotSupported
-	}
+// Ed25519 account and validator keypairs are derived the same way, so validator is ignored.
+func (c ED25519CryptoAlgorithm) DeriveKeypair(decodedSeed []byte, _ bool) (string, string, error) {
 	rawPriv := Sha512Half(decodedSeed)
 	pubKey, privKey, err := ed25519.GenerateKey(bytes.NewBuffer(rawPriv))
 	if err != nil {
@@ -56,21 +54,42 @@ func (c ED25519CryptoAlgorithm) DeriveKeypair(decodedSeed []byte, validator bool
 	return private, public, nil
 }
 
+// ParsePrivateKey parses a private key, prefixed with the ED25519 prefix.
+func (c ED25519CryptoAlgorithm) ParsePrivateKey(b []byte) (PrivateKey, error) {
+	if len(b) != ed25519.SeedSize+1 || b[0] != c.prefix {
+		return nil, ErrInvalidPrivateKey
+	}
+	return c.newPrivateKey(b[1:]), nil
+}
+
+// ParsePublicKey parses a public key, prefixed with the ED25519 prefix.
+func (c ED25519CryptoAlgorithm) ParsePublicKey(b []byte) (PublicKey, error) {
+	if len(b) != ed25519.PublicKeySize+1 || b[0] != c.prefix {
+		return nil, ErrInvalidPublicKey
+	}
+	return c.newPublicKey(b[1:]), nil
+}
+
 // Sign signs a message using the ED25519 algorithm with the provided private key.
 func (c ED25519CryptoAlgorithm) Sign(msg, privKey string) (string, error) {
 	b, err := hex.DecodeString(privKey)
 	if err != nil {
 		return "", err
 	}
-	rawPriv := ed25519.NewKeyFromSeed(b[1:])
-	signedMsg := ed25519.Sign(rawPriv, []byte(msg))
+	if len(b) != ed25519.SeedSize+1 {
+		return "", ErrInvalidPrivateKey
+	}
+	signedMsg, err := c.newPrivateKey(b[1:]).Sign([]byte(msg))
+	if err != nil {
+		return "", err
+	}
 	return strings.ToUpper(hex.EncodeToString(signedMsg)), nil
 }
 
 // Validate validates a signature for a message with a public key.
 func (c ED25519CryptoAlgorithm) Validate(msg, pubkey, sig string) bool {
 	bp, err := hex.DecodeString(pubkey)
-	if err != nil {
+	if err != nil || len(bp) != ed25519.PublicKeySize+1 {
 		return false
 	}
 
@@ -79,5 +98,57 @@ func (c ED25519CryptoAlgorithm) Validate(msg, pubkey, sig string) bool {
 		return false
 	}
 
-	return ed25519.Verify(ed25519.PublicKey(bp[1:]), []byte(msg), bs)
+	return c.newPublicKey(bp[1:]).Verify([]byte(msg), bs)
+}
+
+// newPrivateKey creates a private key from an unprefixed ED25519 seed.
+func (c ED25519CryptoAlgorithm) newPrivateKey(seed []byte) *ed25519PrivateKey {
+	return &ed25519PrivateKey{prefix: c.prefix, key: ed25519.NewKeyFromSeed(seed)}
+}
+
+// newPublicKey creates a public key from an unprefixed ED25519 public key.
+func (c ED25519CryptoAlgorithm) newPublicKey(key []byte) *ed25519PublicKey {
+	return &ed25519PublicKey{prefix: c.prefix, key: ed25519.PublicKey(bytes.Clone(key))}
+}
+
+// ed25519PrivateKey is a parsed ED25519 private key.
+type ed25519PrivateKey struct {
+	prefix byte
+	key    ed25519.PrivateKey
+}
+
+// Sign implements the PrivateKey interface.
+func (k *ed25519PrivateKey) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(k.key, msg), nil
+}
+
+// Public implements the PrivateKey interface.
+func (k *ed25519PrivateKey) Public() PublicKey {
+	return &ed25519PublicKey{prefix: k.prefix, key: k.key.Public().(ed25519.PublicKey)}
+}
+
+// Bytes implements the PrivateKey interface.
+func (k *ed25519PrivateKey) Bytes() []byte {
+	return append([]byte{k.prefix}, k.key.Seed()...)
+}
+
+// Zero implements the PrivateKey interface.
+func (k *ed25519PrivateKey) Zero() {
+	clear(k.key)
+}
+
+// ed25519PublicKey is a parsed ED25519 public key.
+type ed25519PublicKey struct {
+	prefix byte
+	key    ed25519.PublicKey
+}
+
+// Verify implements the PublicKey interface.
+func (k *ed25519PublicKey) Verify(msg, sig []byte) bool {
+	return ed25519.Verify(k.key, msg, sig)
+}
+
+// Bytes implements the PublicKey interface.
+func (k *ed25519PublicKey) Bytes() []byte {
+	return append([]byte{k.prefix}, k.key...)
 }
@@ -1,6 +1,8 @@
 package crypto
 
 import (
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,12 +34,12 @@ func TestED25519DeriveKeypair(t *testing.T) {
 			expErr:     nil,
 		},
 		{
-			name:       "fail - error if validator is set to true",
+			name:       "pass - validator keypair is derived as the account keypair",
 			seedBytes:  []byte{102, 97, 107, 101, 82, 97, 110, 100, 111, 109, 83, 116, 114, 105, 110, 103},
 			validator:  true,
-			expPubKey:  "",
-			expPrivKey: "",
-			expErr:     ErrValidatorNotSupported,
+			expPubKey:  "ED4924A9045FE5ED8B22BAA7B6229A72A287CCF3EA287AADD3A032A24C0F008FA6",
+			expPrivKey: "EDBB3ECA8985E1484FA6A28C4B30FB0042A2CC5DF3EC8DC37B5F3D126DDFD3CA14",
+			expErr:     nil,
 		},
 	}
 
@@ -154,3 +156,91 @@ func TestED25519Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestED25519ParsePrivateKey(t *testing.T) {
+	tt := []struct {
+		name           string
+		input          string
+		expectedPubKey string
+		expectedErr    error
+	}{
+		{
+			name:           "pass - valid private key",
+			input:          "EDB4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expectedPubKey: "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+		},
+		{
+			name:        "fail - missing prefix",
+			input:       "B4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expectedErr: ErrInvalidPrivateKey,
+		},
+		{
+			name:        "fail - wrong prefix",
+			input:       "00B4C4E046826BD26190D09715FC31F4E6A728204EADD112905B08B14B7F15C4F3",
+			expectedErr: ErrInvalidPrivateKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.input)
+			require.NoError(t, err)
+
+			key, err := ED25519().ParsePrivateKey(b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, b, key.Bytes())
+			require.Equal(t, tc.expectedPubKey, strings.ToUpper(hex.EncodeToString(key.Public().Bytes())))
+
+			sig, err := key.Sign([]byte("hello world"))
+			require.NoError(t, err)
+			require.True(t, key.Public().Verify([]byte("hello world"), sig))
+			require.False(t, key.Public().Verify([]byte("hello world!"), sig))
+
+			expected, err := ED25519().Sign("hello world", tc.input)
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(sig)))
+		})
+	}
+}
+
+func TestED25519ParsePublicKey(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{
+			name:  "pass - valid public key",
+			input: "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+		},
+		{
+			name:        "fail - too short",
+			input:       "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A",
+			expectedErr: ErrInvalidPublicKey,
+		},
+		{
+			name:        "fail - wrong prefix",
+			input:       "0201FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+			expectedErr: ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.input)
+			require.NoError(t, err)
+
+			key, err := ED25519().ParsePublicKey(b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, b, key.Bytes())
+		})
+	}
+}
@@ -6,12 +6,18 @@ var (
 	// keypair
 
 	// ErrValidatorKeypairDerivation is returned when a validator keypair is attempted to be derived
+	//
+	// Deprecated: validator keypairs are supported by both algorithms, so it is no longer returned.
 	ErrValidatorKeypairDerivation = errors.New("validator keypair derivation not supported")
 	// ErrInvalidPrivateKey is returned when a private key is invalid
 	ErrInvalidPrivateKey = errors.New("invalid private key")
+	// ErrInvalidPublicKey is returned when a public key is invalid
+	ErrInvalidPublicKey = errors.New("invalid public key")
 	// ErrInvalidMessage is returned when a message is required but not provided
 	ErrInvalidMessage = errors.New("message is required")
 	// ErrValidatorNotSupported is returned when a validator keypair is used with the ED25519 algorithm.
+	//
+	// Deprecated: validator keypairs are supported by both algorithms, so it is no longer returned.
 	ErrValidatorNotSupported = errors.New("validator keypairs can not use Ed25519")
 
 	// der
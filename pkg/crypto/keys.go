@@ -0,0 +1,23 @@
+package crypto
+
+// PrivateKey is a private key parsed by a cryptographic algorithm.
+// Parsing is done once, so signing with a PrivateKey does not decode the key on every call.
+type PrivateKey interface {
+	// Sign signs a message. SECP256K1 signatures are DER encoded.
+	Sign(msg []byte) ([]byte, error)
+	// Public returns the public key of the private key.
+	Public() PublicKey
+	// Bytes returns the private key, prefixed with the algorithm prefix.
+	Bytes() []byte
+	// Zero overwrites the private key with zeros. The key must not be used afterwards.
+	Zero()
+}
+
+// PublicKey is a public key parsed by a cryptographic algorithm.
+type PublicKey interface {
+	// Verify reports whether sig is a valid signature of msg by the public key.
+	Verify(msg, sig []byte) bool
+	// Bytes returns the public key, prefixed with the algorithm prefix for ED25519 keys
+	// and compressed for SECP256K1 keys.
+	Bytes() []byte
+}
@@ -85,6 +85,7 @@ func (c SECP256K1CryptoAlgorithm) deriveScalar(bytes []byte, discrim *big.Int) *
 }
 
 // DeriveKeypair derives a keypair from a seed.
+// Validator keypairs are the root keypair of the seed, while account keypairs are derived from it.
 func (c SECP256K1CryptoAlgorithm) DeriveKeypair(seed []byte, validator bool) (string, string, error) {
 	curve := btcec.S256()
 	order := curve.N
@@ -92,7 +93,9 @@ func (c SECP256K1CryptoAlgorithm) DeriveKeypair(seed []byte, validator bool) (st
 	privateGen := c.deriveScalar(seed, nil)
 
 	if validator {
-		return "", "", ErrValidatorKeypairDerivation
+		privKey, pubKey := btcec.PrivKeyFromBytes(privateGen.FillBytes(make([]byte, 32)))
+		private := strings.ToUpper(hex.EncodeToString(privKey.Serialize()))
+		return "00" + private, strings.ToUpper(hex.EncodeToString(pubKey.SerializeCompressed())), nil
 	}
 
 	rootPrivateKey, _ := btcec.PrivKeyFromBytes(privateGen.Bytes())
@@ -111,14 +114,31 @@ func (c SECP256K1CryptoAlgorithm) DeriveKeypair(seed []byte, validator bool) (st
 	return "00" + private, strings.ToUpper(hex.EncodeToString(pubKeyBytes)), nil
 }
 
+// ParsePrivateKey parses a private key, either prefixed with the SECP256K1 prefix or unprefixed.
+func (c SECP256K1CryptoAlgorithm) ParsePrivateKey(b []byte) (PrivateKey, error) {
+	switch {
+	case len(b) == secp256k1.PrivKeyBytesLen+1 && b[0] == c.prefix:
+		b = b[1:]
+	case len(b) != secp256k1.PrivKeyBytesLen:
+		return nil, ErrInvalidPrivateKey
+	}
+	return c.newPrivateKey(b), nil
+}
+
+// ParsePublicKey parses a public key, in compressed or uncompressed form.
+func (c SECP256K1CryptoAlgorithm) ParsePublicKey(b []byte) (PublicKey, error) {
+	key, err := secp256k1.ParsePubKey(b)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return &secp256k1PublicKey{key: key}, nil
+}
+
 // Sign signs a message with a private key.
 func (c SECP256K1CryptoAlgorithm) Sign(msg, privKey string) (string, error) {
 	if len(privKey) != 64 && len(privKey) != 66 {
 		return "", ErrInvalidPrivateKey
 	}
-	if len(msg) == 0 {
-		return "", ErrInvalidMessage
-	}
 
 	if len(privKey) == 66 {
 		privKey = privKey[2:]
@@ -128,23 +148,79 @@ func (c SECP256K1CryptoAlgorithm) Sign(msg, privKey string) (string, error) {
 		return "", ErrInvalidPrivateKey
 	}
 
-	secpPrivKey := secp256k1.PrivKeyFromBytes(key)
-	sig := ecdsa.Sign(secpPrivKey, Sha512Half([]byte(msg)))
-
-	parsedSig, err := DERHexFromSig(sig.R().String(), sig.S().String())
+	sig, err := c.newPrivateKey(key).Sign([]byte(msg))
 	if err != nil {
 		return "", err
 	}
-	return strings.ToUpper(parsedSig), nil
+	return strings.ToUpper(hex.EncodeToString(sig)), nil
 }
 
 // Validate validates a signature for a message with a public key.
 func (c SECP256K1CryptoAlgorithm) Validate(msg, pubkey, sig string) bool {
-	// Decode the signature from DERHex to a hex string
-	r, s, err := DERHexToSig(sig)
+	// Decode the signature and the pubkey from hex to byte slices
+	sigBytes, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	pubkeyBytes, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return false
+	}
+
+	pubKey, err := c.ParsePublicKey(pubkeyBytes)
 	if err != nil {
 		return false
 	}
+	return pubKey.Verify([]byte(msg), sigBytes)
+}
+
+// newPrivateKey creates a private key from an unprefixed SECP256K1 private key.
+func (c SECP256K1CryptoAlgorithm) newPrivateKey(key []byte) *secp256k1PrivateKey {
+	return &secp256k1PrivateKey{prefix: c.prefix, key: secp256k1.PrivKeyFromBytes(key)}
+}
+
+// secp256k1PrivateKey is a parsed SECP256K1 private key.
+type secp256k1PrivateKey struct {
+	prefix byte
+	key    *secp256k1.PrivateKey
+}
+
+// Sign implements the PrivateKey interface.
+// The message is hashed with Sha512Half and the signature is returned in canonical DER form.
+func (k *secp256k1PrivateKey) Sign(msg []byte) ([]byte, error) {
+	if len(msg) == 0 {
+		return nil, ErrInvalidMessage
+	}
+	return ecdsa.Sign(k.key, Sha512Half(msg)).Serialize(), nil
+}
+
+// Public implements the PrivateKey interface.
+func (k *secp256k1PrivateKey) Public() PublicKey {
+	return &secp256k1PublicKey{key: k.key.PubKey()}
+}
+
+// Bytes implements the PrivateKey interface.
+func (k *secp256k1PrivateKey) Bytes() []byte {
+	return append([]byte{k.prefix}, k.key.Serialize()...)
+}
+
+// Zero implements the PrivateKey interface.
+func (k *secp256k1PrivateKey) Zero() {
+	k.key.Zero()
+}
+
+// secp256k1PublicKey is a parsed SECP256K1 public key.
+type secp256k1PublicKey struct {
+	key *secp256k1.PublicKey
+}
+
+// Verify implements the PublicKey interface.
+// The signature is expected to be DER encoded.
+func (k *secp256k1PublicKey) Verify(msg, sig []byte) bool {
+	r, s, err := DERToSig(sig)
+	if err != nil || len(r) > 32 || len(s) > 32 {
+		return false
+	}
 
 	// Convert r and s slices to [32]byte arrays
 	var rBytes, sBytes [32]byte
@@ -158,22 +234,12 @@ func (c SECP256K1CryptoAlgorithm) Validate(msg, pubkey, sig string) bool {
 	ecdsaR.SetBytes(&rBytes)
 	ecdsaS.SetBytes(&sBytes)
 
-	parsedSig := ecdsa.NewSignature(ecdsaR, ecdsaS)
-	// Hash the message
-	hash := Sha512Half([]byte(msg))
-
-	// Decode the pubkey from hex to a byte slice
-	pubkeyBytes, err := hex.DecodeString(pubkey)
-	if err != nil {
-		return false
-	}
+	return ecdsa.NewSignature(ecdsaR, ecdsaS).Verify(Sha512Half(msg), k.key)
+}
 
-	// Verify the signature
-	pubKey, err := secp256k1.ParsePubKey(pubkeyBytes)
-	if err != nil {
-		return false
-	}
-	return parsedSig.Verify(hash, pubKey)
+// Bytes implements the PublicKey interface.
+func (k *secp256k1PublicKey) Bytes() []byte {
+	return k.key.SerializeCompressed()
 }
 
 // DerivePublicKeyFromPublicGenerator derives a public key from a public generator.
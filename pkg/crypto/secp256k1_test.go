@@ -1,7 +1,10 @@
 package crypto
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,12 +44,12 @@ func TestSecp256k1_deriveKeypair(t *testing.T) {
 			expectedErr:     nil,
 		},
 		{
-			name:            "fail - validator set to true",
+			name:            "pass - validator keypair is the root keypair",
 			seedBytes:       []byte{124, 228, 51, 247, 54, 54, 81, 51, 239, 86, 226, 187, 232, 20, 111, 163},
 			validator:       true,
-			expectedPubKey:  "",
-			expectedPrivKey: "",
-			expectedErr:     ErrValidatorKeypairDerivation,
+			expectedPubKey:  "03C1BE10335B9B82B62A7562B5F957552389882921F0E6118AC48C15D632F446FE",
+			expectedPrivKey: "006054C534A4E53BF1BCBD913936C080035DE37577BA838A1B7CD38342AA754CCD",
+			expectedErr:     nil,
 		},
 	}
 
@@ -264,3 +267,118 @@ func TestSecp256k1_DerivePublicKeyFromPublicGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestSecp256k1_ParsePrivateKey(t *testing.T) {
+	tt := []struct {
+		name           string
+		input          string
+		expectedBytes  string
+		expectedPubKey string
+		expectedErr    error
+	}{
+		{
+			name:           "pass - prefixed private key",
+			input:          "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedBytes:  "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedPubKey: "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+		},
+		{
+			name:           "pass - unprefixed private key",
+			input:          "395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedBytes:  "00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedPubKey: "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+		},
+		{
+			name:        "fail - wrong prefix",
+			input:       "ED395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55",
+			expectedErr: ErrInvalidPrivateKey,
+		},
+		{
+			name:        "fail - invalid length",
+			input:       "395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E",
+			expectedErr: ErrInvalidPrivateKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.input)
+			require.NoError(t, err)
+
+			key, err := SECP256K1().ParsePrivateKey(b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedBytes, strings.ToUpper(hex.EncodeToString(key.Bytes())))
+			require.Equal(t, tc.expectedPubKey, strings.ToUpper(hex.EncodeToString(key.Public().Bytes())))
+
+			_, err = key.Sign(nil)
+			require.ErrorIs(t, err, ErrInvalidMessage)
+		})
+	}
+}
+
+func TestSecp256k1_ParsePublicKey(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{
+			name:  "pass - compressed public key",
+			input: "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71A8",
+		},
+		{
+			name:        "fail - invalid length",
+			input:       "03D49C56E1B185F1BE899AE66A02EFC17F78EA6FC53AF85E0FE54C6E8B7F8C71",
+			expectedErr: ErrInvalidPublicKey,
+		},
+		{
+			name:        "fail - ed25519 prefix",
+			input:       "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63",
+			expectedErr: ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.input)
+			require.NoError(t, err)
+
+			key, err := SECP256K1().ParsePublicKey(b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, b, key.Bytes())
+		})
+	}
+}
+
+// The byte signing path serializes signatures with the secp256k1 library, while it used to build the
+// DER encoding from the hex form of r and s. Both encodings must be identical.
+func TestSecp256k1_SignMatchesDERHexFromSig(t *testing.T) {
+	b, err := hex.DecodeString("00395898665728F57DE5D90F1DE102278A967D6941A45A6C9A98CB123394489E55")
+	require.NoError(t, err)
+	key, err := SECP256K1().ParsePrivateKey(b)
+	require.NoError(t, err)
+
+	for i := range 256 {
+		msg := []byte(fmt.Sprintf("message %d", i))
+
+		sig, err := key.Sign(msg)
+		require.NoError(t, err)
+
+		r, s, err := DERToSig(sig)
+		require.NoError(t, err)
+		expected, err := DERHexFromSig(hex.EncodeToString(r), hex.EncodeToString(s))
+		require.NoError(t, err)
+		require.Equal(t, expected, hex.EncodeToString(sig))
+
+		require.True(t, key.Public().Verify(msg, sig))
+		require.False(t, key.Public().Verify(append(msg, '!'), sig))
+	}
+}
@@ -0,0 +1,27 @@
+package rfc1751
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidKeyLength is returned when the length of the key to encode is not a multiple of 8 bytes.
+	ErrInvalidKeyLength = errors.New("rfc1751: key length must be a multiple of 8 bytes")
+	// ErrInvalidWordCount is returned when the number of words to decode is not a multiple of 6.
+	ErrInvalidWordCount = errors.New("rfc1751: word count must be a multiple of 6")
+	// ErrInvalidParity is returned when the parity bits of a group of words do not match its key bits.
+	ErrInvalidParity = errors.New("rfc1751: invalid parity")
+)
+
+// Dynamic errors
+
+// ErrUnknownWord is returned when a word is not in the RFC 1751 dictionary.
+type ErrUnknownWord struct {
+	Word string
+}
+
+// Error implements the error interface for ErrUnknownWord
+func (e ErrUnknownWord) Error() string {
+	return fmt.Sprintf("rfc1751: unknown word %q", e.Word)
+}
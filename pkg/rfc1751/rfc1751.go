@@ -0,0 +1,113 @@
+// Package rfc1751 implements RFC 1751, which encodes binary keys as sequences of short English words.
+//
+// Every 8 bytes of key are encoded as 6 words of 11 bits each: 64 bits of key followed by 2 parity bits.
+package rfc1751
+
+import (
+	"encoding/binary"
+	"sort"
+	"strings"
+)
+
+const (
+	// blockSize is the number of key bytes encoded by a group of words.
+	blockSize = 8
+	// wordsPerBlock is the number of words encoding a block of key bytes.
+	wordsPerBlock = 6
+)
+
+// Encode encodes a key as RFC 1751 words, separated by spaces. The key length must be a multiple of 8 bytes.
+func Encode(key []byte) (string, error) {
+	if len(key) == 0 || len(key)%blockSize != 0 {
+		return "", ErrInvalidKeyLength
+	}
+
+	out := make([]string, 0, len(key)/blockSize*wordsPerBlock)
+	for i := 0; i < len(key); i += blockSize {
+		out = append(out, encodeBlock(binary.BigEndian.Uint64(key[i:i+blockSize]))...)
+	}
+
+	return strings.Join(out, " "), nil
+}
+
+// Decode decodes RFC 1751 words, separated by whitespace, into a key. The number of words must be a multiple of 6.
+// Words are case insensitive and, as in RFC 1751, the digits 0, 1 and 5 are read as the letters O, L and S.
+func Decode(mnemonic string) ([]byte, error) {
+	fields := strings.Fields(mnemonic)
+	if len(fields) == 0 || len(fields)%wordsPerBlock != 0 {
+		return nil, ErrInvalidWordCount
+	}
+
+	key := make([]byte, 0, len(fields)/wordsPerBlock*blockSize)
+	for i := 0; i < len(fields); i += wordsPerBlock {
+		block, err := decodeBlock(fields[i : i+wordsPerBlock])
+		if err != nil {
+			return nil, err
+		}
+		key = binary.BigEndian.AppendUint64(key, block)
+	}
+
+	return key, nil
+}
+
+// encodeBlock encodes 64 bits of key as 6 words. The last word holds the 9 last bits of key and the 2 parity bits.
+func encodeBlock(block uint64) []string {
+	out := make([]string, wordsPerBlock)
+	for i := 0; i < wordsPerBlock-1; i++ {
+		out[i] = words[block>>(53-11*i)&0x7FF]
+	}
+	out[wordsPerBlock-1] = words[(block&0x1FF)<<2|parity(block)]
+	return out
+}
+
+// decodeBlock decodes 6 words into 64 bits of key, checking the parity bits.
+func decodeBlock(blockWords []string) (uint64, error) {
+	var block uint64
+	var last uint64
+	for i, w := range blockWords {
+		index, err := wordIndex(w)
+		if err != nil {
+			return 0, err
+		}
+		if i < wordsPerBlock-1 {
+			block |= index << (53 - 11*i)
+		} else {
+			last = index
+		}
+	}
+	block |= last >> 2
+
+	if parity(block) != last&0x3 {
+		return 0, ErrInvalidParity
+	}
+
+	return block, nil
+}
+
+// parity returns the sum of the 2-bit groups of the block, modulo 4.
+func parity(block uint64) uint64 {
+	var p uint64
+	for i := 0; i < 64; i += 2 {
+		p += block >> i & 0x3
+	}
+	return p & 0x3
+}
+
+// wordIndex returns the index of a word in the dictionary, after normalizing it.
+func wordIndex(w string) (uint64, error) {
+	normalized := strings.NewReplacer("1", "L", "0", "O", "5", "S").Replace(strings.ToUpper(w))
+
+	lo, hi := 0, shortWordCount
+	if len(normalized) == 4 {
+		lo, hi = shortWordCount, len(words)
+	} else if len(normalized) == 0 || len(normalized) > 4 {
+		return 0, ErrUnknownWord{Word: w}
+	}
+
+	i := lo + sort.SearchStrings(words[lo:hi], normalized)
+	if i == hi || words[i] != normalized {
+		return 0, ErrUnknownWord{Word: w}
+	}
+
+	return uint64(i), nil
+}
@@ -0,0 +1,111 @@
+package rfc1751
+
+import (
+	"encoding/hex"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWords(t *testing.T) {
+	require.True(t, sort.StringsAreSorted(words[:shortWordCount]))
+	require.True(t, sort.StringsAreSorted(words[shortWordCount:]))
+	for i, w := range words {
+		if i < shortWordCount {
+			require.LessOrEqual(t, len(w), 3)
+		} else {
+			require.Len(t, w, 4)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	// Test vectors from RFC 1751.
+	tests := []struct {
+		name     string
+		key      string
+		mnemonic string
+	}{
+		{
+			name:     "pass - 64 bits",
+			key:      "EB33F77EE73D4053",
+			mnemonic: "TIDE ITCH SLOW REIN RULE MOT",
+		},
+		{
+			name:     "pass - 128 bits",
+			key:      "CCAC2AED591056BE4F90FD441C534766",
+			mnemonic: "RASH BUSH MILK LOOK BAD BRIM AVID GAFF BAIT ROT POD LOVE",
+		},
+		{
+			name:     "pass - 128 bits with one-letter word",
+			key:      "EFF81F9BFBC65350920CDD7416DE8009",
+			mnemonic: "TROD MUTE TAIL WARM CHAR KONG HAAG CITY BORE O TEAL AWL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := hex.DecodeString(tt.key)
+			require.NoError(t, err)
+
+			mnemonic, err := Encode(key)
+			require.NoError(t, err)
+			require.Equal(t, tt.mnemonic, mnemonic)
+
+			decoded, err := Decode(tt.mnemonic)
+			require.NoError(t, err)
+			require.Equal(t, key, decoded)
+		})
+	}
+}
+
+func TestDecode_Normalization(t *testing.T) {
+	decoded, err := Decode("  tide itch s1ow rein ru1e m0t ")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xEB, 0x33, 0xF7, 0x7E, 0xE7, 0x3D, 0x40, 0x53}, decoded)
+}
+
+func TestEncode_Errors(t *testing.T) {
+	_, err := Encode(nil)
+	require.ErrorIs(t, err, ErrInvalidKeyLength)
+
+	_, err = Encode(make([]byte, 12))
+	require.ErrorIs(t, err, ErrInvalidKeyLength)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		expected error
+	}{
+		{
+			name:     "fail - empty",
+			mnemonic: "",
+			expected: ErrInvalidWordCount,
+		},
+		{
+			name:     "fail - word count",
+			mnemonic: "TIDE ITCH SLOW REIN RULE",
+			expected: ErrInvalidWordCount,
+		},
+		{
+			name:     "fail - unknown word",
+			mnemonic: "TIDE ITCH SLOW REIN RULE XRPL",
+			expected: ErrUnknownWord{Word: "XRPL"},
+		},
+		{
+			name:     "fail - parity",
+			mnemonic: "TIDE ITCH SLOW REIN RULE MOW",
+			expected: ErrInvalidParity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.mnemonic)
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
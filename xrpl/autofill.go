@@ -0,0 +1,525 @@
+package xrpl
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	querycommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// AutofillConfig holds the network and fee settings used by an Autofiller.
+type AutofillConfig struct {
+	NetworkID  uint32
+	MaxFeeXRP  float32
+	FeeCushion float32
+}
+
+// Autofiller fills in the missing fields of a transaction and calculates its fee.
+// It only depends on the AutofillQuerier interface, so it works with any client transport.
+type Autofiller struct {
+	q   AutofillQuerier
+	cfg AutofillConfig
+}
+
+// NewAutofiller creates a new Autofiller that queries the ledger through q.
+func NewAutofiller(q AutofillQuerier, cfg AutofillConfig) *Autofiller {
+	return &Autofiller{
+		q:   q,
+		cfg: cfg,
+	}
+}
+
+// Autofill fills in the missing fields in a transaction.
+func (a *Autofiller) Autofill(tx *transaction.FlatTransaction) error {
+	if err := a.SetValidTransactionAddresses(tx); err != nil {
+		return err
+	}
+
+	err := a.SetTransactionFlags(tx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["NetworkID"]; !ok {
+		if a.cfg.NetworkID != 0 {
+			(*tx)["NetworkID"] = a.cfg.NetworkID
+		}
+	}
+	if _, ok := (*tx)["Sequence"]; !ok {
+		err := a.SetTransactionNextValidSequenceNumber(tx)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["Fee"]; !ok {
+		err := a.CalculateFeePerTransactionType(tx, 0)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
+		err := a.SetLastLedgerSequence(tx)
+		if err != nil {
+			return err
+		}
+	}
+	if txType, ok := (*tx)["TransactionType"].(string); ok {
+		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
+			err := a.CheckAccountDeleteBlockers(acc)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.PaymentTx.String() {
+			err := a.CheckPaymentAmounts(tx)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.BatchTx.String() {
+			err := a.AutofillRawTransactions(tx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AutofillMultisigned fills in the missing fields in a multisigned transaction.
+// It fills in the missing fields in the transaction and calculates the fee per number of signers.
+func (a *Autofiller) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
+	err := a.Autofill(tx)
+	if err != nil {
+		return err
+	}
+
+	return a.CalculateFeePerTransactionType(tx, nSigners)
+}
+
+// SetValidTransactionAddresses converts the address fields of the transaction to classic addresses.
+func (a *Autofiller) SetValidTransactionAddresses(tx *transaction.FlatTransaction) error {
+	// Validate if "Account" address is an xAddress
+	if err := a.ValidateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["Destination"]; ok {
+		if err := a.ValidateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
+			return err
+		}
+	}
+
+	// DepositPreuaht
+	a.ConvertTransactionAddressToClassicAddress(tx, "Authorize")
+	a.ConvertTransactionAddressToClassicAddress(tx, "Unauthorize")
+	// EscrowCancel, EscrowFinish
+	a.ConvertTransactionAddressToClassicAddress(tx, "Owner")
+	// SetRegularKey
+	a.ConvertTransactionAddressToClassicAddress(tx, "RegularKey")
+
+	return nil
+}
+
+// TODO: Implement this when IsValidXAddress is implemented
+func (a *Autofiller) getClassicAccountAndTag(address string) (string, uint32) {
+	return address, 0
+}
+
+// ConvertTransactionAddressToClassicAddress converts the given address field of the transaction to a classic address.
+func (a *Autofiller) ConvertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
+	if address, ok := (*tx)[fieldName].(string); ok {
+		classicAddress, _ := a.getClassicAccountAndTag(address)
+		(*tx)[fieldName] = classicAddress
+	}
+}
+
+// ValidateTransactionAddress converts the given address field to a classic address and
+// checks that its tag matches the tag field of the transaction, if present.
+func (a *Autofiller) ValidateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
+	classicAddress, tag := a.getClassicAccountAndTag((*tx)[addressField].(string))
+	(*tx)[addressField] = classicAddress
+
+	if tag != uint32(0) {
+		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
+			return ErrMismatchedTag{
+				Expected: addressField,
+				Actual:   tagField,
+			}
+		}
+		(*tx)[tagField] = tag
+	}
+
+	return nil
+}
+
+// SetTransactionFlags sets a transaction's flags to its numeric representation.
+// TODO: Add flag support for AMMDeposit, AMMWithdraw,
+// NFTTOkenCreateOffer, NFTokenMint, OfferCreate, XChainModifyBridge (not supported).
+func (a *Autofiller) SetTransactionFlags(tx *transaction.FlatTransaction) error {
+	flags, ok := (*tx)["Flags"].(uint32)
+	if !ok && flags > 0 {
+		(*tx)["Flags"] = int(0)
+		return nil
+	}
+
+	_, ok = (*tx)["TransactionType"].(string)
+	if !ok {
+		return ErrTransactionTypeMissing
+	}
+
+	return nil
+}
+
+// SetTransactionNextValidSequenceNumber sets the next valid sequence number for a given transaction.
+func (a *Autofiller) SetTransactionNextValidSequenceNumber(tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["Account"].(string); !ok {
+		return ErrMissingAccountInTransaction
+	}
+	res, err := a.q.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address((*tx)["Account"].(string)),
+		LedgerIndex: querycommon.LedgerTitle("current"),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
+	return nil
+}
+
+// GetFeeXrp calculates the current transaction fee for the ledger, in XRP.
+// The fee is the base fee scaled by the server load factor and the given cushion, capped at the maximum fee.
+func (a *Autofiller) GetFeeXrp(cushion float32) (string, error) {
+	res, err := a.q.GetServerInfo(&server.InfoRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
+		return "", ErrCouldNotGetBaseFeeXrp
+	}
+
+	loadFactor := res.Info.LoadFactor
+	if res.Info.LoadFactor == 0 {
+		loadFactor = 1
+	}
+
+	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
+
+	if fee > a.cfg.MaxFeeXRP {
+		fee = a.cfg.MaxFeeXRP
+	}
+
+	// Round fee to NUM_DECIMAL_PLACES
+	roundedFee := float32(math.Round(float64(fee)*math.Pow10(int(currency.MaxFractionLength)))) / float32(math.Pow10(int(currency.MaxFractionLength)))
+
+	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
+	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
+}
+
+// CalculateFeePerTransactionType calculates the fee of the transaction and sets its Fee field, in drops.
+//
+// Replicates xrpl.js calculateFeePerTransactionType logic,
+// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
+func (a *Autofiller) CalculateFeePerTransactionType(tx *transaction.FlatTransaction, nSigners uint64) error {
+	// Get base network fee
+	netFeeXRP, err := a.GetFeeXrp(a.cfg.FeeCushion)
+	if err != nil {
+		return err
+	}
+
+	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
+	if err != nil {
+		return err
+	}
+
+	// Convert to uint64 for calculations
+	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	baseFee := baseFeeUint
+
+	// Get transaction type
+	transactionType := ""
+	if txType, ok := (*tx)["TransactionType"]; ok {
+		if str, ok := txType.(string); ok {
+			transactionType = str
+		}
+	}
+
+	// Check if this is a special transaction cost type
+	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
+
+	switch transactionType {
+	case "EscrowFinish":
+		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
+			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
+				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
+				if fulfillmentBytesSize < 0 {
+					return ErrInvalidFulfillmentLength
+				}
+				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
+				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
+				baseFee = baseFeeUint * (33 + chunks)
+			}
+		}
+	case "AccountDelete", "AMMCreate":
+		reserveFee, err := a.FetchOwnerReserveFee()
+		if err != nil {
+			return err
+		}
+		baseFee = reserveFee
+	case "Batch":
+		rawTxFees, err := a.CalculateBatchFees(tx)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint*2 + rawTxFees
+	}
+
+	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
+	if nSigners > 0 {
+		signersFee := baseFeeUint * nSigners
+		baseFee += signersFee
+	}
+
+	// Apply max fee limit (but not for special transaction cost types)
+	var totalFee uint64
+	if isSpecialTxCost {
+		totalFee = baseFee
+	} else {
+		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", a.cfg.MaxFeeXRP))
+		if err != nil {
+			return err
+		}
+		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+		if err != nil {
+			return err
+		}
+		if baseFee < maxFeeUint {
+			totalFee = baseFee
+		} else {
+			totalFee = maxFeeUint
+		}
+	}
+
+	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
+	return nil
+}
+
+// SetLastLedgerSequence sets the LastLedgerSequence field of the transaction
+// to the latest validated ledger sequence plus common.LedgerOffset.
+func (a *Autofiller) SetLastLedgerSequence(tx *transaction.FlatTransaction) error {
+	index, err := a.q.GetLedgerIndex()
+	if err != nil {
+		return err
+	}
+
+	(*tx)["LastLedgerSequence"] = index.Uint32() + common.LedgerOffset
+	return nil
+}
+
+// CheckAccountDeleteBlockers checks for any blockers that prevent the deletion of an account.
+// Returns nil if there are no blockers, otherwise returns an error.
+func (a *Autofiller) CheckAccountDeleteBlockers(address types.Address) error {
+	accObjects, err := a.q.GetAccountObjects(&account.ObjectsRequest{
+		Account:              address,
+		LedgerIndex:          querycommon.LedgerTitle("validated"),
+		DeletionBlockersOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(accObjects.AccountObjects) > 0 {
+		return ErrAccountCannotBeDeleted
+	}
+	return nil
+}
+
+// CheckPaymentAmounts sets the Amount of a Payment from its DeliverMax field,
+// or checks that both fields are identical when both are provided.
+func (a *Autofiller) CheckPaymentAmounts(tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["DeliverMax"]; ok {
+		if _, ok := (*tx)["Amount"]; !ok {
+			(*tx)["Amount"] = (*tx)["DeliverMax"]
+		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
+			return ErrAmountAndDeliverMaxMustBeIdentical
+		}
+	}
+	return nil
+}
+
+// FetchOwnerReserveFee fetches the owner reserve fee from the server state.
+// Replicates the JavaScript fetchOwnerReserveFee function.
+func (a *Autofiller) FetchOwnerReserveFee() (uint64, error) {
+	response, err := a.q.GetServerState(&server.StateRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	reserveInc := response.State.ValidatedLedger.ReserveInc
+	if reserveInc == 0 {
+		return 0, ErrCouldNotFetchOwnerReserve
+	}
+
+	return uint64(reserveInc), nil
+}
+
+// CalculateBatchFees calculates the total fees for all inner transactions in a Batch.
+// Replicates the JavaScript logic for Batch transaction fee calculation.
+func (a *Autofiller) CalculateBatchFees(tx *transaction.FlatTransaction) (uint64, error) {
+	var totalFees uint64
+
+	// Get RawTransactions from the batch transaction
+	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return 0, ErrRawTransactionsFieldMissing
+	}
+
+	// Iterate through each raw transaction
+	for _, rawTx := range rawTransactions {
+		// Extract the actual transaction from the wrapper
+		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return 0, ErrRawTransactionFieldMissing
+		}
+
+		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
+		innerTxFlat := transaction.FlatTransaction(innerTx)
+		err := a.CalculateFeePerTransactionType(&innerTxFlat, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract the calculated fee
+		feeStr, ok := innerTx["Fee"].(string)
+		if !ok {
+			return 0, ErrFeeFieldMissing
+		}
+
+		innerTx["Fee"] = "0"
+
+		// Convert fee string to uint64 and add to total
+		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
+		if err != nil {
+			return 0, ErrFailedToParseFee{
+				Fee: feeStr,
+				Err: err,
+			}
+		}
+
+		totalFees += feeUint
+	}
+
+	return totalFees, nil
+}
+
+// AutofillRawTransactions fills in the Fee, SigningPubKey, NetworkID and Sequence fields
+// of the inner transactions of a Batch transaction.
+func (a *Autofiller) AutofillRawTransactions(tx *transaction.FlatTransaction) error {
+	needsNetworkID, err := a.TxNeedsNetworkID()
+	if err != nil {
+		return err
+	}
+
+	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return ErrRawTransactionsFieldIsNotAnArray
+	}
+
+	accountSeq := make(map[string]uint32, len(rawTxs))
+
+	for _, rawTx := range rawTxs {
+		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return ErrRawTransactionFieldIsNotAnObject
+		}
+
+		// Validate `Fee` field
+		if innerRawTx["Fee"] == nil {
+			innerRawTx["Fee"] = "0"
+		} else if innerRawTx["Fee"] != "0" {
+			return types.ErrBatchInnerTransactionInvalid
+		}
+
+		// Validate `SigningPubKey` field
+		if innerRawTx["SigningPubKey"] == nil {
+			innerRawTx["SigningPubKey"] = ""
+		} else if innerRawTx["SigningPubKey"] != "" {
+			return ErrSigningPubKeyFieldMustBeEmpty
+		}
+
+		// Validate `TxnSignature` field
+		if innerRawTx["TxnSignature"] != nil {
+			return ErrTxnSignatureFieldMustBeEmpty
+		}
+		if innerRawTx["Signers"] != nil {
+			return ErrSignersFieldMustBeEmpty
+		}
+
+		// Validate `NetworkID` field
+		if innerRawTx["NetworkID"] == nil && needsNetworkID {
+			innerRawTx["NetworkID"] = a.cfg.NetworkID
+		}
+
+		// Validate `Sequence` field
+		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
+
+			acc, ok := innerRawTx["Account"].(string)
+			if !ok {
+				return ErrAccountFieldIsNotAString
+			}
+
+			if accountSeq[acc] != 0 {
+				innerRawTx["Sequence"] = accountSeq[acc]
+				accountSeq[acc]++
+			} else {
+				accountInfo, err := a.q.GetAccountInfo(&account.InfoRequest{
+					Account: types.Address(acc),
+				})
+				if err != nil {
+					return err
+				}
+				var seq uint32
+				if innerRawTx["Account"] == (*tx)["Account"] {
+					seq = accountInfo.AccountData.Sequence + 1
+				} else {
+					seq = accountInfo.AccountData.Sequence
+				}
+				accountSeq[acc] = seq + 1
+				innerRawTx["Sequence"] = seq
+			}
+		}
+	}
+
+	return nil
+}
+
+// TxNeedsNetworkID determines if the transaction requires a NetworkID to be valid.
+// Transaction needs a NetworkID if the network ID is above RestrictedNetworks and
+// the server build version is at least RequiredNetworkIDVersion.
+func (a *Autofiller) TxNeedsNetworkID() (bool, error) {
+	if a.cfg.NetworkID != 0 && a.cfg.NetworkID > RestrictedNetworks {
+		res, err := a.q.GetServerInfo(&server.InfoRequest{})
+		if err != nil {
+			return false, err
+		}
+
+		if res.Info.BuildVersion != "" {
+			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,192 @@
+package xrpl
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/admin"
+	querycommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+// mockQuerier is an in-memory implementation of AutofillQuerier and StandaloneTxQuerier.
+type mockQuerier struct {
+	sequence     uint32
+	baseFeeXRP   float32
+	loadFactor   uint
+	buildVersion string
+	reserveInc   uint
+	ledgerIndex  []querycommon.LedgerIndex
+	objects      []ledger.FlatLedgerObject
+	tx           *transactions.TxResponse
+	txErr        error
+	txCalls      int
+	accepted     int
+}
+
+func (m *mockQuerier) GetAccountInfo(*account.InfoRequest) (*account.InfoResponse, error) {
+	return &account.InfoResponse{AccountData: ledger.AccountRoot{Sequence: m.sequence}}, nil
+}
+
+func (m *mockQuerier) GetAccountObjects(*account.ObjectsRequest) (*account.ObjectsResponse, error) {
+	return &account.ObjectsResponse{AccountObjects: m.objects}, nil
+}
+
+func (m *mockQuerier) GetServerInfo(*server.InfoRequest) (*server.InfoResponse, error) {
+	return &server.InfoResponse{Info: servertypes.Info{
+		BuildVersion:    m.buildVersion,
+		LoadFactor:      m.loadFactor,
+		ValidatedLedger: servertypes.ClosedLedger{BaseFeeXRP: m.baseFeeXRP},
+	}}, nil
+}
+
+func (m *mockQuerier) GetServerState(*server.StateRequest) (*server.StateResponse, error) {
+	return &server.StateResponse{State: servertypes.State{
+		ValidatedLedger: servertypes.LedgerState{ReserveInc: m.reserveInc},
+	}}, nil
+}
+
+func (m *mockQuerier) GetLedgerIndex() (querycommon.LedgerIndex, error) {
+	index := m.ledgerIndex[0]
+	if len(m.ledgerIndex) > 1 {
+		m.ledgerIndex = m.ledgerIndex[1:]
+	}
+	return index, nil
+}
+
+func (m *mockQuerier) GetTx(*transactions.TxRequest) (*transactions.TxResponse, error) {
+	m.txCalls++
+	return m.tx, m.txErr
+}
+
+// LedgerAccept closes a ledger, returning the next index of ledgerIndex as the new current ledger.
+func (m *mockQuerier) LedgerAccept(*admin.LedgerAcceptRequest) (*admin.LedgerAcceptResponse, error) {
+	m.accepted++
+	index, err := m.GetLedgerIndex()
+	return &admin.LedgerAcceptResponse{LedgerCurrentIndex: index}, err
+}
+
+func TestAutofiller_Autofill(t *testing.T) {
+	q := &mockQuerier{
+		sequence:    42,
+		baseFeeXRP:  0.00001,
+		loadFactor:  1,
+		ledgerIndex: []querycommon.LedgerIndex{100},
+	}
+	a := NewAutofiller(q, AutofillConfig{
+		NetworkID:  21338,
+		MaxFeeXRP:  2,
+		FeeCushion: 1,
+	})
+
+	tx := transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Account":         "rPEPPER7kfTD9w2To4CQk6UCfuHM9c6GDY",
+		"Destination":     "rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN",
+		"DeliverMax":      "1000",
+	}
+
+	require.NoError(t, a.Autofill(&tx))
+	require.Equal(t, uint32(21338), tx["NetworkID"])
+	require.Equal(t, uint32(42), tx["Sequence"])
+	require.Equal(t, "10", tx["Fee"])
+	require.Equal(t, uint32(120), tx["LastLedgerSequence"])
+	require.Equal(t, "1000", tx["Amount"])
+}
+
+func TestAutofiller_CalculateFeePerTransactionType(t *testing.T) {
+	tests := []struct {
+		name        string
+		tx          transaction.FlatTransaction
+		q           *mockQuerier
+		nSigners    uint64
+		expectedFee string
+		expectedErr error
+	}{
+		{
+			name:        "pass - base fee",
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			q:           &mockQuerier{baseFeeXRP: 0.00001, loadFactor: 1},
+			expectedFee: "10",
+		},
+		{
+			name:        "pass - multisigned",
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			q:           &mockQuerier{baseFeeXRP: 0.00001, loadFactor: 1},
+			nSigners:    3,
+			expectedFee: "40",
+		},
+		{
+			name:        "pass - capped at max fee",
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			q:           &mockQuerier{baseFeeXRP: 1, loadFactor: 1000},
+			expectedFee: "2000000",
+		},
+		{
+			name:        "pass - account delete uses owner reserve",
+			tx:          transaction.FlatTransaction{"TransactionType": "AccountDelete"},
+			q:           &mockQuerier{baseFeeXRP: 0.00001, loadFactor: 1, reserveInc: 2000000},
+			expectedFee: "2000000",
+		},
+		{
+			name:        "fail - missing base fee",
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			q:           &mockQuerier{},
+			expectedErr: ErrCouldNotGetBaseFeeXrp,
+		},
+		{
+			name:        "fail - missing owner reserve",
+			tx:          transaction.FlatTransaction{"TransactionType": "AMMCreate"},
+			q:           &mockQuerier{baseFeeXRP: 0.00001, loadFactor: 1},
+			expectedErr: ErrCouldNotFetchOwnerReserve,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAutofiller(tt.q, AutofillConfig{MaxFeeXRP: 2, FeeCushion: 1})
+			err := a.CalculateFeePerTransactionType(&tt.tx, tt.nSigners)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFee, tt.tx["Fee"])
+		})
+	}
+}
+
+func TestAutofiller_CheckAccountDeleteBlockers(t *testing.T) {
+	a := NewAutofiller(&mockQuerier{}, AutofillConfig{})
+	require.NoError(t, a.CheckAccountDeleteBlockers("rPEPPER7kfTD9w2To4CQk6UCfuHM9c6GDY"))
+
+	a = NewAutofiller(&mockQuerier{objects: []ledger.FlatLedgerObject{{"LedgerEntryType": "Check"}}}, AutofillConfig{})
+	require.ErrorIs(t, a.CheckAccountDeleteBlockers("rPEPPER7kfTD9w2To4CQk6UCfuHM9c6GDY"), ErrAccountCannotBeDeleted)
+}
+
+func TestAutofiller_TxNeedsNetworkID(t *testing.T) {
+	tests := []struct {
+		name      string
+		networkID uint32
+		version   string
+		expected  bool
+	}{
+		{name: "pass - mainnet", networkID: 0, version: "2.3.0", expected: false},
+		{name: "pass - restricted network with old server", networkID: 21338, version: "1.10.0", expected: false},
+		{name: "pass - restricted network", networkID: 21338, version: "2.3.0", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAutofiller(&mockQuerier{buildVersion: tt.version}, AutofillConfig{NetworkID: tt.networkID})
+			needs, err := a.TxNeedsNetworkID()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, needs)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package xrpl
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/admin"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
+	querycommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/nft"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitOptions specifies options for submitting a single transaction.
+type SubmitOptions struct {
+	Autofill bool
+	Wallet   *wallet.Wallet
+	FailHard bool
+}
+
+// AutofillQuerier defines the queries required to autofill a transaction and calculate its fee.
+type AutofillQuerier interface {
+	GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error)
+	GetAccountObjects(req *account.ObjectsRequest) (*account.ObjectsResponse, error)
+	GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, error)
+	GetServerState(req *server.StateRequest) (*server.StateResponse, error)
+	GetLedgerIndex() (querycommon.LedgerIndex, error)
+}
+
+// TxQuerier defines the queries required to wait for a transaction to be included in a ledger.
+type TxQuerier interface {
+	GetLedgerIndex() (querycommon.LedgerIndex, error)
+	GetTx(req *transactions.TxRequest) (*transactions.TxResponse, error)
+}
+
+// StandaloneTxQuerier defines the queries required to close ledgers until a transaction is validated,
+// on a server in stand-alone mode.
+type StandaloneTxQuerier interface {
+	TxQuerier
+	LedgerAccept(req *admin.LedgerAcceptRequest) (*admin.LedgerAcceptResponse, error)
+}
+
+// Querier defines the typed query methods shared by every XRPL client transport.
+type Querier interface {
+	AutofillQuerier
+	TxQuerier
+
+	// account
+	GetAccountChannels(req *account.ChannelsRequest) (*account.ChannelsResponse, error)
+	GetAccountLines(req *account.LinesRequest) (*account.LinesResponse, error)
+	GetXrpBalance(address types.Address) (string, error)
+	GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse, error)
+	GetAccountCurrencies(req *account.CurrenciesRequest) (*account.CurrenciesResponse, error)
+	GetAccountOffers(req *account.OffersRequest) (*account.OffersResponse, error)
+	GetAccountTransactions(req *account.TransactionsRequest) (*account.TransactionsResponse, error)
+	GetGatewayBalances(req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error)
+
+	// channel
+	GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyResponse, error)
+
+	// ledger
+	GetClosedLedger() (*ledger.ClosedResponse, error)
+	GetCurrentLedger() (*ledger.CurrentResponse, error)
+	GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error)
+	GetLedger(req *ledger.Request) (*ledger.Response, error)
+
+	// nft
+	GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error)
+	GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error)
+
+	// path
+	GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersResponse, error)
+	GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error)
+	FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse, error)
+	FindPathClose(req *path.FindCloseRequest) (*path.FindResponse, error)
+	FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse, error)
+	GetRipplePathFind(req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error)
+
+	// server
+	GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureAllResponse, error)
+	GetFeature(req *server.FeatureOneRequest) (*server.FeatureResponse, error)
+	GetFee(req *server.FeeRequest) (*server.FeeResponse, error)
+	GetManifest(req *server.ManifestRequest) (*server.ManifestResponse, error)
+
+	// oracle
+	GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error)
+
+	// utility
+	Ping(req *utility.PingRequest) (*utility.PingResponse, error)
+	GetRandom(req *utility.RandomRequest) (*utility.RandomResponse, error)
+}
+
+// AdminQuerier defines the admin methods shared by every XRPL client transport.
+// They require an admin connection to rippled, and LedgerAccept is only available in stand-alone mode.
+type AdminQuerier interface {
+	LedgerAccept(req *admin.LedgerAcceptRequest) (*admin.LedgerAcceptResponse, error)
+	WalletPropose(req *admin.WalletProposeRequest) (*admin.WalletProposeResponse, error)
+	ValidationCreate(req *admin.ValidationCreateRequest) (*admin.ValidationCreateResponse, error)
+	Sign(req *admin.SignRequest) (*admin.SignResponse, error)
+	SignFor(req *admin.SignForRequest) (*admin.SignForResponse, error)
+	GetPeers(req *admin.PeersRequest) (*admin.PeersResponse, error)
+	GetFetchInfo(req *admin.FetchInfoRequest) (*admin.FetchInfoResponse, error)
+	LogLevel(req *admin.LogLevelRequest) (*admin.LogLevelResponse, error)
+	LedgerCleaner(req *admin.LedgerCleanerRequest) (*admin.LedgerCleanerResponse, error)
+	CanDelete(req *admin.CanDeleteRequest) (*admin.CanDeleteResponse, error)
+	ConnectPeer(req *admin.ConnectRequest) (*admin.ConnectResponse, error)
+	StopServer(req *admin.StopRequest) (*admin.StopResponse, error)
+}
+
+// Client defines the transport-agnostic API implemented by both rpc.Client and websocket.Client.
+// Code depending on Client can swap transports or be tested against a mock implementation.
+type Client interface {
+	Querier
+
+	FaucetProvider() common.FaucetProvider
+	FundWallet(wallet *wallet.Wallet) error
+
+	Autofill(tx *transaction.FlatTransaction) error
+	AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error
+
+	SubmitTx(tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.SubmitResponse, error)
+	SubmitTxAndWait(tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.TxResponse, error)
+	SubmitTxBlob(txBlob string, failHard bool) (*transactions.SubmitResponse, error)
+	SubmitTxBlobAndWait(txBlob string, failHard bool) (*transactions.TxResponse, error)
+	SubmitMultisigned(txBlob string, failHard bool) (*transactions.SubmitMultisignedResponse, error)
+}
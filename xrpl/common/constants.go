@@ -20,6 +20,9 @@ const (
 	// DefaultMaxFeeXRP is the default maximum fee in XRP.
 	DefaultMaxFeeXRP float32 = 2
 
+	// DefaultBatchSize is the default maximum number of requests sent in a single JSON-RPC batch.
+	DefaultBatchSize = 100
+
 	// DefaultTimeout is the default timeout for RPC calls (5 seconds).
 	DefaultTimeout = 5 * time.Second
 )
@@ -0,0 +1,316 @@
+package currency
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// MaxNativeDrops is the maximum amount of XRP, in drops.
+	MaxNativeDrops int64 = 100_000_000_000_000_000
+	// MaxMPTAmount is the maximum amount of an MPT.
+	MaxMPTAmount int64 = math.MaxInt64
+
+	minIOUMantissa  uint64 = 1_000_000_000_000_000
+	maxIOUMantissa  uint64 = 9_999_999_999_999_999
+	minIOUExponent         = -96
+	maxIOUExponent         = 80
+	zeroIOUExponent        = -100
+
+	tenTo14   uint64 = 100_000_000_000_000
+	tenTo14m1        = tenTo14 - 1
+	tenTo17   uint64 = 100_000_000_000_000_000
+)
+
+// amountValueRegex matches the decimal values accepted by rippled for issued currency amounts.
+var amountValueRegex = regexp.MustCompile(`^([-+]?)(0|[1-9][0-9]*)(\.([0-9]+))?([eE]([+-]?)([0-9]+))?$`)
+
+// Asset identifies what an Amount is an amount of: XRP, an issued currency or an MPT.
+type Asset struct {
+	Kind          types.CurrencyKind
+	Currency      string
+	Issuer        types.Address
+	MPTIssuanceID string
+}
+
+// XRPAsset returns the XRP asset.
+func XRPAsset() Asset {
+	return Asset{Kind: types.XRP}
+}
+
+// IssuedAsset returns the asset of the currency issued by issuer.
+func IssuedAsset(currency string, issuer types.Address) Asset {
+	return Asset{Kind: types.ISSUED, Currency: currency, Issuer: issuer}
+}
+
+// MPTAsset returns the asset of the MPT issuance.
+func MPTAsset(issuanceID string) Asset {
+	return Asset{Kind: types.MPT, MPTIssuanceID: issuanceID}
+}
+
+// isIntegral reports whether the amounts of the asset are integers, as XRP drops and MPT amounts are.
+func (a Asset) isIntegral() bool {
+	return a.Kind == types.XRP || a.Kind == types.MPT
+}
+
+// Amount is an exact amount of XRP, an issued currency or an MPT, with the semantics of rippled's STAmount.
+//
+// XRP amounts are integers of drops, up to MaxNativeDrops, and MPT amounts are integers up to MaxMPTAmount.
+// Issued currency amounts are decimal floating point values with a 16 digit mantissa in [1e15, 1e16) and an
+// exponent in [-96, 80]: results with more digits are rounded, and results too small to be represented are zero.
+// The zero value is zero XRP.
+type Amount struct {
+	asset    Asset
+	mantissa uint64
+	exponent int
+	negative bool
+}
+
+// NewXRPAmount returns the amount of XRP of the given drops.
+func NewXRPAmount(drops int64) (Amount, error) {
+	return newIntegralAmount(XRPAsset(), drops)
+}
+
+// NewIssuedAmount returns the amount of the currency issued by issuer of the given decimal value, such as
+// "1.5" or "-25e-3". Values with more than 16 significant digits are rounded to nearest.
+func NewIssuedAmount(currency string, issuer types.Address, value string) (Amount, error) {
+	m := amountValueRegex.FindStringSubmatch(value)
+	if m == nil || len(m[2])+len(m[4]) > 32 {
+		return Amount{}, ErrInvalidAmountValue
+	}
+
+	mantissa, err := strconv.ParseUint(m[2]+m[4], 10, 64)
+	if err != nil {
+		return Amount{}, ErrInvalidAmountValue
+	}
+	exponent := -len(m[4])
+	if m[5] != "" {
+		e, err := strconv.Atoi(m[7])
+		if err != nil {
+			return Amount{}, ErrInvalidAmountValue
+		}
+		if m[6] == "-" {
+			e = -e
+		}
+		exponent += e
+	}
+	return newAmount(IssuedAsset(currency, issuer), mantissa, exponent, m[1] == "-", RoundToNearest)
+}
+
+// NewMPTAmount returns the amount of the MPT issuance of the given value.
+func NewMPTAmount(issuanceID string, value int64) (Amount, error) {
+	return newIntegralAmount(MPTAsset(issuanceID), value)
+}
+
+// NewAmount returns the Amount of a XRPCurrencyAmount, IssuedCurrencyAmount or MPTCurrencyAmount.
+func NewAmount(amount types.CurrencyAmount) (Amount, error) {
+	switch a := amount.(type) {
+	case types.XRPCurrencyAmount:
+		if a.Uint64() > uint64(MaxNativeDrops) {
+			return Amount{}, ErrNativeAmountOutOfRange
+		}
+		return NewXRPAmount(int64(a))
+	case types.IssuedCurrencyAmount:
+		return NewIssuedAmount(a.Currency, a.Issuer, a.Value)
+	case types.MPTCurrencyAmount:
+		v, err := strconv.ParseInt(a.Value, 10, 64)
+		if err != nil {
+			return Amount{}, ErrInvalidAmountValue
+		}
+		return NewMPTAmount(a.MPTIssuanceID, v)
+	default:
+		return Amount{}, ErrUnsupportedCurrencyAmount
+	}
+}
+
+// NewAmountFromMantissa returns the amount of the asset of mantissa * 10^exponent, negated if negative.
+// XRP and MPT amounts are rounded to an integer, and issued currency amounts to 16 digits, to nearest.
+func NewAmountFromMantissa(asset Asset, mantissa uint64, exponent int, negative bool) (Amount, error) {
+	return newAmount(asset, mantissa, exponent, negative, RoundToNearest)
+}
+
+// ZeroAmount returns the zero amount of the asset.
+func ZeroAmount(asset Asset) Amount {
+	a := Amount{asset: asset}
+	if !asset.isIntegral() {
+		a.exponent = zeroIOUExponent
+	}
+	return a
+}
+
+// newIntegralAmount returns the XRP or MPT amount of value.
+func newIntegralAmount(asset Asset, value int64) (Amount, error) {
+	if value < 0 {
+		return newAmount(asset, uint64(-value), 0, true, RoundToNearest)
+	}
+	return newAmount(asset, uint64(value), 0, false, RoundToNearest)
+}
+
+// newAmount returns the canonical amount of the asset of mantissa * 10^exponent, as rippled's
+// STAmount::canonicalize. XRP and MPT amounts are rounded to an integer, and issued currency amounts to a
+// 16 digit mantissa, with mode.
+func newAmount(asset Asset, mantissa uint64, exponent int, negative bool, mode RoundingMode) (Amount, error) {
+	if mantissa == 0 {
+		return ZeroAmount(asset), nil
+	}
+
+	if asset.isIntegral() {
+		errOutOfRange, maxValue, maxExponent := ErrNativeAmountOutOfRange, MaxNativeDrops, 17
+		if asset.Kind == types.MPT {
+			errOutOfRange, maxValue, maxExponent = ErrMPTAmountOutOfRange, MaxMPTAmount, 18
+		}
+		if exponent > maxExponent {
+			return Amount{}, errOutOfRange
+		}
+		v, err := integerValue(mantissa, exponent, negative, mode)
+		if err != nil {
+			return Amount{}, errOutOfRange
+		}
+		if v < 0 {
+			v = -v
+		}
+		if v > maxValue {
+			return Amount{}, errOutOfRange
+		}
+		if v == 0 {
+			return ZeroAmount(asset), nil
+		}
+		return Amount{asset: asset, mantissa: uint64(v), negative: negative}, nil
+	}
+
+	n, err := newNumber(mantissa, exponent, negative, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	return amountFromNumber(asset, n, mode)
+}
+
+// amountFromNumber returns the amount of the asset of a number, rounded with mode for XRP and MPT amounts.
+func amountFromNumber(asset Asset, n number, mode RoundingMode) (Amount, error) {
+	if asset.isIntegral() {
+		return newAmount(asset, n.mantissa, n.exponent, n.negative, mode)
+	}
+	if n.isZero() || n.exponent < minIOUExponent {
+		return ZeroAmount(asset), nil
+	}
+	if n.exponent > maxIOUExponent {
+		return Amount{}, ErrAmountOverflow
+	}
+	return Amount{asset: asset, mantissa: n.mantissa, exponent: n.exponent, negative: n.negative}, nil
+}
+
+// number returns the amount as a number. XRP and MPT amounts of more than 16 digits are rounded with mode.
+func (a Amount) number(mode RoundingMode) (number, error) {
+	if a.asset.isIntegral() {
+		return newNumber(a.mantissa, 0, a.negative, mode)
+	}
+	if a.IsZero() {
+		return number{}, nil
+	}
+	return number{mantissa: a.mantissa, exponent: a.exponent, negative: a.negative}, nil
+}
+
+// Asset returns the asset of the amount.
+func (a Amount) Asset() Asset {
+	return a.asset
+}
+
+// Mantissa returns the absolute value of the mantissa of the amount. It is the absolute amount of drops
+// of XRP amounts and the absolute value of MPT amounts, whose exponent is 0.
+func (a Amount) Mantissa() uint64 {
+	return a.mantissa
+}
+
+// Exponent returns the exponent of the amount. It is -100 for a zero issued currency amount.
+func (a Amount) Exponent() int {
+	return a.exponent
+}
+
+// IsNegative returns true if the amount is lower than zero.
+func (a Amount) IsNegative() bool {
+	return a.negative
+}
+
+// IsZero returns true if the amount is zero.
+func (a Amount) IsZero() bool {
+	return a.mantissa == 0
+}
+
+// Signum returns -1, 0 or 1 depending on the sign of the amount.
+func (a Amount) Signum() int {
+	switch {
+	case a.IsZero():
+		return 0
+	case a.negative:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Int64 returns the drops of an XRP amount or the value of an MPT amount. It is 0 for issued currency amounts.
+func (a Amount) Int64() int64 {
+	if !a.asset.isIntegral() {
+		return 0
+	}
+	if a.negative {
+		return -int64(a.mantissa)
+	}
+	return int64(a.mantissa)
+}
+
+// String returns the value of the amount as rippled formats it: drops for XRP amounts, and a decimal number
+// for issued currency amounts, in scientific notation if its exponent is not in [-25, -5].
+func (a Amount) String() string {
+	if a.asset.isIntegral() {
+		return strconv.FormatInt(a.Int64(), 10)
+	}
+	if a.IsZero() {
+		return "0"
+	}
+
+	var sb strings.Builder
+	if a.negative {
+		sb.WriteByte('-')
+	}
+	raw := strconv.FormatUint(a.mantissa, 10)
+	if a.exponent != 0 && (a.exponent < -25 || a.exponent > -5) {
+		sb.WriteString(raw)
+		sb.WriteByte('e')
+		sb.WriteString(strconv.Itoa(a.exponent))
+		return sb.String()
+	}
+
+	var integer, fraction string
+	if point := len(raw) + a.exponent; point > 0 {
+		integer, fraction = raw[:point], raw[point:]
+	} else {
+		integer, fraction = "0", strings.Repeat("0", -point)+raw
+	}
+	sb.WriteString(integer)
+	if fraction = strings.TrimRight(fraction, "0"); fraction != "" {
+		sb.WriteByte('.')
+		sb.WriteString(fraction)
+	}
+	return sb.String()
+}
+
+// CurrencyAmount returns the amount as a XRPCurrencyAmount, IssuedCurrencyAmount or MPTCurrencyAmount.
+// Negative XRP amounts cannot be represented as a XRPCurrencyAmount and return ErrNegativeXRPAmount.
+func (a Amount) CurrencyAmount() (types.CurrencyAmount, error) {
+	switch a.asset.Kind {
+	case types.XRP:
+		if a.negative {
+			return nil, ErrNegativeXRPAmount
+		}
+		return types.XRPCurrencyAmount(a.mantissa), nil
+	case types.MPT:
+		return types.MPTCurrencyAmount{MPTIssuanceID: a.asset.MPTIssuanceID, Value: a.String()}, nil
+	default:
+		return types.IssuedCurrencyAmount{Currency: a.asset.Currency, Issuer: a.asset.Issuer, Value: a.String()}, nil
+	}
+}
@@ -0,0 +1,222 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer types.Address = "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"
+
+const testMPTIssuanceID = "00000001A407AF5856CCF3C42619DAA925813FC955C72983"
+
+func mustIssued(t *testing.T, value string) Amount {
+	t.Helper()
+	a, err := NewIssuedAmount("USD", testIssuer, value)
+	require.NoError(t, err)
+	return a
+}
+
+func mustXRP(t *testing.T, drops int64) Amount {
+	t.Helper()
+	a, err := NewXRPAmount(drops)
+	require.NoError(t, err)
+	return a
+}
+
+func mustMPT(t *testing.T, value int64) Amount {
+	t.Helper()
+	a, err := NewMPTAmount(testMPTIssuanceID, value)
+	require.NoError(t, err)
+	return a
+}
+
+func TestNewIssuedAmount(t *testing.T) {
+	tt := []struct {
+		description string
+		value       string
+		mantissa    uint64
+		exponent    int
+		negative    bool
+		expected    string
+		expectedErr error
+	}{
+		{description: "pass - decimal", value: "1.5", mantissa: 1_500_000_000_000_000, exponent: -15, expected: "1.5"},
+		{description: "pass - trailing zeros", value: "123.4560", mantissa: 1_234_560_000_000_000, exponent: -13, expected: "123.456"},
+		{description: "pass - negative with exponent", value: "-25e-3", mantissa: 2_500_000_000_000_000, exponent: -17, negative: true, expected: "-0.025"},
+		{description: "pass - positive exponent sign", value: "+1E+2", mantissa: 1_000_000_000_000_000, exponent: -13, expected: "100"},
+		{description: "pass - smallest plain exponent", value: "0.00001", mantissa: 1_000_000_000_000_000, exponent: -20, expected: "0.00001"},
+		{description: "pass - large value in scientific notation", value: "1e20", mantissa: 1_000_000_000_000_000, exponent: 5, expected: "1000000000000000e5"},
+		{description: "pass - small value in scientific notation", value: "1e-25", mantissa: 1_000_000_000_000_000, exponent: -40, expected: "1000000000000000e-40"},
+		{description: "pass - rounded to 16 digits", value: "12345678901234567", mantissa: 1_234_567_890_123_457, exponent: 1, expected: "1234567890123457e1"},
+		{description: "pass - largest value", value: "9999999999999999e80", mantissa: 9_999_999_999_999_999, exponent: 80, expected: "9999999999999999e80"},
+		{description: "pass - smallest value", value: "1e-81", mantissa: 1_000_000_000_000_000, exponent: -96, expected: "1000000000000000e-96"},
+		{description: "pass - underflow to zero", value: "1e-82", exponent: -100, expected: "0"},
+		{description: "pass - zero", value: "0.000", exponent: -100, expected: "0"},
+		{description: "fail - overflow", value: "1e97", expectedErr: ErrAmountOverflow},
+		{description: "fail - leading zero", value: "01", expectedErr: ErrInvalidAmountValue},
+		{description: "fail - missing fraction", value: "1.", expectedErr: ErrInvalidAmountValue},
+		{description: "fail - missing integer", value: ".5", expectedErr: ErrInvalidAmountValue},
+		{description: "fail - missing exponent", value: "1e", expectedErr: ErrInvalidAmountValue},
+		{description: "fail - not a number", value: "abc", expectedErr: ErrInvalidAmountValue},
+		{description: "fail - mantissa too large", value: "184467440737095516160", expectedErr: ErrInvalidAmountValue},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := NewIssuedAmount("USD", testIssuer, tc.value)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, IssuedAsset("USD", testIssuer), a.Asset())
+			require.Equal(t, tc.mantissa, a.Mantissa())
+			require.Equal(t, tc.exponent, a.Exponent())
+			require.Equal(t, tc.negative, a.IsNegative())
+			require.Equal(t, tc.expected, a.String())
+		})
+	}
+}
+
+func TestNewIntegralAmount(t *testing.T) {
+	tt := []struct {
+		description string
+		new         func() (Amount, error)
+		expected    string
+		expectedErr error
+	}{
+		{
+			description: "pass - XRP",
+			new:         func() (Amount, error) { return NewXRPAmount(1_000_000) },
+			expected:    "1000000",
+		},
+		{
+			description: "pass - negative XRP",
+			new:         func() (Amount, error) { return NewXRPAmount(-10) },
+			expected:    "-10",
+		},
+		{
+			description: "pass - maximum XRP",
+			new:         func() (Amount, error) { return NewXRPAmount(MaxNativeDrops) },
+			expected:    "100000000000000000",
+		},
+		{
+			description: "pass - maximum MPT",
+			new:         func() (Amount, error) { return NewMPTAmount(testMPTIssuanceID, MaxMPTAmount) },
+			expected:    "9223372036854775807",
+		},
+		{
+			description: "fail - XRP out of range",
+			new:         func() (Amount, error) { return NewXRPAmount(MaxNativeDrops + 1) },
+			expectedErr: ErrNativeAmountOutOfRange,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := tc.new()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, a.Exponent())
+			require.Equal(t, tc.expected, a.String())
+		})
+	}
+}
+
+func TestNewAmount(t *testing.T) {
+	tt := []struct {
+		description string
+		input       types.CurrencyAmount
+		expected    types.CurrencyAmount
+		expectedErr error
+	}{
+		{
+			description: "pass - XRP",
+			input:       types.XRPCurrencyAmount(100),
+			expected:    types.XRPCurrencyAmount(100),
+		},
+		{
+			description: "pass - issued currency",
+			input:       types.IssuedCurrencyAmount{Currency: "USD", Issuer: testIssuer, Value: "1.50"},
+			expected:    types.IssuedCurrencyAmount{Currency: "USD", Issuer: testIssuer, Value: "1.5"},
+		},
+		{
+			description: "pass - MPT",
+			input:       types.MPTCurrencyAmount{MPTIssuanceID: testMPTIssuanceID, Value: "100"},
+			expected:    types.MPTCurrencyAmount{MPTIssuanceID: testMPTIssuanceID, Value: "100"},
+		},
+		{
+			description: "fail - XRP out of range",
+			input:       types.XRPCurrencyAmount(uint64(MaxNativeDrops) + 1),
+			expectedErr: ErrNativeAmountOutOfRange,
+		},
+		{
+			description: "fail - invalid issued currency value",
+			input:       types.IssuedCurrencyAmount{Currency: "USD", Issuer: testIssuer, Value: "1,5"},
+			expectedErr: ErrInvalidAmountValue,
+		},
+		{
+			description: "fail - invalid MPT value",
+			input:       types.MPTCurrencyAmount{MPTIssuanceID: testMPTIssuanceID, Value: "1.5"},
+			expectedErr: ErrInvalidAmountValue,
+		},
+		{
+			description: "fail - nil",
+			input:       nil,
+			expectedErr: ErrUnsupportedCurrencyAmount,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := NewAmount(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			ca, err := a.CurrencyAmount()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ca)
+		})
+	}
+
+	t.Run("fail - negative XRP", func(t *testing.T) {
+		_, err := mustXRP(t, -1).CurrencyAmount()
+		require.ErrorIs(t, err, ErrNegativeXRPAmount)
+	})
+}
+
+func TestNewAmountFromMantissa(t *testing.T) {
+	tt := []struct {
+		description string
+		asset       Asset
+		mantissa    uint64
+		exponent    int
+		negative    bool
+		expected    string
+		expectedErr error
+	}{
+		{description: "pass - issued currency", asset: IssuedAsset("USD", testIssuer), mantissa: 15, exponent: -1, expected: "1.5"},
+		{description: "pass - negative issued currency", asset: IssuedAsset("USD", testIssuer), mantissa: 1_002_000_000, exponent: -9, negative: true, expected: "-1.002"},
+		{description: "pass - XRP rounded to nearest", asset: XRPAsset(), mantissa: 26, exponent: -1, expected: "3"},
+		{description: "fail - XRP out of range", asset: XRPAsset(), mantissa: 1, exponent: 18, expectedErr: ErrNativeAmountOutOfRange},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			a, err := NewAmountFromMantissa(tc.asset, tc.mantissa, tc.exponent, tc.negative)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, a.String())
+		})
+	}
+}
@@ -0,0 +1,378 @@
+package currency
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Comparable reports whether two amounts can be added, subtracted and compared: both are XRP amounts,
+// amounts of the same currency, or amounts of the same MPT issuance. As rippled, the issuers of issued
+// currency amounts are not compared.
+func (a Amount) Comparable(b Amount) bool {
+	if a.asset.Kind != b.asset.Kind {
+		return false
+	}
+	switch a.asset.Kind {
+	case types.ISSUED:
+		return a.asset.Currency == b.asset.Currency
+	case types.MPT:
+		return a.asset.MPTIssuanceID == b.asset.MPTIssuanceID
+	default:
+		return true
+	}
+}
+
+// Neg returns the opposite of the amount.
+func (a Amount) Neg() Amount {
+	if !a.IsZero() {
+		a.negative = !a.negative
+	}
+	return a
+}
+
+// Abs returns the absolute value of the amount.
+func (a Amount) Abs() Amount {
+	a.negative = false
+	return a
+}
+
+// Cmp compares a and b and returns -1, 0 or 1. It returns ErrAmountsNotComparable if the amounts are not Comparable.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if !a.Comparable(b) {
+		return 0, ErrAmountsNotComparable
+	}
+	x := number{mantissa: a.mantissa, exponent: a.exponent, negative: a.negative}
+	y := number{mantissa: b.mantissa, exponent: b.exponent, negative: b.negative}
+	return x.cmp(y), nil
+}
+
+// Add returns a + b, in the asset of a, rounded to nearest.
+func (a Amount) Add(b Amount) (Amount, error) {
+	return RoundToNearest.Add(a, b)
+}
+
+// Sub returns a - b, in the asset of a, rounded to nearest.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	return RoundToNearest.Sub(a, b)
+}
+
+// Mul returns a * b, in the asset of a, rounded to nearest.
+func (a Amount) Mul(b Amount) (Amount, error) {
+	return RoundToNearest.Multiply(a, b, a.asset)
+}
+
+// Div returns a / b, in the asset of a, rounded to nearest.
+func (a Amount) Div(b Amount) (Amount, error) {
+	return RoundToNearest.Divide(a, b, a.asset)
+}
+
+// Add returns a + b, in the asset of a, rounded with the rounding mode.
+// It returns ErrAmountsNotComparable if the amounts are not Comparable.
+func (mode RoundingMode) Add(a, b Amount) (Amount, error) {
+	if !a.Comparable(b) {
+		return Amount{}, ErrAmountsNotComparable
+	}
+	if b.IsZero() {
+		return a, nil
+	}
+	if a.IsZero() {
+		b.asset = a.asset
+		return b, nil
+	}
+
+	if a.asset.isIntegral() {
+		x, y := a.Int64(), b.Int64()
+		if (y > 0 && x > math.MaxInt64-y) || (y < 0 && x < math.MinInt64-y) {
+			if a.asset.Kind == types.MPT {
+				return Amount{}, ErrMPTAmountOutOfRange
+			}
+			return Amount{}, ErrNativeAmountOutOfRange
+		}
+		return newIntegralAmount(a.asset, x+y)
+	}
+
+	x, _ := a.number(mode)
+	y, _ := b.number(mode)
+	z, err := x.add(y, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	return amountFromNumber(a.asset, z, mode)
+}
+
+// Sub returns a - b, in the asset of a, rounded with the rounding mode.
+// It returns ErrAmountsNotComparable if the amounts are not Comparable.
+func (mode RoundingMode) Sub(a, b Amount) (Amount, error) {
+	return mode.Add(a, b.Neg())
+}
+
+// Multiply returns v1 * v2 as an amount of asset, rounded with the rounding mode.
+// The amounts do not need to be of the same asset, as when computing the output of an offer from its quality.
+func (mode RoundingMode) Multiply(v1, v2 Amount, asset Asset) (Amount, error) {
+	if v1.IsZero() || v2.IsZero() {
+		return ZeroAmount(asset), nil
+	}
+
+	negative := v1.negative != v2.negative
+	minV, maxV := min(v1.mantissa, v2.mantissa), max(v1.mantissa, v2.mantissa)
+	switch {
+	case v1.asset.Kind == types.XRP && v2.asset.Kind == types.XRP && asset.Kind == types.XRP:
+		if minV > 3_000_000_000 || (maxV>>32)*minV > 2_095_475_792 {
+			return Amount{}, ErrNativeAmountOutOfRange
+		}
+		return newAmount(asset, minV*maxV, 0, negative, mode)
+	case v1.asset.Kind == types.MPT && v2.asset.Kind == types.MPT && asset.Kind == types.MPT:
+		if minV > 3_037_000_499 || (maxV>>32)*minV > 2_147_483_648 {
+			return Amount{}, ErrMPTAmountOutOfRange
+		}
+		return newAmount(asset, minV*maxV, 0, negative, mode)
+	}
+
+	x, err := v1.number(mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	y, err := v2.number(mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	z, err := x.mul(y, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	return amountFromNumber(asset, z, mode)
+}
+
+// Divide returns num / den as an amount of asset, rounded with the rounding mode.
+// It returns ErrDivisionByZero if den is zero.
+func (mode RoundingMode) Divide(num, den Amount, asset Asset) (Amount, error) {
+	if den.IsZero() {
+		return Amount{}, ErrDivisionByZero
+	}
+	if num.IsZero() {
+		return ZeroAmount(asset), nil
+	}
+
+	numVal, numOffset := num.scaledMantissa()
+	denVal, denOffset := den.scaledMantissa()
+
+	// Both mantissas are at least 1e15: multiplying the numerator by 1e17 before dividing keeps 17 digits.
+	q, err := mulDivRound(numVal, tenTo17, denVal, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmount(asset, q+5, numOffset-denOffset-17, num.negative != den.negative, mode)
+}
+
+// MulRound returns v1 * v2 as an amount of asset, rounded up (towards positive infinity) if roundUp is true
+// and down otherwise, as rippled's mulRound, which is used to compute the amounts of offers.
+// Unless both amounts are zero, a positive result rounded up is never zero, but the smallest positive amount.
+func MulRound(v1, v2 Amount, asset Asset, roundUp bool) (Amount, error) {
+	return mulRound(v1, v2, asset, roundUp, false)
+}
+
+// MulRoundStrict is MulRound with the precise rounding of rippled's mulRoundStrict: XRP results are only
+// rounded up if the exact product has a fractional part, and the result is canonicalized towards zero.
+func MulRoundStrict(v1, v2 Amount, asset Asset, roundUp bool) (Amount, error) {
+	return mulRound(v1, v2, asset, roundUp, true)
+}
+
+// DivRound returns num / den as an amount of asset, rounded up (towards positive infinity) if roundUp is true
+// and down otherwise, as rippled's divRound, which is used to compute the amounts of offers.
+// Unless num is zero, a positive result rounded up is never zero, but the smallest positive amount.
+func DivRound(num, den Amount, asset Asset, roundUp bool) (Amount, error) {
+	return divRound(num, den, asset, roundUp, false)
+}
+
+// DivRoundStrict is DivRound with the precise rounding of rippled's divRoundStrict, which canonicalizes the
+// result in the direction of roundUp.
+func DivRoundStrict(num, den Amount, asset Asset, roundUp bool) (Amount, error) {
+	return divRound(num, den, asset, roundUp, true)
+}
+
+// mulRound implements MulRound and MulRoundStrict.
+func mulRound(v1, v2 Amount, asset Asset, roundUp, strict bool) (Amount, error) {
+	if v1.IsZero() || v2.IsZero() {
+		return ZeroAmount(asset), nil
+	}
+	native := asset.Kind == types.XRP
+	if (native && v1.asset.Kind == types.XRP && v2.asset.Kind == types.XRP) ||
+		(asset.Kind == types.MPT && v1.asset.Kind == types.MPT && v2.asset.Kind == types.MPT) {
+		return RoundToNearest.Multiply(v1, v2, asset)
+	}
+
+	value1, offset1 := v1.scaledMantissa()
+	value2, offset2 := v2.scaledMantissa()
+	negative := v1.negative != v2.negative
+
+	// Both mantissas are in [1e15, 1e16): dividing their product by 1e14 keeps 16 to 18 digits.
+	// Rounding away from zero adds 1e14 - 1 before dividing, rounding towards zero truncates.
+	var rounding uint64
+	if negative != roundUp {
+		rounding = tenTo14m1
+	}
+	amount, err := mulDivRound(value1, value2, tenTo14, rounding)
+	if err != nil {
+		return Amount{}, err
+	}
+	offset := offset1 + offset2 + 14
+	if negative != roundUp {
+		if strict {
+			amount, offset = canonicalizeRoundStrict(native, amount, offset, roundUp)
+		} else {
+			amount, offset = canonicalizeRound(native, amount, offset)
+		}
+	}
+
+	mode := RoundToNearest
+	if strict {
+		mode = RoundTowardsZero
+	}
+	result, err := newAmount(asset, amount, offset, negative, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	if roundUp && !negative && result.IsZero() {
+		return smallestAmount(asset, native), nil
+	}
+	return result, nil
+}
+
+// divRound implements DivRound and DivRoundStrict.
+func divRound(num, den Amount, asset Asset, roundUp, strict bool) (Amount, error) {
+	if den.IsZero() {
+		return Amount{}, ErrDivisionByZero
+	}
+	if num.IsZero() {
+		return ZeroAmount(asset), nil
+	}
+
+	numVal, numOffset := num.scaledMantissa()
+	denVal, denOffset := den.scaledMantissa()
+	negative := num.negative != den.negative
+
+	// Both mantissas are in [1e15, 1e16): multiplying the numerator by 1e17 before dividing keeps 16 to 18 digits.
+	// Rounding away from zero adds den - 1 before dividing, rounding towards zero truncates.
+	var rounding uint64
+	if negative != roundUp {
+		rounding = denVal - 1
+	}
+	amount, err := mulDivRound(numVal, tenTo17, denVal, rounding)
+	if err != nil {
+		return Amount{}, err
+	}
+	offset := numOffset - denOffset - 17
+	if negative != roundUp {
+		amount, offset = canonicalizeRound(asset.isIntegral(), amount, offset)
+	}
+
+	mode := RoundToNearest
+	if strict {
+		mode = RoundDownward
+		if roundUp != negative {
+			mode = RoundUpward
+		}
+	}
+	result, err := newAmount(asset, amount, offset, negative, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	if roundUp && !negative && result.IsZero() {
+		return smallestAmount(asset, asset.isIntegral()), nil
+	}
+	return result, nil
+}
+
+// smallestAmount returns the smallest positive amount of the asset: one drop or one MPT unit if integral,
+// or else 1e-81.
+func smallestAmount(asset Asset, integral bool) Amount {
+	if integral {
+		return Amount{asset: asset, mantissa: 1}
+	}
+	return Amount{asset: asset, mantissa: minIOUMantissa, exponent: minIOUExponent}
+}
+
+// scaledMantissa returns the mantissa and exponent of the amount, with the mantissa of XRP and MPT amounts
+// scaled up to at least 1e15, as issued currency amounts.
+func (a Amount) scaledMantissa() (uint64, int) {
+	value, offset := a.mantissa, a.exponent
+	if a.asset.isIntegral() {
+		for value < minIOUMantissa {
+			value *= 10
+			offset--
+		}
+	}
+	return value, offset
+}
+
+// canonicalizeRound rounds a mantissa away from zero, as rippled's canonicalizeRound: to an integer if native,
+// or else to at most 16 digits.
+func canonicalizeRound(native bool, value uint64, offset int) (uint64, int) {
+	if native {
+		if offset < 0 {
+			loops := 0
+			for offset < -1 {
+				value /= 10
+				offset++
+				loops++
+			}
+			if loops >= 2 {
+				value += 9
+			} else {
+				value += 10
+			}
+			value /= 10
+			offset++
+		}
+		return value, offset
+	}
+	if value > maxIOUMantissa {
+		for value > 10*maxIOUMantissa {
+			value /= 10
+			offset++
+		}
+		value += 9
+		value /= 10
+		offset++
+	}
+	return value, offset
+}
+
+// canonicalizeRoundStrict is canonicalizeRound, where native values are only rounded up if roundUp is true and
+// a non-zero digit was dropped, as rippled's canonicalizeRoundStrict.
+func canonicalizeRoundStrict(native bool, value uint64, offset int, roundUp bool) (uint64, int) {
+	if !native {
+		return canonicalizeRound(native, value, offset)
+	}
+	if offset < 0 {
+		hadRemainder := false
+		for offset < -1 {
+			hadRemainder = hadRemainder || value%10 != 0
+			value /= 10
+			offset++
+		}
+		if hadRemainder && roundUp {
+			value += 10
+		} else {
+			value += 9
+		}
+		value /= 10
+		offset++
+	}
+	return value, offset
+}
+
+// mulDivRound returns (a * b + rounding) / c, or ErrAmountOverflow if it does not fit in an uint64.
+func mulDivRound(a, b, c, rounding uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	var carry uint64
+	lo, carry = bits.Add64(lo, rounding, 0)
+	hi += carry
+	if hi >= c {
+		return 0, ErrAmountOverflow
+	}
+	q, _ := bits.Div64(hi, lo, c)
+	return q, nil
+}
@@ -0,0 +1,330 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmount_Add(t *testing.T) {
+	tt := []struct {
+		description string
+		a, b        func(t *testing.T) Amount
+		sub         bool
+		expected    string
+		expectedErr error
+	}{
+		{
+			description: "pass - XRP",
+			a:           func(t *testing.T) Amount { return mustXRP(t, 1) },
+			b:           func(t *testing.T) Amount { return mustXRP(t, 2) },
+			expected:    "3",
+		},
+		{
+			description: "pass - XRP subtraction below zero",
+			a:           func(t *testing.T) Amount { return mustXRP(t, 1) },
+			b:           func(t *testing.T) Amount { return mustXRP(t, 2) },
+			sub:         true,
+			expected:    "-1",
+		},
+		{
+			description: "pass - issued currency is exact",
+			a:           func(t *testing.T) Amount { return mustIssued(t, "0.1") },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "0.2") },
+			expected:    "0.3",
+		},
+		{
+			description: "pass - issued currency rounded to 16 digits",
+			a:           func(t *testing.T) Amount { return mustIssued(t, "1") },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "6555555555555555e-29") },
+			expected:    "1.000000000000066",
+		},
+		{
+			description: "pass - issued currency subtraction",
+			a:           func(t *testing.T) Amount { return mustIssued(t, "1") },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "0.1") },
+			sub:         true,
+			expected:    "0.9",
+		},
+		{
+			description: "pass - issued currency to zero",
+			a:           func(t *testing.T) Amount { return mustIssued(t, "12.5") },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "12.5") },
+			sub:         true,
+			expected:    "0",
+		},
+		{
+			description: "pass - MPT",
+			a:           func(t *testing.T) Amount { return mustMPT(t, 100) },
+			b:           func(t *testing.T) Amount { return mustMPT(t, 50) },
+			sub:         true,
+			expected:    "50",
+		},
+		{
+			description: "fail - XRP out of range",
+			a:           func(t *testing.T) Amount { return mustXRP(t, MaxNativeDrops) },
+			b:           func(t *testing.T) Amount { return mustXRP(t, 1) },
+			expectedErr: ErrNativeAmountOutOfRange,
+		},
+		{
+			description: "fail - MPT overflow",
+			a:           func(t *testing.T) Amount { return mustMPT(t, MaxMPTAmount) },
+			b:           func(t *testing.T) Amount { return mustMPT(t, 1) },
+			expectedErr: ErrMPTAmountOutOfRange,
+		},
+		{
+			description: "fail - issued currency overflow",
+			a:           func(t *testing.T) Amount { return mustIssued(t, "9999999999999999e80") },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "1e80") },
+			expectedErr: ErrAmountOverflow,
+		},
+		{
+			description: "fail - not comparable",
+			a:           func(t *testing.T) Amount { return mustXRP(t, 1) },
+			b:           func(t *testing.T) Amount { return mustIssued(t, "1") },
+			expectedErr: ErrAmountsNotComparable,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			a, b := tc.a(t), tc.b(t)
+			op := a.Add
+			if tc.sub {
+				op = a.Sub
+			}
+			r, err := op(b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, a.Asset(), r.Asset())
+			require.Equal(t, tc.expected, r.String())
+		})
+	}
+}
+
+func TestAmount_Cmp(t *testing.T) {
+	other, err := NewIssuedAmount("USD", "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys", "2")
+	require.NoError(t, err)
+
+	tt := []struct {
+		description string
+		a, b        Amount
+		expected    int
+		expectedErr error
+	}{
+		{description: "pass - lower", a: mustIssued(t, "1"), b: mustIssued(t, "2"), expected: -1},
+		{description: "pass - greater exponent", a: mustIssued(t, "10"), b: mustIssued(t, "9"), expected: 1},
+		{description: "pass - negatives", a: mustIssued(t, "-10"), b: mustIssued(t, "-9"), expected: -1},
+		{description: "pass - zero and negative", a: mustIssued(t, "0"), b: mustIssued(t, "-1"), expected: 1},
+		{description: "pass - equal", a: mustIssued(t, "1.5"), b: mustIssued(t, "15e-1"), expected: 0},
+		{description: "pass - other issuer", a: mustIssued(t, "2"), b: other, expected: 0},
+		{description: "pass - XRP", a: mustXRP(t, 10), b: mustXRP(t, 9), expected: 1},
+		{description: "fail - not comparable", a: mustXRP(t, 10), b: mustMPT(t, 9), expectedErr: ErrAmountsNotComparable},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			c, err := tc.a.Cmp(tc.b)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, c)
+		})
+	}
+}
+
+func TestMultiplyDivide(t *testing.T) {
+	usd := IssuedAsset("USD", testIssuer)
+
+	tt := []struct {
+		description string
+		op          func() (Amount, error)
+		expected    string
+		expectedErr error
+	}{
+		{
+			description: "pass - XRP multiplication",
+			op:          func() (Amount, error) { return mustXRP(t, 2).Mul(mustXRP(t, -3)) },
+			expected:    "-6",
+		},
+		{
+			description: "pass - issued currency multiplication rounded to nearest",
+			op: func() (Amount, error) {
+				return mustIssued(t, "1.414213562373095").Mul(mustIssued(t, "1.414213562373095"))
+			},
+			expected: "2",
+		},
+		{
+			description: "pass - issued currency multiplication rounded towards zero",
+			op: func() (Amount, error) {
+				return RoundTowardsZero.Multiply(mustIssued(t, "1.414213562373095"), mustIssued(t, "1.414213562373095"), usd)
+			},
+			expected: "1.999999999999999",
+		},
+		{
+			description: "pass - XRP by issued currency into issued currency",
+			op:          func() (Amount, error) { return RoundToNearest.Multiply(mustXRP(t, 3), mustIssued(t, "0.5"), usd) },
+			expected:    "1.5",
+		},
+		{
+			description: "pass - issued currency by XRP into XRP",
+			op: func() (Amount, error) {
+				return RoundToNearest.Multiply(mustIssued(t, "0.5"), mustXRP(t, 3), XRPAsset())
+			},
+			expected: "2",
+		},
+		{
+			description: "pass - issued currency division",
+			op:          func() (Amount, error) { return mustIssued(t, "1").Div(mustIssued(t, "3")) },
+			expected:    "0.3333333333333334",
+		},
+		{
+			description: "pass - XRP division",
+			op:          func() (Amount, error) { return mustXRP(t, 10).Div(mustXRP(t, 3)) },
+			expected:    "3",
+		},
+		{
+			description: "pass - MPT division into issued currency",
+			op:          func() (Amount, error) { return RoundToNearest.Divide(mustMPT(t, 1), mustMPT(t, 8), usd) },
+			expected:    "0.125",
+		},
+		{
+			description: "pass - zero",
+			op:          func() (Amount, error) { return mustIssued(t, "0").Mul(mustIssued(t, "3")) },
+			expected:    "0",
+		},
+		{
+			description: "fail - XRP multiplication overflow",
+			op:          func() (Amount, error) { return mustXRP(t, 3_000_000_001).Mul(mustXRP(t, 3_000_000_001)) },
+			expectedErr: ErrNativeAmountOutOfRange,
+		},
+		{
+			description: "fail - MPT multiplication overflow",
+			op:          func() (Amount, error) { return mustMPT(t, 3_037_000_500).Mul(mustMPT(t, 3_037_000_500)) },
+			expectedErr: ErrMPTAmountOutOfRange,
+		},
+		{
+			description: "fail - issued currency multiplication overflow",
+			op:          func() (Amount, error) { return mustIssued(t, "1e50").Mul(mustIssued(t, "1e50")) },
+			expectedErr: ErrAmountOverflow,
+		},
+		{
+			description: "fail - division by zero",
+			op:          func() (Amount, error) { return mustIssued(t, "1").Div(mustIssued(t, "0")) },
+			expectedErr: ErrDivisionByZero,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			r, err := tc.op()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, r.String())
+		})
+	}
+}
+
+func TestMulRoundDivRound(t *testing.T) {
+	usd := IssuedAsset("USD", testIssuer)
+
+	tt := []struct {
+		description string
+		op          func() (Amount, error)
+		expected    string
+		expectedErr error
+	}{
+		{
+			description: "pass - MulRound up into XRP",
+			op:          func() (Amount, error) { return MulRound(mustXRP(t, 3), mustIssued(t, "0.5"), XRPAsset(), true) },
+			expected:    "2",
+		},
+		{
+			description: "pass - MulRound down into XRP rounds the truncated value to nearest",
+			op:          func() (Amount, error) { return MulRound(mustXRP(t, 3), mustIssued(t, "0.5"), XRPAsset(), false) },
+			expected:    "2",
+		},
+		{
+			description: "pass - MulRoundStrict down into XRP",
+			op:          func() (Amount, error) { return MulRoundStrict(mustXRP(t, 3), mustIssued(t, "0.5"), XRPAsset(), false) },
+			expected:    "1",
+		},
+		{
+			description: "pass - MulRound down negative into XRP",
+			op:          func() (Amount, error) { return MulRound(mustXRP(t, -3), mustIssued(t, "0.5"), XRPAsset(), false) },
+			expected:    "-2",
+		},
+		{
+			description: "pass - MulRound up of an underflow is the smallest amount",
+			op:          func() (Amount, error) { return MulRound(mustIssued(t, "1e-81"), mustIssued(t, "1e-81"), usd, true) },
+			expected:    "1000000000000000e-96",
+		},
+		{
+			description: "pass - MulRound down of an underflow is zero",
+			op:          func() (Amount, error) { return MulRound(mustIssued(t, "1e-81"), mustIssued(t, "1e-81"), usd, false) },
+			expected:    "0",
+		},
+		{
+			description: "pass - DivRound up",
+			op:          func() (Amount, error) { return DivRound(mustIssued(t, "2"), mustIssued(t, "3"), usd, true) },
+			expected:    "0.6666666666666667",
+		},
+		{
+			description: "pass - DivRound down rounds the truncated value to nearest",
+			op:          func() (Amount, error) { return DivRound(mustIssued(t, "2"), mustIssued(t, "3"), usd, false) },
+			expected:    "0.6666666666666667",
+		},
+		{
+			description: "pass - DivRoundStrict down",
+			op:          func() (Amount, error) { return DivRoundStrict(mustIssued(t, "2"), mustIssued(t, "3"), usd, false) },
+			expected:    "0.6666666666666666",
+		},
+		{
+			description: "pass - DivRound up into XRP",
+			op:          func() (Amount, error) { return DivRound(mustXRP(t, 10), mustXRP(t, 3), XRPAsset(), true) },
+			expected:    "4",
+		},
+		{
+			description: "pass - DivRound up into XRP is at least one drop",
+			op:          func() (Amount, error) { return DivRound(mustXRP(t, 1), mustIssued(t, "1e10"), XRPAsset(), true) },
+			expected:    "1",
+		},
+		{
+			description: "pass - DivRoundStrict down into XRP",
+			op:          func() (Amount, error) { return DivRoundStrict(mustXRP(t, 1), mustIssued(t, "1e10"), XRPAsset(), false) },
+			expected:    "0",
+		},
+		{
+			description: "fail - DivRound by zero",
+			op:          func() (Amount, error) { return DivRound(mustXRP(t, 1), mustXRP(t, 0), XRPAsset(), true) },
+			expectedErr: ErrDivisionByZero,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			r, err := tc.op()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, r.String())
+		})
+	}
+}
+
+func TestAmount_Neg(t *testing.T) {
+	require.Equal(t, "-1.5", mustIssued(t, "1.5").Neg().String())
+	require.Equal(t, "1.5", mustIssued(t, "-1.5").Abs().String())
+	require.Equal(t, 0, mustIssued(t, "0").Neg().Signum())
+	require.Equal(t, int64(-5), mustXRP(t, 5).Neg().Int64())
+}
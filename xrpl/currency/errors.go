@@ -0,0 +1,22 @@
+package currency
+
+import "errors"
+
+var (
+	// ErrInvalidAmountValue is returned when the value of an amount is not a valid decimal number.
+	ErrInvalidAmountValue = errors.New("invalid amount value")
+	// ErrAmountOverflow is returned when the result of an operation is too large to be represented.
+	ErrAmountOverflow = errors.New("amount value overflow")
+	// ErrNativeAmountOutOfRange is returned when an XRP amount exceeds the 100 billion XRP maximum.
+	ErrNativeAmountOutOfRange = errors.New("native currency amount out of range")
+	// ErrMPTAmountOutOfRange is returned when an MPT amount exceeds the maximum MPT amount.
+	ErrMPTAmountOutOfRange = errors.New("MPT amount out of range")
+	// ErrDivisionByZero is returned when dividing an amount by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrAmountsNotComparable is returned when adding, subtracting or comparing amounts of different assets.
+	ErrAmountsNotComparable = errors.New("amounts are not comparable")
+	// ErrNegativeXRPAmount is returned when converting a negative XRP amount to an XRPCurrencyAmount.
+	ErrNegativeXRPAmount = errors.New("negative XRP amount cannot be represented as an XRPCurrencyAmount")
+	// ErrUnsupportedCurrencyAmount is returned when converting a CurrencyAmount of an unknown type.
+	ErrUnsupportedCurrencyAmount = errors.New("unsupported currency amount")
+)
@@ -1,4 +1,5 @@
-// Package currency provides utilities for working with XRP native currency conversions and calculations.
+// Package currency provides utilities for working with XRP native currency conversions and calculations,
+// and exact XRP, issued currency and MPT amount arithmetic.
 package currency
 
 import (
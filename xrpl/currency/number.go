@@ -0,0 +1,341 @@
+package currency
+
+import (
+	"math"
+	"math/bits"
+)
+
+// RoundingMode is the rounding mode of amount arithmetic, as the rounding modes of rippled's Number.
+// Results with more than 16 significant digits, and XRP and MPT results with a fractional part, are rounded with it.
+type RoundingMode int
+
+const (
+	// RoundToNearest rounds to the nearest value, ties to even. It is the default rounding mode of rippled.
+	RoundToNearest RoundingMode = iota
+	// RoundTowardsZero truncates.
+	RoundTowardsZero
+	// RoundDownward rounds towards negative infinity.
+	RoundDownward
+	// RoundUpward rounds towards positive infinity.
+	RoundUpward
+)
+
+const (
+	numberMinMantissa uint64 = 1_000_000_000_000_000
+	numberMaxMantissa uint64 = 9_999_999_999_999_999
+	numberMinExponent        = -32768
+	numberMaxExponent        = 32768
+)
+
+// number is a port of rippled's Number: a decimal floating point value with a 16 digit mantissa,
+// used for the arithmetic of issued currency amounts, and of XRP and MPT amounts of other assets.
+// The mantissa is in [1e15, 1e16) and the exponent in [-32768, 32768], or the mantissa is 0.
+type number struct {
+	mantissa uint64
+	exponent int
+	negative bool
+}
+
+// guard holds the decimal digits shifted off a mantissa, to round it, as rippled's Number::Guard.
+// digits holds up to 16 digits, one per nibble, the first shifted off digit last.
+type guard struct {
+	digits   uint64
+	xbit     bool
+	negative bool
+}
+
+// push shifts a digit off the mantissa into the guard.
+func (g *guard) push(d uint64) {
+	g.xbit = g.xbit || g.digits&0xF != 0
+	g.digits >>= 4
+	g.digits |= (d & 0xF) << 60
+}
+
+// pop takes back the last digit pushed into the guard.
+func (g *guard) pop() uint64 {
+	d := g.digits >> 60
+	g.digits <<= 4
+	return d
+}
+
+// round returns 1 if the mantissa must be rounded away from zero, -1 if it must be truncated,
+// and 0 if the guard is exactly half, for round to nearest.
+func (g *guard) round(mode RoundingMode) int {
+	const half = 0x5000_0000_0000_0000
+
+	switch mode {
+	case RoundTowardsZero:
+		return -1
+	case RoundDownward:
+		if g.negative && (g.digits > 0 || g.xbit) {
+			return 1
+		}
+		return -1
+	case RoundUpward:
+		if g.negative {
+			return -1
+		}
+		if g.digits > 0 || g.xbit {
+			return 1
+		}
+		return -1
+	}
+
+	switch {
+	case g.digits > half:
+		return 1
+	case g.digits < half:
+		return -1
+	case g.xbit:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// newNumber returns the normalized number of a mantissa and an exponent, rounded with mode.
+func newNumber(mantissa uint64, exponent int, negative bool, mode RoundingMode) (number, error) {
+	if mantissa == 0 {
+		return number{}, nil
+	}
+
+	for mantissa < numberMinMantissa && exponent > numberMinExponent {
+		mantissa *= 10
+		exponent--
+	}
+	g := guard{negative: negative}
+	for mantissa > numberMaxMantissa {
+		if exponent >= numberMaxExponent {
+			return number{}, ErrAmountOverflow
+		}
+		g.push(mantissa % 10)
+		mantissa /= 10
+		exponent++
+	}
+	if exponent < numberMinExponent || mantissa < numberMinMantissa {
+		return number{}, nil
+	}
+
+	if r := g.round(mode); r == 1 || (r == 0 && mantissa&1 == 1) {
+		mantissa++
+		if mantissa > numberMaxMantissa {
+			mantissa /= 10
+			exponent++
+		}
+	}
+	if exponent > numberMaxExponent {
+		return number{}, ErrAmountOverflow
+	}
+	return number{mantissa: mantissa, exponent: exponent, negative: negative}, nil
+}
+
+// isZero reports whether the number is zero.
+func (x number) isZero() bool {
+	return x.mantissa == 0
+}
+
+// neg returns the opposite of the number.
+func (x number) neg() number {
+	if x.isZero() {
+		return x
+	}
+	x.negative = !x.negative
+	return x
+}
+
+// add returns x + y, rounded with mode.
+func (x number) add(y number, mode RoundingMode) (number, error) {
+	switch {
+	case y.isZero():
+		return x, nil
+	case x.isZero():
+		return y, nil
+	case x == y.neg():
+		return number{}, nil
+	}
+
+	xm, xe, xn := x.mantissa, x.exponent, x.negative
+	ym, ye, yn := y.mantissa, y.exponent, y.negative
+
+	var g guard
+	if xe < ye {
+		g.negative = xn
+		for xe < ye {
+			g.push(xm % 10)
+			xm /= 10
+			xe++
+		}
+	} else if xe > ye {
+		g.negative = yn
+		for xe > ye {
+			g.push(ym % 10)
+			ym /= 10
+			ye++
+		}
+	}
+
+	if xn == yn {
+		xm += ym
+		if xm > numberMaxMantissa {
+			g.push(xm % 10)
+			xm /= 10
+			xe++
+		}
+		if r := g.round(mode); r == 1 || (r == 0 && xm&1 == 1) {
+			xm++
+			if xm > numberMaxMantissa {
+				xm /= 10
+				xe++
+			}
+		}
+		if xe > numberMaxExponent {
+			return number{}, ErrAmountOverflow
+		}
+		return number{mantissa: xm, exponent: xe, negative: xn}, nil
+	}
+
+	if xm > ym {
+		xm -= ym
+	} else {
+		xm = ym - xm
+		xe = ye
+		xn = yn
+	}
+	for xm < numberMinMantissa {
+		xm *= 10
+		xm -= g.pop()
+		xe--
+	}
+	if r := g.round(mode); r == 1 || (r == 0 && xm&1 == 1) {
+		xm--
+		if xm < numberMinMantissa {
+			xm *= 10
+			xe--
+		}
+	}
+	if xe < numberMinExponent {
+		return number{}, nil
+	}
+	return number{mantissa: xm, exponent: xe, negative: xn}, nil
+}
+
+// mul returns x * y, rounded with mode.
+func (x number) mul(y number, mode RoundingMode) (number, error) {
+	if x.isZero() || y.isZero() {
+		return number{}, nil
+	}
+
+	hi, lo := bits.Mul64(x.mantissa, y.mantissa)
+	ze := x.exponent + y.exponent
+	zn := x.negative != y.negative
+
+	g := guard{negative: zn}
+	for hi != 0 || lo > numberMaxMantissa {
+		var r uint64
+		hi, r = hi/10, hi%10
+		lo, r = bits.Div64(r, lo, 10)
+		g.push(r)
+		ze++
+	}
+
+	xm, xe := lo, ze
+	if r := g.round(mode); r == 1 || (r == 0 && xm&1 == 1) {
+		xm++
+		if xm > numberMaxMantissa {
+			xm /= 10
+			xe++
+		}
+	}
+	if xe < numberMinExponent {
+		return number{}, nil
+	}
+	if xe > numberMaxExponent {
+		return number{}, ErrAmountOverflow
+	}
+	return number{mantissa: xm, exponent: xe, negative: zn}, nil
+}
+
+// div returns x / y, rounded with mode. As rippled, the quotient is computed with 17 extra digits and truncated
+// before being rounded to 16 digits, so the remainder does not take part in rounding.
+func (x number) div(y number, mode RoundingMode) (number, error) {
+	if y.isZero() {
+		return number{}, ErrDivisionByZero
+	}
+	if x.isZero() {
+		return number{}, nil
+	}
+
+	hi, lo := bits.Mul64(x.mantissa, tenTo17)
+	q, _ := bits.Div64(hi, lo, y.mantissa)
+	return newNumber(q, x.exponent-y.exponent-17, x.negative != y.negative, mode)
+}
+
+// toInt64 returns the number rounded to an integer with mode, as the conversion of rippled's Number to an integer.
+func (x number) toInt64(mode RoundingMode) (int64, error) {
+	return integerValue(x.mantissa, x.exponent, x.negative, mode)
+}
+
+// integerValue returns mantissa * 10^exponent rounded to an integer with mode.
+func integerValue(mantissa uint64, exponent int, negative bool, mode RoundingMode) (int64, error) {
+	if mantissa == 0 {
+		return 0, nil
+	}
+
+	g := guard{negative: negative}
+	for ; exponent < 0; exponent++ {
+		g.push(mantissa % 10)
+		mantissa /= 10
+	}
+	for ; exponent > 0; exponent-- {
+		if mantissa > math.MaxInt64/10 {
+			return 0, ErrAmountOverflow
+		}
+		mantissa *= 10
+	}
+	if r := g.round(mode); r == 1 || (r == 0 && mantissa&1 == 1) {
+		mantissa++
+	}
+	if mantissa > math.MaxInt64 {
+		return 0, ErrAmountOverflow
+	}
+	if negative {
+		return -int64(mantissa), nil
+	}
+	return int64(mantissa), nil
+}
+
+// cmp compares two numbers and returns -1, 0 or 1.
+func (x number) cmp(y number) int {
+	switch {
+	case x.negative != y.negative:
+		if x.negative {
+			return -1
+		}
+		return 1
+	case x.isZero() || y.isZero():
+		switch {
+		case x.isZero() && y.isZero():
+			return 0
+		case x.isZero() && y.negative, !x.isZero() && !x.negative:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	c := 0
+	switch {
+	case x.exponent > y.exponent:
+		c = 1
+	case x.exponent < y.exponent:
+		c = -1
+	case x.mantissa > y.mantissa:
+		c = 1
+	case x.mantissa < y.mantissa:
+		c = -1
+	}
+	if x.negative {
+		return -c
+	}
+	return c
+}
@@ -0,0 +1,240 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumber_Add(t *testing.T) {
+	tt := []struct {
+		description string
+		x, y        number
+		mode        RoundingMode
+		expected    number
+	}{
+		{
+			description: "pass - round up the shifted digits",
+			x:           number{mantissa: 1_000_000_000_000_000, exponent: -15},
+			y:           number{mantissa: 6_555_555_555_555_555, exponent: -29},
+			expected:    number{mantissa: 1_000_000_000_000_066, exponent: -15},
+		},
+		{
+			description: "pass - negative sum",
+			x:           number{mantissa: 1_000_000_000_000_000, exponent: -15, negative: true},
+			y:           number{mantissa: 6_555_555_555_555_555, exponent: -29, negative: true},
+			expected:    number{mantissa: 1_000_000_000_000_066, exponent: -15, negative: true},
+		},
+		{
+			description: "pass - difference borrows from the shifted digits",
+			x:           number{mantissa: 1_000_000_000_000_000, exponent: -15, negative: true},
+			y:           number{mantissa: 6_555_555_555_555_555, exponent: -29},
+			expected:    number{mantissa: 9_999_999_999_999_344, exponent: -16, negative: true},
+		},
+		{
+			description: "pass - difference of the opposite operands",
+			x:           number{mantissa: 6_555_555_555_555_555, exponent: -29, negative: true},
+			y:           number{mantissa: 1_000_000_000_000_000, exponent: -15},
+			expected:    number{mantissa: 9_999_999_999_999_344, exponent: -16},
+		},
+		{
+			description: "pass - difference rounded to nearest",
+			x:           number{mantissa: 9_999_999_999_999_999, exponent: -31, negative: true},
+			y:           number{mantissa: 1_000_000_000_000_000, exponent: -15},
+			expected:    number{mantissa: 9_999_999_999_999_990, exponent: -16},
+		},
+		{
+			description: "pass - zero",
+			x:           number{},
+			y:           number{mantissa: 5_000_000_000_000_000, exponent: -15},
+			expected:    number{mantissa: 5_000_000_000_000_000, exponent: -15},
+		},
+		{
+			description: "pass - opposite operands",
+			x:           number{mantissa: 5_555_555_555_555_555, exponent: -20},
+			y:           number{mantissa: 5_555_555_555_555_555, exponent: -20, negative: true},
+			expected:    number{},
+		},
+		{
+			description: "pass - round towards zero",
+			x:           number{mantissa: 1_000_000_000_000_000, exponent: -15},
+			y:           number{mantissa: 6_555_555_555_555_555, exponent: -29},
+			mode:        RoundTowardsZero,
+			expected:    number{mantissa: 1_000_000_000_000_065, exponent: -15},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			z, err := tc.x.add(tc.y, tc.mode)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, z)
+		})
+	}
+}
+
+func TestNumber_Mul(t *testing.T) {
+	sqrt2 := number{mantissa: 1_414_213_562_373_095, exponent: -15}
+
+	tt := []struct {
+		description string
+		x, y        number
+		mode        RoundingMode
+		expected    number
+	}{
+		{
+			description: "pass - integers",
+			x:           number{mantissa: 7_000_000_000_000_000, exponent: -15},
+			y:           number{mantissa: 8_000_000_000_000_000, exponent: -15},
+			expected:    number{mantissa: 5_600_000_000_000_000, exponent: -14},
+		},
+		{
+			description: "pass - round to nearest",
+			x:           sqrt2,
+			y:           sqrt2,
+			expected:    number{mantissa: 2_000_000_000_000_000, exponent: -15},
+		},
+		{
+			description: "pass - round towards zero",
+			x:           sqrt2,
+			y:           sqrt2,
+			mode:        RoundTowardsZero,
+			expected:    number{mantissa: 1_999_999_999_999_999, exponent: -15},
+		},
+		{
+			description: "pass - round downward negative",
+			x:           sqrt2,
+			y:           sqrt2.neg(),
+			mode:        RoundDownward,
+			expected:    number{mantissa: 2_000_000_000_000_000, exponent: -15, negative: true},
+		},
+		{
+			description: "pass - round up to a new exponent",
+			x:           number{mantissa: 3_214_285_714_285_706, exponent: -15},
+			y:           number{mantissa: 3_111_111_111_111_119, exponent: -15},
+			expected:    number{mantissa: 1_000_000_000_000_000, exponent: -14},
+		},
+		{
+			description: "pass - underflow",
+			x:           number{mantissa: 1_000_000_000_000_000, exponent: numberMinExponent},
+			y:           number{mantissa: 1_000_000_000_000_000, exponent: numberMinExponent},
+			expected:    number{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			z, err := tc.x.mul(tc.y, tc.mode)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, z)
+		})
+	}
+
+	t.Run("fail - overflow", func(t *testing.T) {
+		x := number{mantissa: 1_000_000_000_000_000, exponent: numberMaxExponent}
+		_, err := x.mul(x, RoundToNearest)
+		require.ErrorIs(t, err, ErrAmountOverflow)
+	})
+}
+
+func TestNumber_Div(t *testing.T) {
+	one := number{mantissa: 1_000_000_000_000_000, exponent: -15}
+	seven := number{mantissa: 7_000_000_000_000_000, exponent: -15}
+
+	tt := []struct {
+		description string
+		x, y        number
+		mode        RoundingMode
+		expected    number
+		expectedErr error
+	}{
+		{
+			description: "pass - exact",
+			x:           one,
+			y:           number{mantissa: 2_000_000_000_000_000, exponent: -15},
+			expected:    number{mantissa: 5_000_000_000_000_000, exponent: -16},
+		},
+		{
+			description: "pass - truncated quotient rounded to nearest even",
+			x:           one,
+			y:           seven,
+			expected:    number{mantissa: 1_428_571_428_571_428, exponent: -16},
+		},
+		{
+			description: "pass - round upward",
+			x:           one,
+			y:           seven,
+			mode:        RoundUpward,
+			expected:    number{mantissa: 1_428_571_428_571_429, exponent: -16},
+		},
+		{
+			description: "pass - round downward negative",
+			x:           one.neg(),
+			y:           seven,
+			mode:        RoundDownward,
+			expected:    number{mantissa: 1_428_571_428_571_429, exponent: -16, negative: true},
+		},
+		{
+			description: "pass - zero numerator",
+			x:           number{},
+			y:           seven,
+			expected:    number{},
+		},
+		{
+			description: "fail - division by zero",
+			x:           one,
+			y:           number{},
+			expectedErr: ErrDivisionByZero,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			z, err := tc.x.div(tc.y, tc.mode)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, z)
+		})
+	}
+}
+
+func TestIntegerValue(t *testing.T) {
+	tt := []struct {
+		description string
+		mantissa    uint64
+		exponent    int
+		negative    bool
+		mode        RoundingMode
+		expected    int64
+		expectedErr error
+	}{
+		{description: "pass - zero", expected: 0},
+		{description: "pass - integer", mantissa: 1155, expected: 1155},
+		{description: "pass - positive exponent", mantissa: 9_999_999_999_999_999, exponent: 2, expected: 999_999_999_999_999_900},
+		{description: "pass - half to even down", mantissa: 25, exponent: -1, expected: 2},
+		{description: "pass - half to even up", mantissa: 15, exponent: -1, expected: 2},
+		{description: "pass - above half", mantissa: 16, exponent: -1, expected: 2},
+		{description: "pass - below half", mantissa: 14, exponent: -1, expected: 1},
+		{description: "pass - negative half to even", mantissa: 15, exponent: -1, negative: true, expected: -2},
+		{description: "pass - towards zero", mantissa: 19, exponent: -1, mode: RoundTowardsZero, expected: 1},
+		{description: "pass - downward negative", mantissa: 11, exponent: -1, negative: true, mode: RoundDownward, expected: -2},
+		{description: "pass - upward", mantissa: 11, exponent: -1, mode: RoundUpward, expected: 2},
+		{description: "pass - upward negative", mantissa: 19, exponent: -1, negative: true, mode: RoundUpward, expected: -1},
+		{description: "fail - overflow", mantissa: 1_000_000_000_000_000, exponent: 4, expectedErr: ErrAmountOverflow},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			v, err := integerValue(tc.mantissa, tc.exponent, tc.negative, tc.mode)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, v)
+		})
+	}
+}
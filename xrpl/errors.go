@@ -1,8 +1,84 @@
 package xrpl
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrNoTxToMultisign is returned when no transaction blobs are provided to Multisign.
 	ErrNoTxToMultisign = errors.New("no transaction to multisign")
+
+	// transaction
+
+	// ErrMissingAccountInTransaction is returned when the Account field is missing from a transaction.
+	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
+	// ErrTransactionTypeMissing is returned when the transaction type is missing from a transaction.
+	ErrTransactionTypeMissing = errors.New("transaction type is missing in transaction")
+	// ErrTransactionNotFound is returned when a transaction cannot be found.
+	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
+	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+
+	// fields
+
+	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
+	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
+	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	// ErrSigningPubKeyFieldMustBeEmpty is returned when the SigningPubKey field should be empty but isn't.
+	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	// ErrTxnSignatureFieldMustBeEmpty is returned when the TxnSignature field should be empty but isn't.
+	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	// ErrSignersFieldMustBeEmpty is returned when the Signers field should be empty but isn't.
+	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	// ErrAccountFieldIsNotAString is returned when the Account field is not a string type.
+	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
+	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
+	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
+	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+
+	// fees
+
+	// ErrCouldNotGetBaseFeeXrp is returned when BaseFeeXrp cannot be retrieved from ServerInfo.
+	ErrCouldNotGetBaseFeeXrp = errors.New("get fee xrp: could not get BaseFeeXrp from ServerInfo")
+	// ErrCouldNotFetchOwnerReserve is returned when the owner reserve fee cannot be fetched.
+	ErrCouldNotFetchOwnerReserve = errors.New("could not fetch Owner Reserve")
+
+	// account
+
+	// ErrAccountCannotBeDeleted is returned when an account cannot be deleted due to associated objects.
+	ErrAccountCannotBeDeleted = errors.New("account cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
+
+	// payment
+
+	// ErrAmountAndDeliverMaxMustBeIdentical is returned when Amount and DeliverMax fields are not identical.
+	ErrAmountAndDeliverMaxMustBeIdentical = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
 )
+
+// Dynamic errors
+
+// ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
+type ErrMismatchedTag struct {
+	Expected string
+	Actual   string
+}
+
+// Error implements the error interface for ErrMismatchedTag
+func (e ErrMismatchedTag) Error() string {
+	return fmt.Sprintf("transaction tag mismatch: %q must equal %q", e.Actual, e.Expected)
+}
+
+// ErrFailedToParseFee is returned when fee parsing fails.
+type ErrFailedToParseFee struct {
+	Fee string
+	Err error
+}
+
+// Error implements the error interface for ErrFailedToParseFee
+func (e ErrFailedToParseFee) Error() string {
+	return fmt.Sprintf("failed to parse fee: %q: %v", e.Fee, e.Err)
+}
@@ -0,0 +1,89 @@
+package xrpl
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Request is a request sent by a client. It is implemented by every request of the queries packages.
+type Request interface {
+	Method() string
+	APIVersion() int
+	Validate() error
+}
+
+// Response is a response returned by a client. It is implemented by rpc.Response and websocket.ClientResponse.
+type Response interface {
+	GetResult(v any) error
+}
+
+// Invoker sends a request to the server and returns its response.
+type Invoker func(req Request) (Response, error)
+
+// Interceptor wraps the sending of a request. It can observe or modify the request and the response,
+// and must call next to send the request, unless it wants to short-circuit it.
+type Interceptor func(req Request, next Invoker) (Response, error)
+
+// ChainInterceptors combines the interceptors into a single one.
+// The first interceptor is the outermost one: it is called first and returns last.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	return func(req Request, next Invoker) (Response, error) {
+		return Intercept(req, next, interceptors...)
+	}
+}
+
+// Intercept sends the request through the interceptors, in order, and finally to invoker.
+func Intercept(req Request, invoker Invoker, interceptors ...Interceptor) (Response, error) {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(req Request) (Response, error) {
+			return interceptor(req, next)
+		}
+	}
+	return invoker(req)
+}
+
+// LoggingInterceptor returns an Interceptor that logs every request with the given logger.
+// Successful requests are logged at debug level, failed ones at error level,
+// with the method name, the duration and the error, if any.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return func(req Request, next Invoker) (Response, error) {
+		start := time.Now()
+		res, err := next(req)
+		duration := time.Since(start)
+
+		if err != nil {
+			logger.LogAttrs(context.Background(), slog.LevelError, "xrpl request failed",
+				slog.String("method", req.Method()),
+				slog.Duration("duration", duration),
+				slog.String("error", err.Error()),
+			)
+			return res, err
+		}
+
+		logger.LogAttrs(context.Background(), slog.LevelDebug, "xrpl request",
+			slog.String("method", req.Method()),
+			slog.Duration("duration", duration),
+		)
+		return res, err
+	}
+}
+
+// MetricsRecorder records the outcome of requests.
+// It can be implemented on top of any metrics library. For example, with Prometheus,
+// ObserveRequest would increment a requests counter and, if err is not nil, an errors counter,
+// and observe a latency histogram, all labelled by method.
+type MetricsRecorder interface {
+	ObserveRequest(method string, duration time.Duration, err error)
+}
+
+// MetricsInterceptor returns an Interceptor that records the method, duration and error of every request.
+func MetricsInterceptor(recorder MetricsRecorder) Interceptor {
+	return func(req Request, next Invoker) (Response, error) {
+		start := time.Now()
+		res, err := next(req)
+		recorder.ObserveRequest(req.Method(), time.Since(start), err)
+		return res, err
+	}
+}
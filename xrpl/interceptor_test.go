@@ -0,0 +1,92 @@
+package xrpl
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResponse struct{}
+
+func (mockResponse) GetResult(any) error { return nil }
+
+type mockRecorder struct {
+	methods []string
+	errs    []error
+}
+
+func (m *mockRecorder) ObserveRequest(method string, _ time.Duration, err error) {
+	m.methods = append(m.methods, method)
+	m.errs = append(m.errs, err)
+}
+
+func TestIntercept(t *testing.T) {
+	var calls []string
+
+	tracing := func(name string) Interceptor {
+		return func(req Request, next Invoker) (Response, error) {
+			calls = append(calls, name+" before")
+			res, err := next(req)
+			calls = append(calls, name+" after")
+			return res, err
+		}
+	}
+	invoker := func(Request) (Response, error) {
+		calls = append(calls, "invoker")
+		return mockResponse{}, nil
+	}
+
+	res, err := Intercept(&utility.PingRequest{}, invoker, tracing("first"), ChainInterceptors(tracing("second"), tracing("third")))
+	require.NoError(t, err)
+	require.Equal(t, mockResponse{}, res)
+	require.Equal(t, []string{
+		"first before",
+		"second before",
+		"third before",
+		"invoker",
+		"third after",
+		"second after",
+		"first after",
+	}, calls)
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	_, err := Intercept(&utility.PingRequest{}, func(Request) (Response, error) {
+		return mockResponse{}, nil
+	}, LoggingInterceptor(logger))
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "level=DEBUG")
+	require.Contains(t, buf.String(), "method=ping")
+
+	buf.Reset()
+	_, err = Intercept(&utility.RandomRequest{}, func(Request) (Response, error) {
+		return nil, errors.New("noNetwork")
+	}, LoggingInterceptor(logger))
+	require.EqualError(t, err, "noNetwork")
+	require.Contains(t, buf.String(), "level=ERROR")
+	require.Contains(t, buf.String(), "method=random")
+	require.Contains(t, buf.String(), "error=noNetwork")
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	recorder := &mockRecorder{}
+	errNoNetwork := errors.New("noNetwork")
+
+	_, _ = Intercept(&utility.PingRequest{}, func(Request) (Response, error) {
+		return mockResponse{}, nil
+	}, MetricsInterceptor(recorder))
+	_, _ = Intercept(&utility.RandomRequest{}, func(Request) (Response, error) {
+		return nil, errNoNetwork
+	}, MetricsInterceptor(recorder))
+
+	require.Equal(t, []string{"ping", "random"}, recorder.methods)
+	require.Equal(t, []error{nil, errNoNetwork}, recorder.errs)
+}
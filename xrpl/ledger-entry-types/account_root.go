@@ -158,6 +158,11 @@ func (a *AccountRoot) SetLsfDisableMaster() {
 	a.Flags |= lsfDisableMaster
 }
 
+// IsLsfDisableMaster reports whether the DisableMaster flag is set.
+func (a *AccountRoot) IsLsfDisableMaster() bool {
+	return a.Flags&lsfDisableMaster != 0
+}
+
 // SetLsfDisallowIncomingCheck sets the DisallowIncomingCheck flag.
 func (a *AccountRoot) SetLsfDisallowIncomingCheck() {
 	a.Flags |= lsfDisallowIncomingCheck
@@ -73,6 +73,15 @@ func TestAccountRoot_SetLsfDisableMaster(t *testing.T) {
 	require.Equal(t, ar.Flags, lsfDisableMaster)
 }
 
+func TestAccountRoot_IsLsfDisableMaster(t *testing.T) {
+	ar := &AccountRoot{}
+	require.False(t, ar.IsLsfDisableMaster())
+	ar.SetLsfDepositAuth()
+	require.False(t, ar.IsLsfDisableMaster())
+	ar.SetLsfDisableMaster()
+	require.True(t, ar.IsLsfDisableMaster())
+}
+
 func TestAccountRoot_SetLsfDisallowIncomingCheck(t *testing.T) {
 	ar := &AccountRoot{}
 	ar.SetLsfDisallowIncomingCheck()
@@ -0,0 +1,70 @@
+package manifest
+
+import (
+	"github.com/Peersyst/xrpl-go/keypairs"
+)
+
+// Keypair is a hex encoded key pair, as returned by keypairs.DeriveKeypair.
+type Keypair struct {
+	PrivateKey string
+	PublicKey  string
+}
+
+// Create creates a manifest that delegates to the signing key, at the given sequence, signed by
+// both the master and the signing keys. A manifest replaces any manifest of the same master key
+// with a lower sequence, so rotating the signing key is done by creating a manifest with a higher sequence.
+func Create(master, signing Keypair, sequence uint32, domain string) (*Manifest, error) {
+	if sequence == RevokedSequence {
+		return nil, ErrRevokedSequence
+	}
+
+	m := &Manifest{
+		MasterKey:  master.PublicKey,
+		SigningKey: signing.PublicKey,
+		Sequence:   sequence,
+		Domain:     domain,
+	}
+	if err := m.Sign(master.PrivateKey, signing.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Revoke creates a manifest that revokes the master key, signed by the master key only.
+// Once it is broadcast, validations signed by any key delegated by the master key are no longer trusted.
+func Revoke(master Keypair) (*Manifest, error) {
+	m := &Manifest{
+		MasterKey: master.PublicKey,
+		Sequence:  RevokedSequence,
+	}
+	if err := m.Sign(master.PrivateKey, ""); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Sign signs the manifest with the master private key and, if it is not empty, the signing private key,
+// replacing any existing signatures. The signatures are verified before returning.
+func (m *Manifest) Sign(masterPriv, signingPriv string) error {
+	m.MasterSignature, m.Signature = "", ""
+
+	data, err := m.SigningData()
+	if err != nil {
+		return err
+	}
+
+	m.MasterSignature, err = keypairs.Sign(string(data), masterPriv)
+	if err != nil {
+		return err
+	}
+	if signingPriv != "" {
+		m.Signature, err = keypairs.Sign(string(data), signingPriv)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.Verify()
+}
@@ -0,0 +1,104 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeypair returns the node key pair derived from a seed.
+func testKeypair(t *testing.T, seed string) Keypair {
+	t.Helper()
+
+	priv, pub, err := keypairs.DeriveNodeKeypair(seed)
+	require.NoError(t, err)
+
+	return Keypair{PrivateKey: priv, PublicKey: pub}
+}
+
+func TestCreate(t *testing.T) {
+	master := testKeypair(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r")
+	signing := testKeypair(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+
+	tests := []struct {
+		name     string
+		sequence uint32
+		domain   string
+		expected error
+	}{
+		{
+			name:     "pass - with domain",
+			sequence: 1,
+			domain:   "example.com",
+		},
+		{
+			name:     "pass - without domain",
+			sequence: 42,
+		},
+		{
+			name:     "fail - revoked sequence",
+			sequence: RevokedSequence,
+			expected: ErrRevokedSequence,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Create(master, signing, tt.sequence, tt.domain)
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				return
+			}
+			require.NoError(t, err)
+
+			encoded, err := m.Encode()
+			require.NoError(t, err)
+			decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.NoError(t, decoded.Verify())
+			require.Equal(t, master.PublicKey, decoded.MasterKey)
+			require.Equal(t, signing.PublicKey, decoded.SigningKey)
+			require.Equal(t, tt.sequence, decoded.Sequence)
+			require.Equal(t, tt.domain, decoded.Domain)
+		})
+	}
+
+	t.Run("fail - same master and signing keys", func(t *testing.T) {
+		_, err := Create(master, master, 1, "")
+		require.ErrorIs(t, err, ErrSameMasterAndSigningKey)
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	master := testKeypair(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+
+	m, err := Revoke(master)
+	require.NoError(t, err)
+	require.True(t, m.IsRevoked())
+	require.Empty(t, m.SigningKey)
+	require.Empty(t, m.Signature)
+	require.NoError(t, m.Verify())
+}
+
+func TestManifest_Sign(t *testing.T) {
+	master := testKeypair(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r")
+	signing := testKeypair(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+
+	m, err := Create(master, signing, 1, "")
+	require.NoError(t, err)
+
+	t.Run("pass - re-signing after an update", func(t *testing.T) {
+		updated := *m
+		updated.Domain = "example.com"
+		require.ErrorIs(t, updated.Verify(), ErrInvalidMasterSignature)
+		require.NoError(t, updated.Sign(master.PrivateKey, signing.PrivateKey))
+		require.NoError(t, updated.Verify())
+	})
+
+	t.Run("fail - signing key does not match", func(t *testing.T) {
+		other := testKeypair(t, "sp5fghtJtpUorTwvof1NpDXAzNwf5")
+		updated := *m
+		require.ErrorIs(t, updated.Sign(master.PrivateKey, other.PrivateKey), ErrInvalidSignature)
+	})
+}
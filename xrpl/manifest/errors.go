@@ -0,0 +1,40 @@
+package manifest
+
+import "errors"
+
+var (
+	// decoding
+
+	// ErrMissingMasterKey is returned when a manifest has no PublicKey field.
+	ErrMissingMasterKey = errors.New("manifest: missing master public key")
+	// ErrMissingSequence is returned when a manifest has no Sequence field.
+	ErrMissingSequence = errors.New("manifest: missing sequence")
+	// ErrMissingMasterSignature is returned when a manifest has no MasterSignature field.
+	ErrMissingMasterSignature = errors.New("manifest: missing master signature")
+	// ErrMissingSigningKey is returned when a manifest that is not a revocation has no SigningPubKey field.
+	ErrMissingSigningKey = errors.New("manifest: missing ephemeral signing key")
+	// ErrMissingSignature is returned when a manifest that is not a revocation has no Signature field.
+	ErrMissingSignature = errors.New("manifest: missing ephemeral signature")
+	// ErrSameMasterAndSigningKey is returned when the master and ephemeral keys of a manifest are the same.
+	ErrSameMasterAndSigningKey = errors.New("manifest: master and ephemeral keys must be different")
+	// ErrUnexpectedFieldType is returned when a manifest field does not have the expected type.
+	ErrUnexpectedFieldType = errors.New("manifest: unexpected field type")
+
+	// verification
+
+	// ErrInvalidMasterSignature is returned when the master signature of a manifest does not verify.
+	ErrInvalidMasterSignature = errors.New("manifest: invalid master signature")
+	// ErrInvalidSignature is returned when the ephemeral signature of a manifest does not verify.
+	ErrInvalidSignature = errors.New("manifest: invalid ephemeral signature")
+
+	// creation
+
+	// ErrRevokedSequence is returned when creating a manifest with the sequence reserved for revocations.
+	ErrRevokedSequence = errors.New("manifest: sequence is reserved for revocations")
+	// ErrKeysRevoked is returned when creating a token with validator keys that have been revoked.
+	ErrKeysRevoked = errors.New("manifest: validator keys have been revoked")
+	// ErrUnsupportedKeyType is returned when validator keys have an unknown key type.
+	ErrUnsupportedKeyType = errors.New("manifest: unsupported key type")
+	// ErrInvalidValidatorToken is returned when a validator token is missing its manifest or secret key.
+	ErrInvalidValidatorToken = errors.New("manifest: invalid validator token")
+)
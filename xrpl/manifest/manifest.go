@@ -0,0 +1,241 @@
+// Package manifest provides encoding, decoding and verification of validator manifests.
+//
+// A manifest binds the master key of a validator, which is kept offline, to the
+// ephemeral key the validator signs validations with. It is signed by both keys,
+// and a newer sequence replaces an older one. A manifest with the maximum sequence
+// revokes the master key.
+package manifest
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+)
+
+const (
+	// RevokedSequence is the sequence of a manifest that revokes its master key.
+	RevokedSequence uint32 = math.MaxUint32
+)
+
+// Manifest is a decoded validator manifest. Keys and signatures are hex encoded.
+type Manifest struct {
+	// MasterKey is the master public key of the validator.
+	MasterKey string
+	// SigningKey is the ephemeral public key of the validator. It may be empty for revocations.
+	SigningKey string
+	// Sequence is the sequence of the manifest. RevokedSequence revokes the master key.
+	Sequence uint32
+	// Domain is the domain claimed by the validator, if any.
+	Domain string
+	// MasterSignature is the signature of the manifest by the master key.
+	MasterSignature string
+	// Signature is the signature of the manifest by the ephemeral key. It may be empty for revocations.
+	Signature string
+}
+
+// Decode decodes a base64 encoded manifest, as returned by the manifest method and in validator lists.
+// It checks that the required fields are present, but does not verify the signatures. See Manifest.Verify.
+func Decode(manifest string) (*Manifest, error) {
+	b, err := base64.StdEncoding.DecodeString(manifest)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeBytes(b)
+}
+
+// DecodeBytes decodes a serialized manifest.
+// It checks that the required fields are present, but does not verify the signatures. See Manifest.Verify.
+func DecodeBytes(b []byte) (*Manifest, error) {
+	fields, err := binarycodec.Decode(hex.EncodeToString(b))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{}
+
+	stringFields := map[string]*string{
+		"PublicKey":       &m.MasterKey,
+		"SigningPubKey":   &m.SigningKey,
+		"MasterSignature": &m.MasterSignature,
+		"Signature":       &m.Signature,
+	}
+	for name, dst := range stringFields {
+		v, ok := fields[name]
+		if !ok {
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrUnexpectedFieldType
+		}
+		*dst = s
+	}
+
+	if v, ok := fields["Domain"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrUnexpectedFieldType
+		}
+		domain, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		m.Domain = string(domain)
+	}
+
+	v, ok := fields["Sequence"]
+	if !ok {
+		return nil, ErrMissingSequence
+	}
+	if m.Sequence, ok = v.(uint32); !ok {
+		return nil, ErrUnexpectedFieldType
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Encode encodes the manifest in base64, as returned by the manifest method and in validator lists.
+func (m *Manifest) Encode() (string, error) {
+	b, err := m.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Bytes returns the serialized manifest.
+func (m *Manifest) Bytes() ([]byte, error) {
+	encoded, err := binarycodec.Encode(m.toJSON(true))
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(encoded)
+}
+
+// SigningData returns the data signed by both the master and the ephemeral keys:
+// the manifest without its signatures, prefixed with the manifest hash prefix.
+func (m *Manifest) SigningData() ([]byte, error) {
+	encoded, err := binarycodec.EncodeForSigningManifest(m.toJSON(false))
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(encoded)
+}
+
+// IsRevoked reports whether the manifest revokes the master key.
+func (m *Manifest) IsRevoked() bool {
+	return m.Sequence == RevokedSequence
+}
+
+// Verify verifies the master signature of the manifest and, unless the manifest is a
+// revocation without an ephemeral key, its ephemeral signature.
+func (m *Manifest) Verify() error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	data, err := m.SigningData()
+	if err != nil {
+		return err
+	}
+
+	ok, err := keypairs.Validate(string(data), m.MasterKey, m.MasterSignature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidMasterSignature
+	}
+
+	if m.SigningKey == "" {
+		return nil
+	}
+
+	ok, err = keypairs.Validate(string(data), m.SigningKey, m.Signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// MasterNodePublicKey returns the master public key of the validator, encoded as a node public key.
+func (m *Manifest) MasterNodePublicKey() (string, error) {
+	return encodeNodePublicKey(m.MasterKey)
+}
+
+// SigningNodePublicKey returns the ephemeral public key of the validator, encoded as a node public key.
+func (m *Manifest) SigningNodePublicKey() (string, error) {
+	return encodeNodePublicKey(m.SigningKey)
+}
+
+// validate checks that the fields required by rippled are present.
+func (m *Manifest) validate() error {
+	if m.MasterKey == "" {
+		return ErrMissingMasterKey
+	}
+	if m.MasterSignature == "" {
+		return ErrMissingMasterSignature
+	}
+	if m.IsRevoked() {
+		if m.SigningKey != "" && m.Signature == "" {
+			return ErrMissingSignature
+		}
+		return nil
+	}
+	if m.SigningKey == "" {
+		return ErrMissingSigningKey
+	}
+	if m.Signature == "" {
+		return ErrMissingSignature
+	}
+	if strings.EqualFold(m.MasterKey, m.SigningKey) {
+		return ErrSameMasterAndSigningKey
+	}
+	return nil
+}
+
+// toJSON returns the manifest fields in the binary-codec JSON format.
+// Signatures are only included if withSignatures is true.
+func (m *Manifest) toJSON(withSignatures bool) map[string]any {
+	json := map[string]any{
+		"PublicKey": m.MasterKey,
+		"Sequence":  m.Sequence,
+	}
+	if m.SigningKey != "" {
+		json["SigningPubKey"] = m.SigningKey
+	}
+	if m.Domain != "" {
+		json["Domain"] = strings.ToUpper(hex.EncodeToString([]byte(m.Domain)))
+	}
+	if withSignatures {
+		if m.MasterSignature != "" {
+			json["MasterSignature"] = m.MasterSignature
+		}
+		if m.Signature != "" {
+			json["Signature"] = m.Signature
+		}
+	}
+	return json
+}
+
+// encodeNodePublicKey encodes a hex public key as a node public key.
+func encodeNodePublicKey(key string) (string, error) {
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	return addresscodec.EncodeNodePublicKey(b)
+}
@@ -0,0 +1,188 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// signedManifest returns a manifest signed by a test master key and, if it is not a revocation, a test ephemeral key.
+func signedManifest(t *testing.T, sequence uint32, domain string) *Manifest {
+	t.Helper()
+
+	masterSeed, err := keypairs.GenerateSeed("master-test-seed", crypto.ED25519(), nil)
+	require.NoError(t, err)
+	masterPriv, masterPub, err := keypairs.DeriveKeypair(masterSeed, false)
+	require.NoError(t, err)
+
+	m := &Manifest{
+		MasterKey: masterPub,
+		Sequence:  sequence,
+		Domain:    domain,
+	}
+
+	var signingPriv string
+	if sequence != RevokedSequence {
+		signingSeed, err := keypairs.GenerateSeed("signing-testseed", crypto.SECP256K1(), nil)
+		require.NoError(t, err)
+		signingPriv, m.SigningKey, err = keypairs.DeriveKeypair(signingSeed, false)
+		require.NoError(t, err)
+	}
+
+	data, err := m.SigningData()
+	require.NoError(t, err)
+
+	m.MasterSignature, err = keypairs.Sign(string(data), masterPriv)
+	require.NoError(t, err)
+	if signingPriv != "" {
+		m.Signature, err = keypairs.Sign(string(data), signingPriv)
+		require.NoError(t, err)
+	}
+
+	return m
+}
+
+func TestManifest_EncodeDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest *Manifest
+	}{
+		{
+			name:     "pass - with domain",
+			manifest: signedManifest(t, 3, "example.com"),
+		},
+		{
+			name:     "pass - without domain",
+			manifest: signedManifest(t, 1, ""),
+		},
+		{
+			name:     "pass - revocation",
+			manifest: signedManifest(t, RevokedSequence, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := tt.manifest.Encode()
+			require.NoError(t, err)
+
+			decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tt.manifest, decoded)
+			require.NoError(t, decoded.Verify())
+			require.Equal(t, tt.manifest.Sequence == RevokedSequence, decoded.IsRevoked())
+		})
+	}
+}
+
+func TestManifest_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(m *Manifest)
+		expected error
+	}{
+		{
+			name:     "pass",
+			malleate: func(*Manifest) {},
+		},
+		{
+			name:     "fail - tampered sequence",
+			malleate: func(m *Manifest) { m.Sequence++ },
+			expected: ErrInvalidMasterSignature,
+		},
+		{
+			name:     "fail - tampered domain",
+			malleate: func(m *Manifest) { m.Domain = "evil.com" },
+			expected: ErrInvalidMasterSignature,
+		},
+		{
+			name: "fail - ephemeral signature from another manifest",
+			malleate: func(m *Manifest) {
+				m.Signature = signedManifest(t, 2, "").Signature
+			},
+			expected: ErrInvalidSignature,
+		},
+		{
+			name:     "fail - missing ephemeral signature",
+			malleate: func(m *Manifest) { m.Signature = "" },
+			expected: ErrMissingSignature,
+		},
+		{
+			name:     "fail - same master and ephemeral keys",
+			malleate: func(m *Manifest) { m.SigningKey = m.MasterKey },
+			expected: ErrSameMasterAndSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := signedManifest(t, 1, "example.com")
+			tt.malleate(m)
+			require.ErrorIs(t, m.Verify(), tt.expected)
+		})
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	m := signedManifest(t, 1, "")
+
+	t.Run("fail - invalid base64", func(t *testing.T) {
+		_, err := Decode("not base64!")
+		require.Error(t, err)
+	})
+
+	t.Run("fail - missing master signature", func(t *testing.T) {
+		unsigned := *m
+		unsigned.MasterSignature = ""
+		b, err := unsigned.Bytes()
+		require.NoError(t, err)
+
+		_, err = DecodeBytes(b)
+		require.ErrorIs(t, err, ErrMissingMasterSignature)
+	})
+}
+
+func TestManifest_NodePublicKeys(t *testing.T) {
+	m := signedManifest(t, 1, "")
+
+	master, err := m.MasterNodePublicKey()
+	require.NoError(t, err)
+	require.Equal(t, byte('n'), master[0])
+
+	signing, err := m.SigningNodePublicKey()
+	require.NoError(t, err)
+	require.Equal(t, byte('n'), signing[0])
+	require.NotEqual(t, master, signing)
+}
+
+func TestDecode_Rippled(t *testing.T) {
+	// A manifest created by rippled, with an ED25519 master key and a SECP256K1 ephemeral key.
+	const rippled = "JAAAAAFxIe1FtwmimvGtH2iCcMJqC9gVFKilGfw1/vCxHXXLplc2GnMhAkE1agqXxBwDwDbID6OMSYuM0FDAlpAgNk8SKFn7MO2fdkcwRQIhAOngu9sAKqXYouJ+l2V0W+sAOkVB+ZRS6PShlJAfUsXfAiBsVJGesaadOJc/aAZokS1vymGmVrlHPKWX3Yywu6in8HASQKPugBD67kMaRFGvmpATHlGKJdvDFlWPYy5AqDedFv5TJa2w0i21eq3MYywLVJZnFOr7C0kw2AiTzSCjIzditQ8="
+	m, err := Decode(rippled)
+	require.NoError(t, err)
+
+	require.Equal(t, "ED45B709A29AF1AD1F688270C26A0BD81514A8A519FC35FEF0B11D75CBA657361A", m.MasterKey)
+	require.Equal(t, "0241356A0A97C41C03C036C80FA38C498B8CD050C0969020364F122859FB30ED9F", m.SigningKey)
+	require.Equal(t, uint32(1), m.Sequence)
+	require.Empty(t, m.Domain)
+	require.False(t, m.IsRevoked())
+	require.NoError(t, m.Verify())
+
+	master, err := m.MasterNodePublicKey()
+	require.NoError(t, err)
+	require.Equal(t, "nHBt9fsb4849WmZiCds4r5TXyBeQjqnH5kzPtqgMAQMgi39YZRPa", master)
+
+	signing, err := m.SigningNodePublicKey()
+	require.NoError(t, err)
+	require.Equal(t, "n9KsDYGKhABVc4wK5u3MnVhgPinyJimyKGpr9VJYuBaY8EnJXR2x", signing)
+
+	encoded, err := m.Encode()
+	require.NoError(t, err)
+	require.Equal(t, rippled, encoded)
+
+	// A tampered sequence invalidates both signatures.
+	m.Sequence = 2
+	require.Error(t, m.Verify())
+}
@@ -0,0 +1,224 @@
+package manifest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+const (
+	// KeyTypeEd25519 is the key type of ED25519 validator keys.
+	KeyTypeEd25519 = "ed25519"
+	// KeyTypeSecp256k1 is the key type of SECP256K1 validator keys.
+	KeyTypeSecp256k1 = "secp256k1"
+
+	// validatorTokenLineLength is the length of the lines of a validator token in the rippled config file.
+	validatorTokenLineLength = 72
+)
+
+// ValidatorKeys are the master keys of a validator, along with the state needed to issue tokens.
+// Its JSON encoding is the keys file format of the validator-keys tool, so existing key files can be used.
+// It must be stored securely, since it contains the master secret key.
+type ValidatorKeys struct {
+	// KeyType is the type of the master key, KeyTypeEd25519 or KeyTypeSecp256k1.
+	KeyType string `json:"key_type"`
+	// PublicKey is the master public key, encoded as a node public key.
+	PublicKey string `json:"public_key"`
+	// SecretKey is the master private key, encoded as a node private key.
+	SecretKey string `json:"secret_key"`
+	// TokenSequence is the sequence of the last manifest issued.
+	TokenSequence uint32 `json:"token_sequence"`
+	// Revoked reports whether the master key has been revoked.
+	Revoked bool `json:"revoked"`
+	// Domain is the domain claimed by the validator in the manifests, if any.
+	Domain string `json:"domain,omitempty"`
+	// Manifest is the last manifest issued, base64 encoded.
+	Manifest string `json:"manifest,omitempty"`
+}
+
+// NewValidatorKeys generates new validator master keys of the given algorithm, using the randomizer.
+func NewValidatorKeys(alg interfaces.KeypairCryptoAlg, r interfaces.Randomizer) (*ValidatorKeys, error) {
+	seed, err := keypairs.GenerateSeed("", alg, r)
+	if err != nil {
+		return nil, err
+	}
+	return ValidatorKeysFromSeed(seed)
+}
+
+// ValidatorKeysFromSeed derives validator master keys from a seed.
+// The seed has to be encoded using the addresscodec package. Otherwise, it returns an error.
+func ValidatorKeysFromSeed(seed string) (*ValidatorKeys, error) {
+	priv, pub, err := keypairs.DeriveNodeKeypair(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	keyType := KeyTypeSecp256k1
+	if strings.HasPrefix(priv, "ED") {
+		keyType = KeyTypeEd25519
+	}
+
+	publicKey, err := keypairs.EncodeNodePublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := keypairs.EncodeNodePrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidatorKeys{
+		KeyType:   keyType,
+		PublicKey: publicKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// MasterKeypair returns the hex encoded master key pair.
+func (k *ValidatorKeys) MasterKeypair() (Keypair, error) {
+	var alg crypto.Algorithm
+	switch k.KeyType {
+	case KeyTypeEd25519:
+		alg = crypto.ED25519()
+	case KeyTypeSecp256k1:
+		alg = crypto.SECP256K1()
+	default:
+		return Keypair{}, ErrUnsupportedKeyType
+	}
+
+	priv, err := keypairs.DecodeNodePrivateKey(k.SecretKey, alg)
+	if err != nil {
+		return Keypair{}, err
+	}
+	pub, err := keypairs.DecodeNodePublicKey(k.PublicKey)
+	if err != nil {
+		return Keypair{}, err
+	}
+
+	return Keypair{PrivateKey: priv, PublicKey: pub}, nil
+}
+
+// CreateToken generates a new SECP256K1 ephemeral key with the randomizer, and issues a manifest
+// delegating to it with the next token sequence. The new manifest replaces the previous one, so
+// CreateToken also rotates the ephemeral key when it is lost or compromised.
+// The keys are updated with the new token sequence and manifest, and must be saved afterwards.
+func (k *ValidatorKeys) CreateToken(r interfaces.Randomizer) (*ValidatorToken, error) {
+	if k.Revoked {
+		return nil, ErrKeysRevoked
+	}
+
+	master, err := k.MasterKeypair()
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := keypairs.GenerateSeed("", crypto.SECP256K1(), r)
+	if err != nil {
+		return nil, err
+	}
+	priv, pub, err := keypairs.DeriveNodeKeypair(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := Create(master, Keypair{PrivateKey: priv, PublicKey: pub}, k.TokenSequence+1, k.Domain)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := m.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	k.TokenSequence = m.Sequence
+	k.Manifest = encoded
+
+	return &ValidatorToken{
+		Manifest:            encoded,
+		ValidationSecretKey: priv[2:],
+	}, nil
+}
+
+// Revoke issues a manifest that revokes the master key. The keys are marked as revoked,
+// so no more tokens can be created, and must be saved afterwards.
+func (k *ValidatorKeys) Revoke() (*Manifest, error) {
+	master, err := k.MasterKeypair()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := Revoke(master)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := m.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	k.Revoked = true
+	k.TokenSequence = m.Sequence
+	k.Manifest = encoded
+
+	return m, nil
+}
+
+// ValidatorToken is the token rippled uses to sign validations, set in the [validator_token] section of its config.
+type ValidatorToken struct {
+	// Manifest is the manifest delegating to the ephemeral key, base64 encoded.
+	Manifest string `json:"manifest"`
+	// ValidationSecretKey is the hex encoded ephemeral private key, without its algorithm prefix.
+	ValidationSecretKey string `json:"validation_secret_key"`
+}
+
+// DecodeValidatorToken decodes a validator token. Whitespace is ignored, so the token can be
+// copied from the [validator_token] section of the rippled config file.
+func DecodeValidatorToken(token string) (*ValidatorToken, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(token), ""))
+	if err != nil {
+		return nil, err
+	}
+
+	var t ValidatorToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, err
+	}
+	if t.Manifest == "" || t.ValidationSecretKey == "" {
+		return nil, ErrInvalidValidatorToken
+	}
+
+	return &t, nil
+}
+
+// Encode encodes the token in base64.
+func (t *ValidatorToken) Encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Config returns the [validator_token] section of the rippled config file for the token.
+func (t *ValidatorToken) Config() (string, error) {
+	encoded, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[validator_token]\n")
+	for len(encoded) > validatorTokenLineLength {
+		sb.WriteString(encoded[:validatorTokenLineLength])
+		sb.WriteByte('\n')
+		encoded = encoded[validatorTokenLineLength:]
+	}
+	sb.WriteString(encoded)
+	sb.WriteByte('\n')
+
+	return sb.String(), nil
+}
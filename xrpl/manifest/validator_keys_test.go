@@ -0,0 +1,115 @@
+package manifest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorKeysFromSeed(t *testing.T) {
+	keys, err := ValidatorKeysFromSeed("snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	require.NoError(t, err)
+	require.Equal(t, &ValidatorKeys{
+		KeyType:   KeyTypeSecp256k1,
+		PublicKey: "n94a1u4jAz288pZLtw6yFWVbi89YamiC6JBXPVUj5zmExe5fTVg9",
+		SecretKey: "pnen77YEeUd4fFKG7iycBWcwKpTaeFRkW2WFostaATy1DSupwXe",
+	}, keys)
+
+	master, err := keys.MasterKeypair()
+	require.NoError(t, err)
+	require.Equal(t, testKeypair(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"), master)
+}
+
+func TestValidatorKeys_JSON(t *testing.T) {
+	keys := &ValidatorKeys{}
+	err := json.Unmarshal([]byte(`{
+		"key_type": "ed25519",
+		"public_key": "nHB4JiHScG1RWKhfzXTFahpv1gcTn3BkZLRqhVGj4rNRoN9MQ43P",
+		"revoked": false,
+		"secret_key": "pa2S92RK49ef21CHDmLk7PTqXMW9NLqbxBddGcHftrYn4HDCfdC",
+		"token_sequence": 3
+	}`), keys)
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEd25519, keys.KeyType)
+	require.Equal(t, uint32(3), keys.TokenSequence)
+
+	master, err := keys.MasterKeypair()
+	require.NoError(t, err)
+	require.Equal(t, testKeypair(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"), master)
+
+	t.Run("fail - unsupported key type", func(t *testing.T) {
+		unsupported := *keys
+		unsupported.KeyType = "rsa"
+		_, err := unsupported.MasterKeypair()
+		require.ErrorIs(t, err, ErrUnsupportedKeyType)
+	})
+}
+
+func TestValidatorKeys_CreateToken(t *testing.T) {
+	keys, err := NewValidatorKeys(crypto.ED25519(), random.NewRandomizer())
+	require.NoError(t, err)
+	require.Equal(t, KeyTypeEd25519, keys.KeyType)
+
+	first, err := keys.CreateToken(random.NewRandomizer())
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), keys.TokenSequence)
+	require.Equal(t, first.Manifest, keys.Manifest)
+
+	second, err := keys.CreateToken(random.NewRandomizer())
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), keys.TokenSequence)
+	require.NotEqual(t, first.ValidationSecretKey, second.ValidationSecretKey)
+
+	m, err := Decode(second.Manifest)
+	require.NoError(t, err)
+	require.NoError(t, m.Verify())
+	require.Equal(t, uint32(2), m.Sequence)
+
+	masterKey, err := m.MasterNodePublicKey()
+	require.NoError(t, err)
+	require.Equal(t, keys.PublicKey, masterKey)
+
+	master, err := keys.MasterKeypair()
+	require.NoError(t, err)
+	require.Equal(t, master.PublicKey, m.MasterKey)
+
+	t.Run("pass - token round trip", func(t *testing.T) {
+		config, err := second.Config()
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(config, "[validator_token]\n"))
+		for _, line := range strings.Split(strings.TrimSpace(config), "\n")[1:] {
+			require.LessOrEqual(t, len(line), validatorTokenLineLength)
+		}
+
+		decoded, err := DecodeValidatorToken(strings.TrimPrefix(config, "[validator_token]"))
+		require.NoError(t, err)
+		require.Equal(t, second, decoded)
+	})
+}
+
+func TestValidatorKeys_Revoke(t *testing.T) {
+	keys, err := ValidatorKeysFromSeed("sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r")
+	require.NoError(t, err)
+
+	m, err := keys.Revoke()
+	require.NoError(t, err)
+	require.True(t, m.IsRevoked())
+	require.NoError(t, m.Verify())
+	require.True(t, keys.Revoked)
+	require.Equal(t, RevokedSequence, keys.TokenSequence)
+
+	_, err = keys.CreateToken(random.NewRandomizer())
+	require.ErrorIs(t, err, ErrKeysRevoked)
+}
+
+func TestDecodeValidatorToken_Errors(t *testing.T) {
+	_, err := DecodeValidatorToken("not base64!")
+	require.Error(t, err)
+
+	_, err = DecodeValidatorToken("e30=")
+	require.ErrorIs(t, err, ErrInvalidValidatorToken)
+}
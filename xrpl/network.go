@@ -0,0 +1,117 @@
+package xrpl
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// RestrictedNetworks is the threshold above which sidechains are expected to have network IDs.
+	// Networks with ID above this restricted number are expected to specify an accurate NetworkID field
+	// in every transaction to that chain to prevent replay attacks.
+	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
+	RestrictedNetworks = 1024
+	// RequiredNetworkIDVersion is the minimum rippled version that requires NetworkID validation.
+	RequiredNetworkIDVersion = "1.11.0"
+)
+
+// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
+// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
+//
+//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
+func isNotLaterRippledVersion(source, target string) bool {
+	if source == target {
+		return true
+	}
+
+	sourceDecomp := strings.Split(source, ".")
+	targetDecomp := strings.Split(target, ".")
+
+	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
+		return false
+	}
+
+	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
+	if err != nil {
+		return false
+	}
+	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
+	if err != nil {
+		return false
+	}
+	targetMajor, err := strconv.Atoi(targetDecomp[0])
+	if err != nil {
+		return false
+	}
+	targetMinor, err := strconv.Atoi(targetDecomp[1])
+	if err != nil {
+		return false
+	}
+
+	// Compare major version
+	if sourceMajor != targetMajor {
+		return sourceMajor < targetMajor
+	}
+
+	// Compare minor version
+	if sourceMinor != targetMinor {
+		return sourceMinor < targetMinor
+	}
+
+	sourcePatch := strings.Split(sourceDecomp[2], "-")
+	targetPatch := strings.Split(targetDecomp[2], "-")
+
+	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
+	if err != nil {
+		return false
+	}
+	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
+	if err != nil {
+		return false
+	}
+
+	// Compare patch version
+	if sourcePatchVersion != targetPatchVersion {
+		return sourcePatchVersion < targetPatchVersion
+	}
+
+	// Compare release version
+	if len(sourcePatch) != len(targetPatch) {
+		return len(sourcePatch) > len(targetPatch)
+	}
+
+	if len(sourcePatch) == 2 {
+		// Compare different release types
+		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
+			return sourcePatch[1] < targetPatch[1]
+		}
+
+		// Compare beta version
+		if strings.HasPrefix(sourcePatch[1], "b") {
+			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
+			if err != nil {
+				return false
+			}
+			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
+			if err != nil {
+				return false
+			}
+			return sourceBeta < targetBeta
+		}
+
+		// Compare rc version
+		if strings.HasPrefix(sourcePatch[1], "rc") {
+			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
+			if err != nil {
+				return false
+			}
+			targetRC, err := strconv.Atoi(targetPatch[1][2:])
+			if err != nil {
+				return false
+			}
+			return sourceRC < targetRC
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package xrpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNotLaterRippledVersion(t *testing.T) {
+	tests := []struct {
+		source   string
+		target   string
+		expected bool
+	}{
+		{source: "1.11.0", target: "1.11.0", expected: true},
+		{source: "1.10.0", target: "1.11.0", expected: true},
+		{source: "1.11.0", target: "1.10.0", expected: false},
+		{source: "1.11.0", target: "2.0.0", expected: true},
+		{source: "1.11.0", target: "1.11.1", expected: true},
+		{source: "1.10.0", target: "1.10.0-b1", expected: false},
+		{source: "1.10.0-b1", target: "1.10.0-b2", expected: true},
+		{source: "1.10.0-rc2", target: "1.10.0-rc1", expected: false},
+		{source: "1.10", target: "1.10.0", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source+"_"+tt.target, func(t *testing.T) {
+			require.Equal(t, tt.expected, isNotLaterRippledVersion(tt.source, tt.target))
+		})
+	}
+}
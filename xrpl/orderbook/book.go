@@ -0,0 +1,371 @@
+// Package orderbook provides order book math: offer qualities, funded amounts with transfer fees, local books
+// of offers kept from book_offers responses and transaction metadata, and the simulation of crossing an
+// OfferCreate against a book.
+package orderbook
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Book is a local order book: the offers giving TakerGets for TakerPays, sorted from the best quality for the
+// taker to the worst, and offers of the same quality in the order they were added, as in the ledger.
+//
+// The book also keeps the funds of the offer owners in TakerGets, to compute the funded part of their offers.
+// Owners with unknown funds are considered to fully fund their offers.
+//
+// A Book is not safe for concurrent use.
+type Book struct {
+	// TakerGetsTransferRate is the TransferRate of the issuer of TakerGets, in billionths, charged to offer
+	// owners when they pay a taker. 0 or QualityOne charges no fee.
+	TakerGetsTransferRate uint32
+	// TakerPaysTransferRate is the TransferRate of the issuer of TakerPays, in billionths, charged to takers
+	// when they pay an offer owner. 0 or QualityOne charges no fee.
+	TakerPaysTransferRate uint32
+	// Taker is the account taking the offers, which pays no transfer fee when it is the issuer of TakerGets.
+	Taker types.Address
+
+	takerGets  currency.Asset
+	takerPays  currency.Asset
+	offers     []Offer
+	ownerFunds map[types.Address]currency.Amount
+}
+
+// NewBook returns an empty book of the offers giving takerGets for takerPays.
+func NewBook(takerGets, takerPays currency.Asset) *Book {
+	return &Book{
+		takerGets:  takerGets,
+		takerPays:  takerPays,
+		ownerFunds: make(map[types.Address]currency.Amount),
+	}
+}
+
+// TakerGets returns the asset the offers of the book give.
+func (b *Book) TakerGets() currency.Asset {
+	return b.takerGets
+}
+
+// TakerPays returns the asset the offers of the book want.
+func (b *Book) TakerPays() currency.Asset {
+	return b.takerPays
+}
+
+// Len returns the number of offers of the book.
+func (b *Book) Len() int {
+	return len(b.offers)
+}
+
+// Load replaces the offers of the book with the offers of a book_offers response, in the order of the
+// response, and the funds of their owners with the owner_funds of the response.
+// Offers which are not of the book are ignored.
+func (b *Book) Load(offers []pathtypes.BookOffer) error {
+	b.offers = b.offers[:0]
+	b.ownerFunds = make(map[types.Address]currency.Amount)
+
+	for _, bo := range offers {
+		o, err := NewOfferFromBookOffer(bo)
+		if err != nil {
+			return err
+		}
+		if !b.contains(o) {
+			continue
+		}
+		if bo.OwnerFunds != "" {
+			funds, err := b.parseFunds(bo.OwnerFunds)
+			if err != nil {
+				return err
+			}
+			b.ownerFunds[o.Account] = funds
+		}
+		b.insert(o)
+	}
+	return nil
+}
+
+// Insert adds an offer to the book, or replaces the offer of the same index.
+// It returns ErrOfferNotInBook if the offer does not give TakerGets for TakerPays.
+func (b *Book) Insert(o Offer) error {
+	if !b.contains(o) {
+		return ErrOfferNotInBook
+	}
+	b.insert(o)
+	return nil
+}
+
+// Remove removes the offer of the given index from the book, and reports whether it was found.
+func (b *Book) Remove(index types.Hash256) bool {
+	i := b.indexOf(index)
+	if i < 0 {
+		return false
+	}
+	b.offers = append(b.offers[:i], b.offers[i+1:]...)
+	return true
+}
+
+// Get returns the offer of the given index.
+func (b *Book) Get(index types.Hash256) (Offer, bool) {
+	i := b.indexOf(index)
+	if i < 0 {
+		return Offer{}, false
+	}
+	return b.offers[i], true
+}
+
+// SetOwnerFunds sets the funds of an offer owner in TakerGets, such as its balance from account_lines or
+// account_info, less its reserve for XRP.
+func (b *Book) SetOwnerFunds(owner types.Address, funds currency.Amount) {
+	b.ownerFunds[owner] = funds
+}
+
+// OwnerFunds returns the funds of an offer owner in TakerGets, if known.
+func (b *Book) OwnerFunds(owner types.Address) (currency.Amount, bool) {
+	funds, ok := b.ownerFunds[owner]
+	return funds, ok
+}
+
+// ApplyMetadata applies the changes of a transaction to the book: created, modified and deleted Offer entries
+// of the book, and the balance changes of owners with known funds. Trust line balances replace the funds of
+// their holder, while XRP funds are changed by the balance change of the AccountRoot, ignoring reserve changes.
+// It reports whether the offers of the book changed.
+func (b *Book) ApplyMetadata(meta transaction.TxObjMeta) (bool, error) {
+	changed := false
+	for _, node := range meta.AffectedNodes {
+		switch {
+		case node.CreatedNode != nil:
+			n := node.CreatedNode
+			c, err := b.applyNode(n.LedgerEntryType, n.LedgerIndex, n.NewFields, nil, false)
+			if err != nil {
+				return changed, err
+			}
+			changed = changed || c
+		case node.ModifiedNode != nil:
+			n := node.ModifiedNode
+			c, err := b.applyNode(n.LedgerEntryType, n.LedgerIndex, n.FinalFields, n.PreviousFields, false)
+			if err != nil {
+				return changed, err
+			}
+			changed = changed || c
+		case node.DeletedNode != nil:
+			n := node.DeletedNode
+			c, err := b.applyNode(n.LedgerEntryType, n.LedgerIndex, n.FinalFields, nil, true)
+			if err != nil {
+				return changed, err
+			}
+			changed = changed || c
+		}
+	}
+	return changed, nil
+}
+
+// applyNode applies the change of a ledger entry, and reports whether the offers of the book changed.
+func (b *Book) applyNode(entryType ledger.EntryType, index string, fields, previous ledger.FlatLedgerObject, deleted bool) (bool, error) {
+	switch entryType {
+	case ledger.OfferEntry:
+		if deleted {
+			return b.Remove(types.Hash256(index)), nil
+		}
+		o, err := NewOfferFromFields(index, fields)
+		if err != nil {
+			return false, err
+		}
+		if !b.contains(o) {
+			return false, nil
+		}
+		b.insert(o)
+		return true, nil
+	case ledger.RippleStateEntry:
+		return false, b.applyTrustLine(fields, deleted)
+	case ledger.AccountRootEntry:
+		return false, b.applyAccountRoot(fields, previous)
+	default:
+		return false, nil
+	}
+}
+
+// applyTrustLine updates the funds of the holder of a trust line of TakerGets, if known.
+func (b *Book) applyTrustLine(fields ledger.FlatLedgerObject, deleted bool) error {
+	balance, _ := fields["Balance"].(map[string]any)
+	low, _ := fields["LowLimit"].(map[string]any)
+	high, _ := fields["HighLimit"].(map[string]any)
+	if b.takerGets.Kind != types.ISSUED || balance == nil || low == nil || high == nil ||
+		balance["currency"] != b.takerGets.Currency {
+		return nil
+	}
+
+	var holder string
+	negate := false
+	switch string(b.takerGets.Issuer) {
+	case high["issuer"]:
+		holder, _ = low["issuer"].(string)
+	case low["issuer"]:
+		holder, _ = high["issuer"].(string)
+		negate = true
+	default:
+		return nil
+	}
+	if _, ok := b.ownerFunds[types.Address(holder)]; !ok {
+		return nil
+	}
+	if deleted {
+		b.ownerFunds[types.Address(holder)] = currency.ZeroAmount(b.takerGets)
+		return nil
+	}
+
+	value, _ := balance["value"].(string)
+	funds, err := currency.NewIssuedAmount(b.takerGets.Currency, b.takerGets.Issuer, value)
+	if err != nil {
+		return err
+	}
+	if negate {
+		funds = funds.Neg()
+	}
+	b.ownerFunds[types.Address(holder)] = funds
+	return nil
+}
+
+// applyAccountRoot changes the XRP funds of an account, if known, by its balance change.
+func (b *Book) applyAccountRoot(fields, previous ledger.FlatLedgerObject) error {
+	account, _ := fields["Account"].(string)
+	funds, ok := b.ownerFunds[types.Address(account)]
+	if b.takerGets.Kind != types.XRP || !ok || previous["Balance"] == nil {
+		return nil
+	}
+
+	final, err := parseAmount(fields["Balance"])
+	if err != nil {
+		return err
+	}
+	prev, err := parseAmount(previous["Balance"])
+	if err != nil {
+		return err
+	}
+	delta, err := final.Sub(prev)
+	if err != nil {
+		return err
+	}
+	if funds, err = funds.Add(delta); err != nil {
+		return err
+	}
+	b.ownerFunds[types.Address(account)] = funds
+	return nil
+}
+
+// Offers returns the offers of the book, best quality first, with TakerGetsFunded and TakerPaysFunded set to
+// the part of TakerGets and TakerPays their owner can pay for, as rippled's book_offers method computes them.
+// The funds of an owner are spent by its offers in book order, transfer fees included.
+func (b *Book) Offers() ([]Offer, error) {
+	offers := make([]Offer, len(b.offers))
+	copy(offers, b.offers)
+
+	balances := make(map[types.Address]currency.Amount, len(b.ownerFunds))
+	for owner, funds := range b.ownerFunds {
+		balances[owner] = funds
+	}
+	for i := range offers {
+		if err := b.fund(&offers[i], balances); err != nil {
+			return nil, err
+		}
+	}
+	return offers, nil
+}
+
+// fund sets the funded amounts of an offer, and spends them from the balance of its owner.
+func (b *Book) fund(o *Offer, balances map[types.Address]currency.Amount) error {
+	o.TakerGetsFunded, o.TakerPaysFunded = o.TakerGets, o.TakerPays
+
+	funds, ok := balances[o.Account]
+	if !ok || b.isIssuer(o.Account) {
+		return nil
+	}
+	if funds.IsNegative() {
+		funds = currency.ZeroAmount(b.takerGets)
+	}
+
+	rate := effectiveRate(b.TakerGetsTransferRate, b.takerGets, o.Account, b.Taker)
+	limit, err := divideRate(funds, rate)
+	if err != nil {
+		return err
+	}
+	if less(limit, o.TakerGets) {
+		o.TakerGetsFunded = limit
+		pays, err := currency.RoundToNearest.Multiply(limit, o.Quality.Rate(), b.takerPays)
+		if err != nil {
+			return err
+		}
+		if less(pays, o.TakerPays) {
+			o.TakerPaysFunded = pays
+		}
+	}
+
+	paid, err := multiplyRate(o.TakerGetsFunded, rate)
+	if err != nil {
+		return err
+	}
+	if less(funds, paid) {
+		paid = funds
+	}
+	balances[o.Account], err = funds.Sub(paid)
+	return err
+}
+
+// contains reports whether the offer gives TakerGets for TakerPays.
+func (b *Book) contains(o Offer) bool {
+	return o.TakerGets.Asset() == b.takerGets && o.TakerPays.Asset() == b.takerPays
+}
+
+// isIssuer reports whether the account issues TakerGets, and so fully funds its offers.
+func (b *Book) isIssuer(account types.Address) bool {
+	return b.takerGets.Kind == types.ISSUED && b.takerGets.Issuer == account
+}
+
+// insert adds or replaces an offer, after the offers of the same quality.
+func (b *Book) insert(o Offer) {
+	if i := b.indexOf(o.Index); i >= 0 {
+		if b.offers[i].Quality == o.Quality {
+			b.offers[i] = o
+			return
+		}
+		b.offers = append(b.offers[:i], b.offers[i+1:]...)
+	}
+	i := sort.Search(len(b.offers), func(i int) bool {
+		return b.offers[i].Quality > o.Quality
+	})
+	b.offers = append(b.offers, Offer{})
+	copy(b.offers[i+1:], b.offers[i:])
+	b.offers[i] = o
+}
+
+// indexOf returns the position of the offer of the given index, or -1.
+func (b *Book) indexOf(index types.Hash256) int {
+	if index == "" {
+		return -1
+	}
+	for i := range b.offers {
+		if b.offers[i].Index == index {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseFunds parses the owner_funds of a book_offers response: drops for XRP and MPT values, and a decimal
+// value for issued currencies.
+func (b *Book) parseFunds(funds string) (currency.Amount, error) {
+	if b.takerGets.Kind == types.ISSUED {
+		return currency.NewIssuedAmount(b.takerGets.Currency, b.takerGets.Issuer, funds)
+	}
+	v, err := strconv.ParseInt(funds, 10, 64)
+	if err != nil {
+		return currency.Amount{}, ErrInvalidAmount
+	}
+	if b.takerGets.Kind == types.MPT {
+		return currency.NewMPTAmount(b.takerGets.MPTIssuanceID, v)
+	}
+	return currency.NewXRPAmount(v)
+}
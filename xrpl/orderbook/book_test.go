@@ -0,0 +1,346 @@
+package orderbook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAlice types.Address = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	testBob   types.Address = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	testCarol types.Address = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+
+	testDirectoryPrefix = "DFA3B6DDAB58C7E8E5D944E736DA4B7046C30E4F460FD9DE"
+)
+
+func testIndex(n int) types.Hash256 {
+	return types.Hash256(fmt.Sprintf("%064X", n))
+}
+
+func usdObject(value string) map[string]any {
+	return map[string]any{"currency": "USD", "issuer": string(testIssuer), "value": value}
+}
+
+// testBookOffer returns a book_offers entry of account giving gets USD for pays drops.
+func testBookOffer(t *testing.T, n int, account types.Address, gets string, pays int64, ownerFunds string) pathtypes.BookOffer {
+	t.Helper()
+	q, err := NewQuality(Amounts{In: drops(t, pays), Out: usd(t, gets)})
+	require.NoError(t, err)
+	return pathtypes.BookOffer{
+		Index:         testIndex(n),
+		Account:       account,
+		Sequence:      uint32(n),
+		BookDirectory: types.Hash256(testDirectoryPrefix + q.Hex()),
+		TakerGets:     usdObject(gets),
+		TakerPays:     fmt.Sprint(pays),
+		OwnerFunds:    ownerFunds,
+	}
+}
+
+// testBook returns a book of offers giving USD for XRP, of alice, bob and carol at 2, 2.5 and 3 XRP per USD.
+func testBook(t *testing.T) *Book {
+	t.Helper()
+	b := NewBook(testUSD, currency.XRPAsset())
+	require.NoError(t, b.Load([]pathtypes.BookOffer{
+		testBookOffer(t, 1, testAlice, "10", 20_000_000, ""),
+		testBookOffer(t, 2, testBob, "10", 25_000_000, ""),
+		testBookOffer(t, 3, testCarol, "10", 30_000_000, ""),
+	}))
+	return b
+}
+
+func offerIndexes(offers []Offer) []types.Hash256 {
+	indexes := make([]types.Hash256, len(offers))
+	for i, o := range offers {
+		indexes[i] = o.Index
+	}
+	return indexes
+}
+
+func TestBook_Load(t *testing.T) {
+	b := NewBook(testUSD, currency.XRPAsset())
+	other := testBookOffer(t, 4, testBob, "10", 1_000_000, "")
+	other.TakerGets = "1000000"
+
+	err := b.Load([]pathtypes.BookOffer{
+		testBookOffer(t, 2, testBob, "10", 25_000_000, ""),
+		testBookOffer(t, 1, testAlice, "10", 20_000_000, "5"),
+		testBookOffer(t, 3, testCarol, "10", 30_000_000, "100"),
+		other,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, b.Len())
+
+	offers, err := b.Offers()
+	require.NoError(t, err)
+	require.Equal(t, []types.Hash256{testIndex(1), testIndex(2), testIndex(3)}, offerIndexes(offers))
+	require.Equal(t, Quality(0x5B071AFD498D0000), offers[0].Quality)
+	require.Equal(t, uint32(1), offers[0].Sequence)
+
+	funds, ok := b.OwnerFunds(testAlice)
+	require.True(t, ok)
+	require.Equal(t, "5", funds.String())
+	_, ok = b.OwnerFunds(testBob)
+	require.False(t, ok)
+
+	err = b.Load([]pathtypes.BookOffer{{Account: testAlice, TakerGets: 1, TakerPays: "1"}})
+	require.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestBook_Offers(t *testing.T) {
+	tt := []struct {
+		description string
+		rate        uint32
+		funds       string
+		gets        []string
+		pays        []string
+	}{
+		{
+			description: "pass - fully funded",
+			funds:       "30",
+			gets:        []string{"10", "10", "10", "10"},
+			pays:        []string{"20000000", "20000000", "25000000", "30000000"},
+		},
+		{
+			description: "pass - funds spent in book order",
+			funds:       "15",
+			gets:        []string{"10", "5", "10", "10"},
+			pays:        []string{"20000000", "10000000", "25000000", "30000000"},
+		},
+		{
+			description: "pass - funds spent with transfer fees",
+			rate:        1_002_000_000,
+			funds:       "5",
+			gets:        []string{"4.99001996007984", "0", "10", "10"},
+			pays:        []string{"9980040", "0", "25000000", "30000000"},
+		},
+		{
+			description: "pass - negative funds",
+			funds:       "-1",
+			gets:        []string{"0", "0", "10", "10"},
+			pays:        []string{"0", "0", "25000000", "30000000"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			b := testBook(t)
+			b.TakerGetsTransferRate = tc.rate
+			require.NoError(t, b.Insert(mustOffer(t, testBookOffer(t, 4, testAlice, "10", 20_000_000, ""))))
+			b.SetOwnerFunds(testAlice, usd(t, tc.funds))
+
+			offers, err := b.Offers()
+			require.NoError(t, err)
+			require.Equal(t, []types.Hash256{testIndex(1), testIndex(4), testIndex(2), testIndex(3)}, offerIndexes(offers))
+			for i, o := range offers {
+				require.Equal(t, tc.gets[i], o.TakerGetsFunded.String())
+				require.Equal(t, tc.pays[i], o.TakerPaysFunded.String())
+			}
+		})
+	}
+}
+
+func mustOffer(t *testing.T, bo pathtypes.BookOffer) Offer {
+	t.Helper()
+	o, err := NewOfferFromBookOffer(bo)
+	require.NoError(t, err)
+	return o
+}
+
+func TestBook_InsertRemove(t *testing.T) {
+	b := testBook(t)
+
+	other := mustOffer(t, testBookOffer(t, 4, testBob, "10", 1_000_000, ""))
+	other.TakerPays = usd(t, "1")
+	require.ErrorIs(t, b.Insert(other), ErrOfferNotInBook)
+
+	better := mustOffer(t, testBookOffer(t, 3, testCarol, "10", 10_000_000, ""))
+	require.NoError(t, b.Insert(better))
+	require.Equal(t, 3, b.Len())
+	o, ok := b.Get(testIndex(3))
+	require.True(t, ok)
+	require.Equal(t, "10000000", o.TakerPays.String())
+
+	offers, err := b.Offers()
+	require.NoError(t, err)
+	require.Equal(t, []types.Hash256{testIndex(3), testIndex(1), testIndex(2)}, offerIndexes(offers))
+
+	require.True(t, b.Remove(testIndex(1)))
+	require.False(t, b.Remove(testIndex(1)))
+	_, ok = b.Get(testIndex(1))
+	require.False(t, ok)
+	require.Equal(t, 2, b.Len())
+}
+
+func TestBook_ApplyMetadata(t *testing.T) {
+	offerFields := func(account types.Address, sequence int, gets string, pays string) ledger.FlatLedgerObject {
+		return ledger.FlatLedgerObject{
+			"Account":   string(account),
+			"Sequence":  float64(sequence),
+			"Flags":     float64(0),
+			"TakerGets": usdObject(gets),
+			"TakerPays": pays,
+		}
+	}
+
+	tt := []struct {
+		description string
+		meta        transaction.TxObjMeta
+		changed     bool
+		indexes     []types.Hash256
+		check       func(t *testing.T, b *Book)
+		expectedErr error
+	}{
+		{
+			description: "pass - created offer",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+				CreatedNode: &transaction.CreatedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					LedgerIndex:     string(testIndex(5)),
+					NewFields:       offerFields(testBob, 5, "10", "22000000"),
+				},
+			}}},
+			changed: true,
+			indexes: []types.Hash256{testIndex(1), testIndex(5), testIndex(2), testIndex(3)},
+		},
+		{
+			description: "pass - modified offer",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+				ModifiedNode: &transaction.ModifiedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					LedgerIndex:     string(testIndex(1)),
+					FinalFields:     offerFields(testAlice, 1, "4", "8000000"),
+					PreviousFields:  ledger.FlatLedgerObject{"TakerGets": usdObject("10"), "TakerPays": "20000000"},
+				},
+			}}},
+			changed: true,
+			indexes: []types.Hash256{testIndex(1), testIndex(2), testIndex(3)},
+			check: func(t *testing.T, b *Book) {
+				o, ok := b.Get(testIndex(1))
+				require.True(t, ok)
+				require.Equal(t, "4", o.TakerGets.String())
+				require.Equal(t, "8000000", o.TakerPays.String())
+			},
+		},
+		{
+			description: "pass - deleted offer",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+				DeletedNode: &transaction.DeletedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					LedgerIndex:     string(testIndex(2)),
+					FinalFields:     offerFields(testBob, 2, "10", "25000000"),
+				},
+			}}},
+			changed: true,
+			indexes: []types.Hash256{testIndex(1), testIndex(3)},
+		},
+		{
+			description: "pass - offer of another book",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+				CreatedNode: &transaction.CreatedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					LedgerIndex:     string(testIndex(6)),
+					NewFields: ledger.FlatLedgerObject{
+						"Account":   string(testBob),
+						"Sequence":  float64(6),
+						"TakerGets": "1000000",
+						"TakerPays": usdObject("1"),
+					},
+				},
+			}}},
+			indexes: []types.Hash256{testIndex(1), testIndex(2), testIndex(3)},
+		},
+		{
+			description: "pass - trust line balance of a known owner",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{
+				{
+					ModifiedNode: &transaction.ModifiedNode{
+						LedgerEntryType: ledger.RippleStateEntry,
+						FinalFields: ledger.FlatLedgerObject{
+							"Balance":   map[string]any{"currency": "USD", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "-7"},
+							"LowLimit":  map[string]any{"currency": "USD", "issuer": string(testIssuer), "value": "0"},
+							"HighLimit": map[string]any{"currency": "USD", "issuer": string(testAlice), "value": "100"},
+						},
+					},
+				},
+				{
+					ModifiedNode: &transaction.ModifiedNode{
+						LedgerEntryType: ledger.RippleStateEntry,
+						FinalFields: ledger.FlatLedgerObject{
+							"Balance":   map[string]any{"currency": "USD", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "3"},
+							"LowLimit":  map[string]any{"currency": "USD", "issuer": string(testCarol), "value": "100"},
+							"HighLimit": map[string]any{"currency": "USD", "issuer": string(testIssuer), "value": "0"},
+						},
+					},
+				},
+			}},
+			indexes: []types.Hash256{testIndex(1), testIndex(2), testIndex(3)},
+			check: func(t *testing.T, b *Book) {
+				funds, ok := b.OwnerFunds(testAlice)
+				require.True(t, ok)
+				require.Equal(t, "7", funds.String())
+				_, ok = b.OwnerFunds(testCarol)
+				require.False(t, ok)
+			},
+		},
+		{
+			description: "fail - invalid offer",
+			meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+				CreatedNode: &transaction.CreatedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					LedgerIndex:     string(testIndex(7)),
+					NewFields:       ledger.FlatLedgerObject{"Sequence": float64(7)},
+				},
+			}}},
+			expectedErr: ErrInvalidOffer,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			b := testBook(t)
+			b.SetOwnerFunds(testAlice, usd(t, "1"))
+
+			changed, err := b.ApplyMetadata(tc.meta)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.changed, changed)
+
+			offers, err := b.Offers()
+			require.NoError(t, err)
+			require.Equal(t, tc.indexes, offerIndexes(offers))
+			if tc.check != nil {
+				tc.check(t, b)
+			}
+		})
+	}
+}
+
+func TestBook_ApplyMetadataAccountRoot(t *testing.T) {
+	b := NewBook(currency.XRPAsset(), testUSD)
+	b.SetOwnerFunds(testAlice, drops(t, 50_000_000))
+
+	changed, err := b.ApplyMetadata(transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+		ModifiedNode: &transaction.ModifiedNode{
+			LedgerEntryType: ledger.AccountRootEntry,
+			FinalFields:     ledger.FlatLedgerObject{"Account": string(testAlice), "Balance": "80000000"},
+			PreviousFields:  ledger.FlatLedgerObject{"Balance": "100000000"},
+		},
+	}}})
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	funds, ok := b.OwnerFunds(testAlice)
+	require.True(t, ok)
+	require.Equal(t, "30000000", funds.String())
+}
@@ -0,0 +1,286 @@
+package orderbook
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// OfferCreate flags, as the flags of transaction.OfferCreate.
+const (
+	tfPassive           uint32 = 0x00010000
+	tfImmediateOrCancel uint32 = 0x00020000
+	tfFillOrKill        uint32 = 0x00040000
+	tfSell              uint32 = 0x00080000
+)
+
+// Order is an OfferCreate to cross against a book: the taker gives up to TakerGets to receive TakerPays.
+type Order struct {
+	// Account is the taker.
+	Account types.Address
+	// TakerGets is the amount the taker gives.
+	TakerGets currency.Amount
+	// TakerPays is the amount the taker wants.
+	TakerPays currency.Amount
+	// Passive orders only cross offers of a strictly better quality.
+	Passive bool
+	// ImmediateOrCancel orders are never placed on the book.
+	ImmediateOrCancel bool
+	// FillOrKill orders are never placed on the book, and do not execute unless fully filled.
+	FillOrKill bool
+	// Sell orders spend all of TakerGets, even if they receive more than TakerPays.
+	Sell bool
+	// Funds is the balance of the taker in TakerGets. Nil means TakerGets is fully funded.
+	Funds *currency.Amount
+}
+
+// NewOrder returns the order of an OfferCreate transaction.
+func NewOrder(tx *transaction.OfferCreate) (Order, error) {
+	takerGets, err := parseAmount(tx.TakerGets)
+	if err != nil {
+		return Order{}, err
+	}
+	takerPays, err := parseAmount(tx.TakerPays)
+	if err != nil {
+		return Order{}, err
+	}
+	return Order{
+		Account:           tx.Account,
+		TakerGets:         takerGets,
+		TakerPays:         takerPays,
+		Passive:           tx.Flags&tfPassive != 0,
+		ImmediateOrCancel: tx.Flags&tfImmediateOrCancel != 0,
+		FillOrKill:        tx.Flags&tfFillOrKill != 0,
+		Sell:              tx.Flags&tfSell != 0,
+	}, nil
+}
+
+// Fill is an offer crossed by an order.
+type Fill struct {
+	// Offer is the offer before the order crossed it.
+	Offer Offer
+	// Amounts are the amounts exchanged: In is paid by the taker to the owner and Out by the owner to the taker.
+	Amounts Amounts
+	// TakerPaid is In with the transfer fee paid by the taker.
+	TakerPaid currency.Amount
+	// OwnerPaid is Out with the transfer fee paid by the owner.
+	OwnerPaid currency.Amount
+}
+
+// Crossing is the result of crossing an order against a book.
+type Crossing struct {
+	// Fills are the offers crossed, best quality first.
+	Fills []Fill
+	// TakerPaid is the total paid by the taker, transfer fees included.
+	TakerPaid currency.Amount
+	// TakerGot is the total received by the taker.
+	TakerGot currency.Amount
+	// Remainder is the part of the order placed on the book, In being the TakerGets of the new offer and Out its
+	// TakerPays, or nil if the order is fully filled, ImmediateOrCancel or FillOrKill.
+	Remainder *Amounts
+	// Killed is true if a FillOrKill order was not fully filled. It does not execute, and Fills is empty.
+	Killed bool
+}
+
+// Cross simulates crossing an order against the book, as rippled's offer crossing does without auto-bridging:
+// the offers of a quality at least as good as the order's are consumed in book order, limited by the funds of
+// their owners, the amounts of the order and the funds of the taker, transfer fees included. The book is left
+// unchanged.
+func (b *Book) Cross(order Order) (Crossing, error) {
+	if order.TakerPays.Asset() != b.takerGets || order.TakerGets.Asset() != b.takerPays {
+		return Crossing{}, ErrBookMismatch
+	}
+	if order.TakerGets.Signum() <= 0 || order.TakerPays.Signum() <= 0 {
+		return Crossing{}, ErrInvalidOrder
+	}
+
+	original := Amounts{In: order.TakerGets, Out: order.TakerPays}
+	threshold, err := NewQuality(original)
+	if err != nil {
+		return Crossing{}, err
+	}
+	if order.Passive && threshold > 0 {
+		threshold--
+	}
+
+	result := Crossing{
+		TakerPaid: currency.ZeroAmount(b.takerPays),
+		TakerGot:  currency.ZeroAmount(b.takerGets),
+	}
+	remaining := original
+	takerFunds := order.Funds
+	balances := make(map[types.Address]currency.Amount, len(b.ownerFunds))
+	for owner, funds := range b.ownerFunds {
+		balances[owner] = funds
+	}
+	inRate := func(owner types.Address) uint32 {
+		return effectiveRate(b.TakerPaysTransferRate, b.takerPays, order.Account, owner)
+	}
+	outRate := func(owner types.Address) uint32 {
+		return effectiveRate(b.TakerGetsTransferRate, b.takerGets, owner, order.Account)
+	}
+
+	for _, o := range b.offers {
+		if o.Quality > threshold || b.crossingDone(order, remaining, takerFunds) {
+			break
+		}
+
+		var ownerFunds *currency.Amount
+		if funds, ok := balances[o.Account]; ok && !b.isIssuer(o.Account) {
+			if funds.Signum() <= 0 {
+				continue
+			}
+			ownerFunds = &funds
+		}
+
+		f, err := crossOffer(o, ownerFunds, takerFunds, remaining, order.Sell, inRate(o.Account), outRate(o.Account))
+		if err != nil {
+			return Crossing{}, err
+		}
+		if f.Amounts.Out.IsZero() {
+			continue
+		}
+		result.Fills = append(result.Fills, f)
+
+		if remaining.In, err = remaining.In.Sub(f.Amounts.In); err != nil {
+			return Crossing{}, err
+		}
+		if remaining.Out, err = remaining.Out.Sub(f.Amounts.Out); err != nil {
+			return Crossing{}, err
+		}
+		if result.TakerPaid, err = result.TakerPaid.Add(f.TakerPaid); err != nil {
+			return Crossing{}, err
+		}
+		if result.TakerGot, err = result.TakerGot.Add(f.Amounts.Out); err != nil {
+			return Crossing{}, err
+		}
+		if takerFunds != nil {
+			funds, err := takerFunds.Sub(f.TakerPaid)
+			if err != nil {
+				return Crossing{}, err
+			}
+			takerFunds = &funds
+		}
+		if ownerFunds != nil {
+			if balances[o.Account], err = ownerFunds.Sub(f.OwnerPaid); err != nil {
+				return Crossing{}, err
+			}
+		}
+	}
+
+	filled := remaining.In.Signum() <= 0 || (!order.Sell && remaining.Out.Signum() <= 0)
+	if order.FillOrKill && !filled {
+		return Crossing{
+			TakerPaid: currency.ZeroAmount(b.takerPays),
+			TakerGot:  currency.ZeroAmount(b.takerGets),
+			Killed:    true,
+		}, nil
+	}
+	if !filled && !order.ImmediateOrCancel && !order.FillOrKill {
+		if result.Remainder, err = remainder(original, remaining, len(result.Fills) > 0, order.Sell); err != nil {
+			return Crossing{}, err
+		}
+	}
+	return result, nil
+}
+
+// crossingDone reports whether an order is done crossing: it spent all of TakerGets or of its funds, or it
+// received all of TakerPays unless selling.
+func (b *Book) crossingDone(order Order, remaining Amounts, takerFunds *currency.Amount) bool {
+	return remaining.In.Signum() <= 0 ||
+		(!order.Sell && remaining.Out.Signum() <= 0) ||
+		(takerFunds != nil && takerFunds.Signum() <= 0)
+}
+
+// remainder returns the amounts of the offer placed on the book after crossing, as rippled computes them: a
+// sell order keeps the rest of TakerGets and rounds TakerPays down at the order quality, while a buy order
+// keeps the rest of TakerPays and rounds TakerGets up.
+func remainder(original, remaining Amounts, crossed, sell bool) (*Amounts, error) {
+	if !crossed {
+		return &original, nil
+	}
+
+	rate, err := NewQuality(original)
+	if err != nil {
+		return nil, err
+	}
+
+	placed := remaining
+	if sell {
+		placed.Out, err = currency.DivRoundStrict(remaining.In, rate.Rate(), original.Out.Asset(), false)
+	} else {
+		placed.In, err = currency.MulRound(remaining.Out, rate.Rate(), original.In.Asset(), true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if placed.In.Signum() <= 0 || placed.Out.Signum() <= 0 {
+		return nil, nil
+	}
+	return &placed, nil
+}
+
+// crossOffer returns the fill of an offer, as rippled's BasicTaker::flow: the offer amounts are limited by the
+// funds of the owner, the remaining TakerPays of the order unless selling, the remaining TakerGets of the order
+// and the funds of the taker, at the offer quality. Nil funds are unlimited.
+func crossOffer(o Offer, ownerFunds, takerFunds *currency.Amount, remaining Amounts, sell bool, inRate, outRate uint32) (Fill, error) {
+	f := Fill{Offer: o, Amounts: o.Amounts()}
+	if err := f.pay(inRate, outRate); err != nil {
+		return Fill{}, err
+	}
+
+	var err error
+	if ownerFunds != nil && less(*ownerFunds, f.OwnerPaid) {
+		out, err := divideRate(*ownerFunds, outRate)
+		if err != nil {
+			return Fill{}, err
+		}
+		if f.Amounts, err = o.Quality.CeilOut(f.Amounts, out); err != nil {
+			return Fill{}, err
+		}
+		if err := f.pay(inRate, outRate); err != nil {
+			return Fill{}, err
+		}
+		f.OwnerPaid = *ownerFunds
+	}
+	if !sell && less(remaining.Out, f.Amounts.Out) {
+		if f.Amounts, err = o.Quality.CeilOut(f.Amounts, remaining.Out); err != nil {
+			return Fill{}, err
+		}
+		if err := f.pay(inRate, outRate); err != nil {
+			return Fill{}, err
+		}
+	}
+	if less(remaining.In, f.Amounts.In) {
+		if f.Amounts, err = o.Quality.CeilIn(f.Amounts, remaining.In); err != nil {
+			return Fill{}, err
+		}
+		if err := f.pay(inRate, outRate); err != nil {
+			return Fill{}, err
+		}
+	}
+	if takerFunds != nil && less(*takerFunds, f.TakerPaid) {
+		in, err := divideRate(*takerFunds, inRate)
+		if err != nil {
+			return Fill{}, err
+		}
+		if f.Amounts, err = o.Quality.CeilIn(f.Amounts, in); err != nil {
+			return Fill{}, err
+		}
+		if err := f.pay(inRate, outRate); err != nil {
+			return Fill{}, err
+		}
+		f.TakerPaid = *takerFunds
+	}
+	return f, nil
+}
+
+// pay sets TakerPaid and OwnerPaid from the amounts of the fill and the transfer rates.
+func (f *Fill) pay(inRate, outRate uint32) error {
+	var err error
+	if f.TakerPaid, err = multiplyRate(f.Amounts.In, inRate); err != nil {
+		return err
+	}
+	f.OwnerPaid, err = multiplyRate(f.Amounts.Out, outRate)
+	return err
+}
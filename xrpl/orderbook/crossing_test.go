@@ -0,0 +1,221 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testTaker types.Address = "rDsbeomae4FXwgQTJp9Rs64Qg9vDiTCdBv"
+
+func TestNewOrder(t *testing.T) {
+	tx := &transaction.OfferCreate{
+		BaseTx: transaction.BaseTx{
+			Account: testTaker,
+			Flags:   tfSell | tfImmediateOrCancel,
+		},
+		TakerGets: types.XRPCurrencyAmount(40_000_000),
+		TakerPays: types.IssuedCurrencyAmount{Currency: "USD", Issuer: testIssuer, Value: "15"},
+	}
+
+	order, err := NewOrder(tx)
+	require.NoError(t, err)
+	require.Equal(t, testTaker, order.Account)
+	require.Equal(t, "40000000", order.TakerGets.String())
+	require.Equal(t, "15", order.TakerPays.String())
+	require.Equal(t, testUSD, order.TakerPays.Asset())
+	require.True(t, order.Sell)
+	require.True(t, order.ImmediateOrCancel)
+	require.False(t, order.FillOrKill)
+	require.False(t, order.Passive)
+}
+
+type expectedFill struct {
+	index           types.Hash256
+	in, out         string
+	takerPaid, paid string
+}
+
+func TestBook_Cross(t *testing.T) {
+	tt := []struct {
+		description string
+		order       func(t *testing.T) Order
+		setup       func(t *testing.T, b *Book)
+		fills       []expectedFill
+		takerPaid   string
+		takerGot    string
+		remainder   []string
+		killed      bool
+		expectedErr error
+	}{
+		{
+			description: "pass - buy",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 40_000_000), TakerPays: usd(t, "15")}
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "20000000", out: "10", takerPaid: "20000000", paid: "10"},
+				{index: testIndex(2), in: "12500000", out: "5", takerPaid: "12500000", paid: "5"},
+			},
+			takerPaid: "32500000",
+			takerGot:  "15",
+		},
+		{
+			description: "pass - sell",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 40_000_000), TakerPays: usd(t, "15"), Sell: true}
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "20000000", out: "10", takerPaid: "20000000", paid: "10"},
+				{index: testIndex(2), in: "20000000", out: "8", takerPaid: "20000000", paid: "8"},
+			},
+			takerPaid: "40000000",
+			takerGot:  "18",
+		},
+		{
+			description: "pass - remainder placed",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 33_000_000), TakerPays: usd(t, "15")}
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "20000000", out: "10", takerPaid: "20000000", paid: "10"},
+			},
+			takerPaid: "20000000",
+			takerGot:  "10",
+			remainder: []string{"11000000", "5"},
+		},
+		{
+			description: "pass - immediate or cancel",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 33_000_000), TakerPays: usd(t, "15"), ImmediateOrCancel: true}
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "20000000", out: "10", takerPaid: "20000000", paid: "10"},
+			},
+			takerPaid: "20000000",
+			takerGot:  "10",
+		},
+		{
+			description: "pass - fill or kill killed",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 33_000_000), TakerPays: usd(t, "15"), FillOrKill: true}
+			},
+			takerPaid: "0",
+			takerGot:  "0",
+			killed:    true,
+		},
+		{
+			description: "pass - passive at equal quality",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 20_000_000), TakerPays: usd(t, "10"), Passive: true}
+			},
+			takerPaid: "0",
+			takerGot:  "0",
+			remainder: []string{"20000000", "10"},
+		},
+		{
+			description: "pass - taker funds",
+			order: func(t *testing.T) Order {
+				funds := drops(t, 10_000_000)
+				return Order{Account: testTaker, TakerGets: drops(t, 40_000_000), TakerPays: usd(t, "15"), Funds: &funds}
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "10000000", out: "5", takerPaid: "10000000", paid: "5"},
+			},
+			takerPaid: "10000000",
+			takerGot:  "5",
+			remainder: []string{"26666667", "10"},
+		},
+		{
+			description: "pass - owner funds and transfer fee",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 40_000_000), TakerPays: usd(t, "15")}
+			},
+			setup: func(t *testing.T, b *Book) {
+				b.TakerGetsTransferRate = 1_002_000_000
+				b.SetOwnerFunds(testAlice, usd(t, "4"))
+			},
+			fills: []expectedFill{
+				{index: testIndex(1), in: "7984032", out: "3.992015968063872", takerPaid: "7984032", paid: "4"},
+				{index: testIndex(2), in: "25000000", out: "10", takerPaid: "25000000", paid: "10.02"},
+			},
+			takerPaid: "32984032",
+			takerGot:  "13.99201596806387",
+			remainder: []string{"2687958", "1.00798403193613"},
+		},
+		{
+			description: "pass - unfunded owner skipped",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 25_000_000), TakerPays: usd(t, "10")}
+			},
+			setup: func(t *testing.T, b *Book) {
+				b.SetOwnerFunds(testAlice, usd(t, "0"))
+			},
+			fills: []expectedFill{
+				{index: testIndex(2), in: "25000000", out: "10", takerPaid: "25000000", paid: "10"},
+			},
+			takerPaid: "25000000",
+			takerGot:  "10",
+		},
+		{
+			description: "fail - book mismatch",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: usd(t, "15"), TakerPays: drops(t, 40_000_000)}
+			},
+			expectedErr: ErrBookMismatch,
+		},
+		{
+			description: "fail - zero amount",
+			order: func(t *testing.T) Order {
+				return Order{Account: testTaker, TakerGets: drops(t, 0), TakerPays: usd(t, "15")}
+			},
+			expectedErr: ErrInvalidOrder,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			b := testBook(t)
+			if tc.setup != nil {
+				tc.setup(t, b)
+			}
+
+			c, err := b.Cross(tc.order(t))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, c.Fills, len(tc.fills))
+			for i, f := range tc.fills {
+				require.Equal(t, f.index, c.Fills[i].Offer.Index)
+				require.Equal(t, f.in, c.Fills[i].Amounts.In.String())
+				require.Equal(t, f.out, c.Fills[i].Amounts.Out.String())
+				require.Equal(t, f.takerPaid, c.Fills[i].TakerPaid.String())
+				require.Equal(t, f.paid, c.Fills[i].OwnerPaid.String())
+			}
+			require.Equal(t, tc.takerPaid, c.TakerPaid.String())
+			require.Equal(t, tc.takerGot, c.TakerGot.String())
+			require.Equal(t, tc.killed, c.Killed)
+			if tc.remainder == nil {
+				require.Nil(t, c.Remainder)
+			} else {
+				require.NotNil(t, c.Remainder)
+				require.Equal(t, tc.remainder, []string{c.Remainder.In.String(), c.Remainder.Out.String()})
+			}
+			require.Equal(t, 3, b.Len())
+		})
+	}
+}
+
+func TestBook_CrossUnfundedTaker(t *testing.T) {
+	b := testBook(t)
+	funds := currency.ZeroAmount(currency.XRPAsset())
+	c, err := b.Cross(Order{Account: testTaker, TakerGets: drops(t, 40_000_000), TakerPays: usd(t, "15"), Funds: &funds})
+	require.NoError(t, err)
+	require.Empty(t, c.Fills)
+	require.NotNil(t, c.Remainder)
+}
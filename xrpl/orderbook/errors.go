@@ -0,0 +1,19 @@
+package orderbook
+
+import "errors"
+
+var (
+	// ErrInvalidQuality is returned when a quality or book directory cannot be decoded.
+	ErrInvalidQuality = errors.New("invalid quality")
+	// ErrInvalidOffer is returned when an offer is missing a field or has an invalid one.
+	ErrInvalidOffer = errors.New("invalid offer")
+	// ErrInvalidAmount is returned when an amount is neither a drops string nor an issued currency or MPT object.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrBookMismatch is returned when an order does not cross the book, because its TakerPays is not the asset
+	// of the book TakerGets or its TakerGets is not the asset of the book TakerPays.
+	ErrBookMismatch = errors.New("order does not cross the book")
+	// ErrOfferNotInBook is returned when inserting an offer whose TakerGets and TakerPays are not the assets of the book.
+	ErrOfferNotInBook = errors.New("offer is not in the book")
+	// ErrInvalidOrder is returned when the TakerGets or TakerPays of an order is not positive.
+	ErrInvalidOrder = errors.New("invalid order, TakerGets and TakerPays must be positive")
+)
@@ -0,0 +1,171 @@
+package orderbook
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Offer is an offer of a book.
+type Offer struct {
+	// Index is the ID of the Offer ledger entry.
+	Index types.Hash256
+	// Account is the owner of the offer.
+	Account types.Address
+	// Sequence is the sequence of the OfferCreate transaction that created the offer.
+	Sequence uint32
+	// Flags are the flags of the Offer ledger entry.
+	Flags uint32
+	// Expiration is the time after which the offer is no longer active, in seconds since the Ripple Epoch, or 0.
+	Expiration uint32
+	// BookDirectory is the ID of the book directory of the offer, which encodes its quality.
+	BookDirectory types.Hash256
+	// TakerGets is the remaining amount the owner gives.
+	TakerGets currency.Amount
+	// TakerPays is the remaining amount the owner wants.
+	TakerPays currency.Amount
+	// Quality is the quality of the offer, set when it was created.
+	Quality Quality
+
+	// TakerGetsFunded and TakerPaysFunded are the part of TakerGets and TakerPays the funds of the owner can pay
+	// for. They are set on the offers returned by Book.Offers.
+	TakerGetsFunded currency.Amount
+	TakerPaysFunded currency.Amount
+}
+
+// Amounts returns the amounts of the offer: In is TakerPays and Out is TakerGets.
+func (o Offer) Amounts() Amounts {
+	return Amounts{In: o.TakerPays, Out: o.TakerGets}
+}
+
+// NewOfferFromBookOffer returns the offer of a book_offers response entry.
+func NewOfferFromBookOffer(bo pathtypes.BookOffer) (Offer, error) {
+	o := Offer{
+		Index:         bo.Index,
+		Account:       bo.Account,
+		Sequence:      bo.Sequence,
+		Flags:         bo.Flags,
+		Expiration:    bo.Expiration,
+		BookDirectory: bo.BookDirectory,
+	}
+	if err := o.setAmounts(bo.TakerGets, bo.TakerPays); err != nil {
+		return Offer{}, err
+	}
+	if err := o.setQuality(bo.Quality); err != nil {
+		return Offer{}, err
+	}
+	return o, nil
+}
+
+// NewOfferFromFields returns the offer of the fields of an Offer ledger entry, such as the NewFields or
+// FinalFields of transaction metadata.
+func NewOfferFromFields(index string, fields ledger.FlatLedgerObject) (Offer, error) {
+	account, _ := fields["Account"].(string)
+	directory, _ := fields["BookDirectory"].(string)
+	if account == "" {
+		return Offer{}, ErrInvalidOffer
+	}
+
+	o := Offer{
+		Index:         types.Hash256(index),
+		Account:       types.Address(account),
+		BookDirectory: types.Hash256(directory),
+	}
+	var ok bool
+	if o.Sequence, ok = uint32Field(fields, "Sequence"); !ok {
+		return Offer{}, ErrInvalidOffer
+	}
+	if o.Flags, ok = uint32Field(fields, "Flags"); !ok {
+		return Offer{}, ErrInvalidOffer
+	}
+	if o.Expiration, ok = uint32Field(fields, "Expiration"); !ok {
+		return Offer{}, ErrInvalidOffer
+	}
+	if err := o.setAmounts(fields["TakerGets"], fields["TakerPays"]); err != nil {
+		return Offer{}, err
+	}
+	if err := o.setQuality(""); err != nil {
+		return Offer{}, err
+	}
+	return o, nil
+}
+
+// setAmounts parses and sets TakerGets and TakerPays.
+func (o *Offer) setAmounts(takerGets, takerPays any) error {
+	var err error
+	if o.TakerGets, err = parseAmount(takerGets); err != nil {
+		return err
+	}
+	if o.TakerPays, err = parseAmount(takerPays); err != nil {
+		return err
+	}
+	return nil
+}
+
+// setQuality sets the quality of the offer from its book directory if known, or else from the quality field of
+// book_offers if not empty, or else from its amounts.
+func (o *Offer) setQuality(quality string) error {
+	var err error
+	switch {
+	case o.BookDirectory != "":
+		o.Quality, err = QualityFromBookDirectory(o.BookDirectory)
+	case quality != "":
+		o.Quality, err = ParseQuality(quality)
+	default:
+		o.Quality, err = NewQuality(o.Amounts())
+	}
+	return err
+}
+
+// parseAmount parses an amount as found in JSON: a drops string, an issued currency object or an MPT object.
+func parseAmount(v any) (currency.Amount, error) {
+	switch a := v.(type) {
+	case string:
+		drops, err := strconv.ParseInt(a, 10, 64)
+		if err != nil {
+			return currency.Amount{}, ErrInvalidAmount
+		}
+		return currency.NewXRPAmount(drops)
+	case map[string]any:
+		value, _ := a["value"].(string)
+		if id, ok := a["mpt_issuance_id"].(string); ok {
+			return currency.NewAmount(types.MPTCurrencyAmount{MPTIssuanceID: id, Value: value})
+		}
+		c, _ := a["currency"].(string)
+		issuer, _ := a["issuer"].(string)
+		if c == "" {
+			return currency.Amount{}, ErrInvalidAmount
+		}
+		return currency.NewIssuedAmount(c, types.Address(issuer), value)
+	case types.CurrencyAmount:
+		return currency.NewAmount(a)
+	default:
+		return currency.Amount{}, ErrInvalidAmount
+	}
+}
+
+// uint32Field returns the value of a numeric field, which is 0 if the field is absent. Numbers are float64 or
+// json.Number when the fields are decoded from JSON.
+func uint32Field(fields ledger.FlatLedgerObject, name string) (uint32, bool) {
+	switch v := fields[name].(type) {
+	case nil:
+		return 0, true
+	case uint32:
+		return v, true
+	case float64:
+		return uint32(v), v >= 0 && v == float64(uint32(v))
+	case json.Number:
+		n, err := strconv.ParseUint(v.String(), 10, 32)
+		return uint32(n), err == nil
+	case int:
+		return uint32(v), v >= 0 && int64(v) == int64(uint32(v))
+	case uint64:
+		return uint32(v), v == uint64(uint32(v))
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,120 @@
+package orderbook
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// qualityMantissaMask masks the 56 bit mantissa of a Quality.
+	qualityMantissaMask uint64 = 1<<56 - 1
+	// QualityOne is the transfer rate charging no fee, in billionths.
+	QualityOne uint32 = 1_000_000_000
+)
+
+// noIssue is the asset of rates, which rippled represents as issued currency amounts with no currency and issuer.
+var noIssue = currency.Asset{Kind: types.ISSUED}
+
+// Amounts is a pair of amounts flowing through an offer: In is paid by the taker to the offer owner, and Out is
+// paid by the offer owner to the taker. For an offer, In is its TakerPays and Out its TakerGets.
+type Amounts struct {
+	In  currency.Amount
+	Out currency.Amount
+}
+
+// Quality is the rate of an offer, In per unit of Out, encoded as rippled does: the exponent plus 100 in the top
+// byte and the mantissa in the 56 lower bits. It is also the last 64 bits of the book directory of the offer.
+// A lower quality is a better rate for the taker.
+type Quality uint64
+
+// NewQuality returns the quality of the amounts, as rippled's getRate. A zero Out, or a rate too small to be
+// represented, returns the zero quality.
+func NewQuality(amounts Amounts) (Quality, error) {
+	if amounts.Out.IsZero() {
+		return 0, nil
+	}
+	r, err := currency.RoundToNearest.Divide(amounts.In, amounts.Out, noIssue)
+	if err != nil {
+		return 0, err
+	}
+	if r.IsZero() {
+		return 0, nil
+	}
+	return Quality(uint64(r.Exponent()+100)<<56 | r.Mantissa()), nil
+}
+
+// ParseQuality returns the quality of a decimal rate, such as the quality field of the book_offers method.
+func ParseQuality(rate string) (Quality, error) {
+	r, err := currency.NewIssuedAmount("", "", rate)
+	if err != nil || r.IsNegative() {
+		return 0, ErrInvalidQuality
+	}
+	if r.IsZero() {
+		return 0, nil
+	}
+	return Quality(uint64(r.Exponent()+100)<<56 | r.Mantissa()), nil
+}
+
+// QualityFromBookDirectory returns the quality of the offers of a book directory, encoded in its last 64 bits.
+func QualityFromBookDirectory(bookDirectory types.Hash256) (Quality, error) {
+	b, err := hex.DecodeString(string(bookDirectory))
+	if err != nil || len(b) != 32 {
+		return 0, ErrInvalidQuality
+	}
+	var q uint64
+	for _, c := range b[24:] {
+		q = q<<8 | uint64(c)
+	}
+	return Quality(q), nil
+}
+
+// Rate returns the rate of the quality, In per unit of Out, as an amount with no asset.
+func (q Quality) Rate() currency.Amount {
+	r, _ := currency.NewAmountFromMantissa(noIssue, uint64(q)&qualityMantissaMask, int(uint64(q)>>56)-100, false)
+	return r
+}
+
+// String returns the rate of the quality as a decimal number.
+func (q Quality) String() string {
+	return q.Rate().String()
+}
+
+// Hex returns the 16 upper case hex digits encoding of the quality, as found at the end of book directories.
+func (q Quality) Hex() string {
+	return fmt.Sprintf("%016X", uint64(q))
+}
+
+// CeilIn limits the amounts to an In of at most limit, at the rate of the quality, as rippled's Quality::ceil_in.
+// Out is rounded up, and clamped to the original Out.
+func (q Quality) CeilIn(amounts Amounts, limit currency.Amount) (Amounts, error) {
+	if c, err := amounts.In.Cmp(limit); err != nil || c <= 0 {
+		return amounts, err
+	}
+	out, err := currency.DivRound(limit, q.Rate(), amounts.Out.Asset(), true)
+	if err != nil {
+		return Amounts{}, err
+	}
+	if c, _ := out.Cmp(amounts.Out); c > 0 {
+		out = amounts.Out
+	}
+	return Amounts{In: limit, Out: out}, nil
+}
+
+// CeilOut limits the amounts to an Out of at most limit, at the rate of the quality, as rippled's Quality::ceil_out.
+// In is rounded up, and clamped to the original In.
+func (q Quality) CeilOut(amounts Amounts, limit currency.Amount) (Amounts, error) {
+	if c, err := amounts.Out.Cmp(limit); err != nil || c <= 0 {
+		return amounts, err
+	}
+	in, err := currency.MulRound(limit, q.Rate(), amounts.In.Asset(), true)
+	if err != nil {
+		return Amounts{}, err
+	}
+	if c, _ := in.Cmp(amounts.In); c > 0 {
+		in = amounts.In
+	}
+	return Amounts{In: in, Out: limit}, nil
+}
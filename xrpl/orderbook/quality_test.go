@@ -0,0 +1,175 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer types.Address = "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"
+
+var testUSD = currency.IssuedAsset("USD", testIssuer)
+
+func usd(t *testing.T, value string) currency.Amount {
+	t.Helper()
+	a, err := currency.NewIssuedAmount("USD", testIssuer, value)
+	require.NoError(t, err)
+	return a
+}
+
+func drops(t *testing.T, value int64) currency.Amount {
+	t.Helper()
+	a, err := currency.NewXRPAmount(value)
+	require.NoError(t, err)
+	return a
+}
+
+func TestNewQuality(t *testing.T) {
+	tt := []struct {
+		description string
+		amounts     func(t *testing.T) Amounts
+		expected    Quality
+		rate        string
+	}{
+		{
+			description: "pass - XRP for USD",
+			amounts:     func(t *testing.T) Amounts { return Amounts{In: drops(t, 20_000_000), Out: usd(t, "10")} },
+			expected:    0x5B071AFD498D0000,
+			rate:        "2000000",
+		},
+		{
+			description: "pass - USD for XRP",
+			amounts:     func(t *testing.T) Amounts { return Amounts{In: usd(t, "10"), Out: drops(t, 20_000_000)} },
+			expected:    0x4E11C37937E08000,
+			rate:        "0.0000005",
+		},
+		{
+			description: "pass - zero out",
+			amounts:     func(t *testing.T) Amounts { return Amounts{In: usd(t, "10"), Out: usd(t, "0")} },
+			expected:    0,
+			rate:        "0",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			q, err := NewQuality(tc.amounts(t))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+			require.Equal(t, tc.rate, q.String())
+		})
+	}
+}
+
+func TestParseQuality(t *testing.T) {
+	tt := []struct {
+		description string
+		rate        string
+		expected    Quality
+		expectedErr error
+	}{
+		{description: "pass - integer", rate: "2000000", expected: 0x5B071AFD498D0000},
+		{description: "pass - decimal", rate: "0.0000005", expected: 0x4E11C37937E08000},
+		{description: "pass - zero", rate: "0", expected: 0},
+		{description: "fail - negative", rate: "-1", expectedErr: ErrInvalidQuality},
+		{description: "fail - not a number", rate: "abc", expectedErr: ErrInvalidQuality},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			q, err := ParseQuality(tc.rate)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+		})
+	}
+}
+
+func TestQualityFromBookDirectory(t *testing.T) {
+	tt := []struct {
+		description string
+		directory   types.Hash256
+		expected    Quality
+		expectedErr error
+	}{
+		{
+			description: "pass - quality in the last 64 bits",
+			directory:   "DFA3B6DDAB58C7E8E5D944E736DA4B7046C30E4F460FD9DE5B071AFD498D0000",
+			expected:    0x5B071AFD498D0000,
+		},
+		{
+			description: "fail - too short",
+			directory:   "5B071AFD498D0000",
+			expectedErr: ErrInvalidQuality,
+		},
+		{
+			description: "fail - not hex",
+			directory:   "ZZA3B6DDAB58C7E8E5D944E736DA4B7046C30E4F460FD9DE5B071AFD498D0000",
+			expectedErr: ErrInvalidQuality,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			q, err := QualityFromBookDirectory(tc.directory)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+			require.Equal(t, string(tc.directory[48:]), q.Hex())
+		})
+	}
+}
+
+func TestQuality_Ceil(t *testing.T) {
+	amounts := Amounts{In: drops(t, 25_000_000), Out: usd(t, "10")}
+	q, err := NewQuality(amounts)
+	require.NoError(t, err)
+
+	tt := []struct {
+		description string
+		ceil        func() (Amounts, error)
+		in, out     string
+	}{
+		{
+			description: "pass - CeilIn limits In",
+			ceil:        func() (Amounts, error) { return q.CeilIn(amounts, drops(t, 20_000_000)) },
+			in:          "20000000",
+			out:         "8",
+		},
+		{
+			description: "pass - CeilIn above In",
+			ceil:        func() (Amounts, error) { return q.CeilIn(amounts, drops(t, 30_000_000)) },
+			in:          "25000000",
+			out:         "10",
+		},
+		{
+			description: "pass - CeilOut rounds In up",
+			ceil:        func() (Amounts, error) { return q.CeilOut(amounts, usd(t, "0.0000001")) },
+			in:          "1",
+			out:         "0.0000001",
+		},
+		{
+			description: "pass - CeilOut limits Out",
+			ceil:        func() (Amounts, error) { return q.CeilOut(amounts, usd(t, "5")) },
+			in:          "12500000",
+			out:         "5",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			r, err := tc.ceil()
+			require.NoError(t, err)
+			require.Equal(t, tc.in, r.In.String())
+			require.Equal(t, tc.out, r.Out.String())
+		})
+	}
+}
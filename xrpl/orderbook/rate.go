@@ -0,0 +1,44 @@
+package orderbook
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// effectiveRate returns the transfer rate charged when from pays to to an amount of the asset: the rate of the
+// issuer, unless the issuer is one of the parties or the parties are the same account.
+func effectiveRate(rate uint32, asset currency.Asset, from, to types.Address) uint32 {
+	if asset.Kind != types.ISSUED || rate == 0 || rate == QualityOne ||
+		from == to || from == asset.Issuer || to == asset.Issuer {
+		return QualityOne
+	}
+	return rate
+}
+
+// rateAmount returns a transfer rate as an amount with no asset.
+func rateAmount(rate uint32) currency.Amount {
+	r, _ := currency.NewAmountFromMantissa(noIssue, uint64(rate), -9, false)
+	return r
+}
+
+// multiplyRate returns the amount with the transfer fee of rate added.
+func multiplyRate(amount currency.Amount, rate uint32) (currency.Amount, error) {
+	if rate == QualityOne {
+		return amount, nil
+	}
+	return currency.RoundToNearest.Multiply(amount, rateAmount(rate), amount.Asset())
+}
+
+// divideRate returns the amount with the transfer fee of rate removed.
+func divideRate(amount currency.Amount, rate uint32) (currency.Amount, error) {
+	if rate == QualityOne {
+		return amount, nil
+	}
+	return currency.RoundToNearest.Divide(amount, rateAmount(rate), amount.Asset())
+}
+
+// less reports whether a is lower than b, which are amounts of the same asset.
+func less(a, b currency.Amount) bool {
+	c, _ := a.Cmp(b)
+	return c < 0
+}
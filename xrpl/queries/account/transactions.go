@@ -2,6 +2,7 @@
 package account
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -9,17 +10,44 @@ import (
 )
 
 // Transaction represents a transaction entry in an account transactions response.
+// In binary mode, the transaction and its metadata are returned as TxBlob and MetaBlob,
+// which DecodeBinary decodes into Tx and Meta.
 type Transaction struct {
 	CloseTimeISO string                      `json:"close_time_iso"`
 	Hash         common.LedgerHash           `json:"hash"`
 	LedgerHash   common.LedgerHash           `json:"ledger_hash"`
 	LedgerIndex  uint64                      `json:"ledger_index"`
 	Meta         transaction.TxObjMeta       `json:"meta"`
+	MetaBlob     string                      `json:"meta_blob,omitempty"`
 	Tx           transaction.FlatTransaction `json:"tx_json"`
 	TxBlob       string                      `json:"tx_blob"`
 	Validated    bool                        `json:"validated"`
 }
 
+// DecodeBinary decodes the TxBlob and MetaBlob of a binary mode transaction into Tx and Meta.
+// Blobs that are empty are left undecoded, so it is a no-op for JSON mode transactions.
+func (t *Transaction) DecodeBinary() error {
+	if t.TxBlob != "" {
+		tx, err := binarycodec.Decode(t.TxBlob)
+		if err != nil {
+			return err
+		}
+		t.Tx = tx
+	}
+	if t.MetaBlob != "" {
+		meta, err := binarycodec.Decode(t.MetaBlob)
+		if err != nil {
+			return err
+		}
+		tmb, err := transaction.NewTxMetadataBuilder(meta, t.Tx)
+		if err != nil {
+			return err
+		}
+		t.Meta = tmb.AsTxObjMeta()
+	}
+	return nil
+}
+
 // ############################################################################
 // Request
 // ############################################################################
@@ -68,3 +96,13 @@ type TransactionsResponse struct {
 	Transactions   []Transaction      `json:"transactions"`
 	Validated      bool               `json:"validated"`
 }
+
+// DecodeBinary decodes the blobs of every transaction of a binary mode response. See Transaction.DecodeBinary.
+func (r *TransactionsResponse) DecodeBinary() error {
+	for i := range r.Transactions {
+		if err := r.Transactions[i].DecodeBinary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccountTransactionsRequest(t *testing.T) {
@@ -88,3 +90,110 @@ func TestAccountTransactionsResponse(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestTransactionsResponse_DecodeBinary(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    TransactionsResponse
+		expected    []Transaction
+		expectedErr bool
+	}{
+		{
+			name: "pass - binary mode",
+			response: TransactionsResponse{
+				Transactions: []Transaction{
+					{
+						LedgerIndex: 71766343,
+						TxBlob:      binaryTxBlob,
+						MetaBlob:    binaryMetaBlob,
+						Validated:   true,
+					},
+				},
+			},
+			expected: []Transaction{
+				{
+					LedgerIndex: 71766343,
+					Tx: transaction.FlatTransaction{
+						"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+						"Amount":          "100000000",
+						"Destination":     "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+						"Fee":             "12",
+						"Flags":           uint32(2147483648),
+						"Sequence":        uint32(1),
+						"SigningPubKey":   "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+						"TransactionType": "Payment",
+						"TxnSignature":    "3045022100A7CCD1B5F67D76C7F2C0B6C199C9D2F3721A14A3C69F3CB134E9BF9D9DD6F8B002206A5F974C4F4D07B4F5A99391BF3E93D9B0A7346861E12E924B6451B6D8ED4F09",
+					},
+					TxBlob:   binaryTxBlob,
+					MetaBlob: binaryMetaBlob,
+					Meta: transaction.TxObjMeta{
+						AffectedNodes: []transaction.AffectedNode{
+							{ModifiedNode: &transaction.ModifiedNode{
+								LedgerEntryType: "AccountRoot",
+								LedgerIndex:     "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+								FinalFields: map[string]any{
+									"Account":    "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+									"Balance":    "999999988",
+									"Flags":      float64(0),
+									"OwnerCount": float64(0),
+									"Sequence":   float64(2),
+								},
+								PreviousFields:    map[string]any{"Balance": "1100000000", "Sequence": float64(1)},
+								PreviousTxnID:     "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+								PreviousTxnLgrSeq: 71766300,
+							}},
+							{CreatedNode: &transaction.CreatedNode{
+								LedgerEntryType: "AccountRoot",
+								LedgerIndex:     "4E1A8C6E7E4E9E6F0C8B7D3E2A1F0B9C8D7E6F5A4B3C2D1E0F9A8B7C6D5E4F3A",
+								NewFields: map[string]any{
+									"Account":  "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+									"Balance":  "100000000",
+									"Sequence": float64(71766343),
+								},
+							}},
+						},
+						TransactionIndex:  1,
+						TransactionResult: "tesSUCCESS",
+						DeliveredAmount:   "100000000",
+					},
+					Validated: true,
+				},
+			},
+		},
+		{
+			name: "pass - JSON mode is left as is",
+			response: TransactionsResponse{
+				Transactions: []Transaction{
+					{Meta: transaction.TxObjMeta{TransactionResult: "tesSUCCESS"}},
+				},
+			},
+			expected: []Transaction{
+				{Meta: transaction.TxObjMeta{TransactionResult: "tesSUCCESS"}},
+			},
+		},
+		{
+			name: "fail - invalid meta blob",
+			response: TransactionsResponse{
+				Transactions: []Transaction{{MetaBlob: "ZZ"}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.response.DecodeBinary()
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, tt.response.Transactions)
+		})
+	}
+}
+
+const (
+	binaryTxBlob   = "12000022800000002400000001614000000005F5E10068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100A7CCD1B5F67D76C7F2C0B6C199C9D2F3721A14A3C69F3CB134E9BF9D9DD6F8B002206A5F974C4F4D07B4F5A99391BF3E93D9B0A7346861E12E924B6451B6D8ED4F0981144B4E9C06F24296074F7BC48F92A97916C6DC5EA983143E9D4A2B8AA0780F682D136F7A56D6724EF53754"
+	binaryMetaBlob = "201C00000001F8E5110061250447111C552E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF5613F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8E6240000000162400000004190AB00E1E7220000000024000000022D0000000062400000003B9AC9F481144B4E9C06F24296074F7BC48F92A97916C6DC5EA9E1E1E3110061564E1A8C6E7E4E9E6F0C8B7D3E2A1F0B9C8D7E6F5A4B3C2D1E0F9A8B7C6D5E4F3AE82404471147624000000005F5E10081143E9D4A2B8AA0780F682D136F7A56D6724EF53754E1E1F1031000"
+)
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// Special values of CanDeleteRequest.CanDelete.
+const (
+	// CanDeleteNever disables online deletion.
+	CanDeleteNever = "never"
+	// CanDeleteAlways allows online deletion of any ledger older than the configured history.
+	CanDeleteAlways = "always"
+	// CanDeleteNow allows online deletion of the ledgers up to the current validated ledger.
+	CanDeleteNow = "now"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// CanDeleteRequest informs the server of the latest ledger that may be deleted
+// by online deletion, when advisory deletion is enabled. CanDelete is a ledger
+// index, a ledger hash or one of CanDeleteNever, CanDeleteAlways and CanDeleteNow.
+// If it is empty, the current setting is returned.
+type CanDeleteRequest struct {
+	common.BaseRequest
+	CanDelete string `json:"can_delete,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for CanDeleteRequest.
+func (*CanDeleteRequest) Method() string {
+	return "can_delete"
+}
+
+// APIVersion returns the XRPL API version for CanDeleteRequest.
+func (*CanDeleteRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the CanDeleteRequest is valid.
+func (*CanDeleteRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// CanDeleteResponse is the expected response from the can_delete method.
+type CanDeleteResponse struct {
+	CanDelete uint32 `json:"can_delete"`
+}
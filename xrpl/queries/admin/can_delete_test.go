@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+)
+
+func TestCanDeleteRequest(t *testing.T) {
+	s := CanDeleteRequest{
+		CanDelete: CanDeleteNow,
+	}
+
+	j := `{
+	"can_delete": "now"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCanDeleteResponse(t *testing.T) {
+	s := CanDeleteResponse{
+		CanDelete: 54321,
+	}
+
+	j := `{
+	"can_delete": 54321
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
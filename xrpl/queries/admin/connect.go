@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// ConnectRequest forces the server to connect to a specific peer.
+// If Port is zero, the server uses the default peer port.
+type ConnectRequest struct {
+	common.BaseRequest
+	IP   string `json:"ip"`
+	Port uint16 `json:"port,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for ConnectRequest.
+func (*ConnectRequest) Method() string {
+	return "connect"
+}
+
+// APIVersion returns the XRPL API version for ConnectRequest.
+func (*ConnectRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the ConnectRequest has an IP address.
+func (r *ConnectRequest) Validate() error {
+	if r.IP == "" {
+		return ErrNoIP
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// ConnectResponse is the expected response from the connect method.
+type ConnectResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectRequest(t *testing.T) {
+	s := ConnectRequest{
+		IP:   "192.170.145.88",
+		Port: 51235,
+	}
+
+	j := `{
+	"ip": "192.170.145.88",
+	"port": 51235
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+
+	require.ErrorIs(t, (&ConnectRequest{}).Validate(), ErrNoIP)
+}
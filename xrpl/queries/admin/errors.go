@@ -0,0 +1,20 @@
+package admin
+
+import "errors"
+
+var (
+	// ErrNoTxJSON is returned when no transaction is specified in a sign or sign_for request.
+	ErrNoTxJSON = errors.New("no transaction JSON specified")
+	// ErrNoSigningSecret is returned when no secret, seed, seed hex or passphrase is specified in a sign or sign_for request.
+	ErrNoSigningSecret = errors.New("no signing secret specified")
+	// ErrMultipleSecrets is returned when more than one of secret, seed, seed hex and passphrase is specified.
+	ErrMultipleSecrets = errors.New("only one of secret, seed, seed_hex and passphrase can be specified")
+	// ErrKeyTypeWithSecret is returned when a key type is specified along with a secret.
+	ErrKeyTypeWithSecret = errors.New("key_type cannot be specified with secret")
+	// ErrNoAccount is returned when no account is specified in a sign_for request.
+	ErrNoAccount = errors.New("no account specified")
+	// ErrNoIP is returned when no IP address is specified in a connect request.
+	ErrNoIP = errors.New("no IP address specified")
+	// ErrPartitionWithoutSeverity is returned when a log partition is specified without a severity.
+	ErrPartitionWithoutSeverity = errors.New("partition cannot be specified without severity")
+)
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	admintypes "github.com/Peersyst/xrpl-go/xrpl/queries/admin/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// FetchInfoRequest returns information about the objects the server is
+// currently fetching from the network. If Clear is true, the fetch progress
+// is reset.
+type FetchInfoRequest struct {
+	common.BaseRequest
+	Clear bool `json:"clear,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for FetchInfoRequest.
+func (*FetchInfoRequest) Method() string {
+	return "fetch_info"
+}
+
+// APIVersion returns the XRPL API version for FetchInfoRequest.
+func (*FetchInfoRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the FetchInfoRequest is valid.
+func (*FetchInfoRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// FetchInfoResponse is the expected response from the fetch_info method.
+// Info is keyed by the sequence number of each ledger being fetched.
+type FetchInfoResponse struct {
+	Info map[string]admintypes.FetchInfo `json:"info"`
+}
@@ -0,0 +1,43 @@
+// Package admin provides commands to query XRPL admin methods.
+// These methods require an admin connection to rippled, and some of them,
+// such as ledger_accept, are only available in stand-alone mode.
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// LedgerAcceptRequest forces the server to close the current open ledger and
+// move to the next ledger number. It is only available in stand-alone mode.
+type LedgerAcceptRequest struct {
+	common.BaseRequest
+}
+
+// Method returns the XRPL JSON-RPC method name for LedgerAcceptRequest.
+func (*LedgerAcceptRequest) Method() string {
+	return "ledger_accept"
+}
+
+// APIVersion returns the XRPL API version for LedgerAcceptRequest.
+func (*LedgerAcceptRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the LedgerAcceptRequest is valid.
+func (*LedgerAcceptRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// LedgerAcceptResponse is the expected response from the ledger_accept method.
+type LedgerAcceptResponse struct {
+	LedgerCurrentIndex common.LedgerIndex `json:"ledger_current_index"`
+}
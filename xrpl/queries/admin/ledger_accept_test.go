@@ -0,0 +1,21 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+)
+
+func TestLedgerAcceptResponse(t *testing.T) {
+	s := LedgerAcceptResponse{
+		LedgerCurrentIndex: 6643099,
+	}
+
+	j := `{
+	"ledger_current_index": 6643099
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
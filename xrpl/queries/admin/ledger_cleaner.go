@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// LedgerCleanerRequest tells the ledger cleaner to check the ledgers stored by
+// the server, fixing or re-acquiring the corrupted ones.
+type LedgerCleanerRequest struct {
+	common.BaseRequest
+	Ledger     uint32 `json:"ledger,omitempty"`
+	MaxLedger  uint32 `json:"max_ledger,omitempty"`
+	MinLedger  uint32 `json:"min_ledger,omitempty"`
+	Full       bool   `json:"full,omitempty"`
+	FixTxns    bool   `json:"fix_txns,omitempty"`
+	CheckNodes bool   `json:"check_nodes,omitempty"`
+	Stop       bool   `json:"stop,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for LedgerCleanerRequest.
+func (*LedgerCleanerRequest) Method() string {
+	return "ledger_cleaner"
+}
+
+// APIVersion returns the XRPL API version for LedgerCleanerRequest.
+func (*LedgerCleanerRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the LedgerCleanerRequest is valid.
+func (*LedgerCleanerRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// LedgerCleanerResponse is the expected response from the ledger_cleaner method.
+type LedgerCleanerResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// LogLevelRequest changes the log verbosity of the server when Severity is set,
+// or returns the current log levels otherwise. If Partition is set, only the
+// level of that partition is changed.
+type LogLevelRequest struct {
+	common.BaseRequest
+	Severity  string `json:"severity,omitempty"`
+	Partition string `json:"partition,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for LogLevelRequest.
+func (*LogLevelRequest) Method() string {
+	return "log_level"
+}
+
+// APIVersion returns the XRPL API version for LogLevelRequest.
+func (*LogLevelRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures a Partition is not specified without a Severity.
+func (r *LogLevelRequest) Validate() error {
+	if r.Partition != "" && r.Severity == "" {
+		return ErrPartitionWithoutSeverity
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// LogLevelResponse is the expected response from the log_level method.
+// Levels is keyed by partition, with "base" holding the default level.
+// It is empty when the request changes a log level.
+type LogLevelResponse struct {
+	Levels map[string]string `json:"levels,omitempty"`
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevelRequest_Validate(t *testing.T) {
+	require.NoError(t, (&LogLevelRequest{}).Validate())
+	require.NoError(t, (&LogLevelRequest{Severity: "debug", Partition: "PeerFinder"}).Validate())
+	require.ErrorIs(t, (&LogLevelRequest{Partition: "PeerFinder"}).Validate(), ErrPartitionWithoutSeverity)
+}
+
+func TestLogLevelResponse(t *testing.T) {
+	s := LogLevelResponse{
+		Levels: map[string]string{
+			"base":       "Info",
+			"PeerFinder": "Debug",
+		},
+	}
+
+	j := `{
+	"levels": {
+		"PeerFinder": "Debug",
+		"base": "Info"
+	}
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
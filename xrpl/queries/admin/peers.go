@@ -0,0 +1,43 @@
+package admin
+
+import (
+	admintypes "github.com/Peersyst/xrpl-go/xrpl/queries/admin/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// PeersRequest returns information about all the other servers currently
+// connected to this server, and about the members of its cluster.
+type PeersRequest struct {
+	common.BaseRequest
+}
+
+// Method returns the XRPL JSON-RPC method name for PeersRequest.
+func (*PeersRequest) Method() string {
+	return "peers"
+}
+
+// APIVersion returns the XRPL API version for PeersRequest.
+func (*PeersRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the PeersRequest is valid.
+func (*PeersRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// PeersResponse is the expected response from the peers method.
+// Cluster is keyed by the public key of each cluster member.
+type PeersResponse struct {
+	Cluster map[string]admintypes.ClusterNode `json:"cluster,omitempty"`
+	Peers   []admintypes.Peer                 `json:"peers"`
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"testing"
+
+	admintypes "github.com/Peersyst/xrpl-go/xrpl/queries/admin/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+)
+
+func TestPeersResponse(t *testing.T) {
+	s := PeersResponse{
+		Cluster: map[string]admintypes.ClusterNode{
+			"n9KUjqxCr5FKThSNXdzb7oqN8rYwScB2dUnNqxQxbEA17JkaWy5x": {
+				Tag: "rippled-1",
+				Age: 2,
+			},
+		},
+		Peers: []admintypes.Peer{
+			{
+				Address:         "10.0.0.2:51235",
+				CompleteLedgers: "32570 - 6643099",
+				Latency:         48,
+				Ledger:          "1D3A8A2DB2F9D44F1E3D5A7A0F5B3F6F4E2D6C7B8A9F0E1D2C3B4A5F6E7D8C9B",
+				Load:            16,
+				PublicKey:       "n9KUjqxCr5FKThSNXdzb7oqN8rYwScB2dUnNqxQxbEA17JkaWy5x",
+				Uptime:          1240,
+				Version:         "rippled-2.3.0",
+			},
+		},
+	}
+
+	j := `{
+	"cluster": {
+		"n9KUjqxCr5FKThSNXdzb7oqN8rYwScB2dUnNqxQxbEA17JkaWy5x": {
+			"tag": "rippled-1",
+			"age": 2
+		}
+	},
+	"peers": [
+		{
+			"address": "10.0.0.2:51235",
+			"complete_ledgers": "32570 - 6643099",
+			"latency": 48,
+			"ledger": "1D3A8A2DB2F9D44F1E3D5A7A0F5B3F6F4E2D6C7B8A9F0E1D2C3B4A5F6E7D8C9B",
+			"load": 16,
+			"public_key": "n9KUjqxCr5FKThSNXdzb7oqN8rYwScB2dUnNqxQxbEA17JkaWy5x",
+			"uptime": 1240,
+			"version": "rippled-2.3.0"
+		}
+	]
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
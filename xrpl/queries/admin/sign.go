@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// SignRequest signs a transaction in JSON format with the given secret and
+// returns the signed binary blob. The server must allow signing, either
+// because the request is made with admin permissions or because signing
+// support is enabled in its configuration.
+type SignRequest struct {
+	common.BaseRequest
+	TxJSON     transaction.FlatTransaction `json:"tx_json"`
+	Secret     string                      `json:"secret,omitempty"`
+	Seed       string                      `json:"seed,omitempty"`
+	SeedHex    string                      `json:"seed_hex,omitempty"`
+	Passphrase string                      `json:"passphrase,omitempty"`
+	KeyType    string                      `json:"key_type,omitempty"`
+	Offline    bool                        `json:"offline,omitempty"`
+	BuildPath  bool                        `json:"build_path,omitempty"`
+	FeeMultMax uint32                      `json:"fee_mult_max,omitempty"`
+	FeeDivMax  uint32                      `json:"fee_div_max,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for SignRequest.
+func (*SignRequest) Method() string {
+	return "sign"
+}
+
+// APIVersion returns the XRPL API version for SignRequest.
+func (*SignRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the SignRequest has a transaction and exactly one signing secret.
+func (r *SignRequest) Validate() error {
+	if len(r.TxJSON) == 0 {
+		return ErrNoTxJSON
+	}
+	return validateSigningSecret(r.Secret, r.Seed, r.SeedHex, r.Passphrase, r.KeyType)
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// SignResponse is the expected response from the sign method.
+type SignResponse struct {
+	TxBlob string                      `json:"tx_blob"`
+	TxJSON transaction.FlatTransaction `json:"tx_json"`
+}
+
+// validateSigningSecret checks that exactly one of the signing secrets is specified,
+// and that the key type is not specified along with a secret.
+func validateSigningSecret(secret, seed, seedHex, passphrase, keyType string) error {
+	switch countSet(secret, seed, seedHex, passphrase) {
+	case 0:
+		return ErrNoSigningSecret
+	case 1:
+	default:
+		return ErrMultipleSecrets
+	}
+	if secret != "" && keyType != "" {
+		return ErrKeyTypeWithSecret
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// SignForRequest provides one signature for a multi-signed transaction.
+// The returned transaction includes the signature in its Signers field.
+type SignForRequest struct {
+	common.BaseRequest
+	Account    types.Address               `json:"account"`
+	TxJSON     transaction.FlatTransaction `json:"tx_json"`
+	Secret     string                      `json:"secret,omitempty"`
+	Seed       string                      `json:"seed,omitempty"`
+	SeedHex    string                      `json:"seed_hex,omitempty"`
+	Passphrase string                      `json:"passphrase,omitempty"`
+	KeyType    string                      `json:"key_type,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for SignForRequest.
+func (*SignForRequest) Method() string {
+	return "sign_for"
+}
+
+// APIVersion returns the XRPL API version for SignForRequest.
+func (*SignForRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the SignForRequest has an account, a transaction and exactly one signing secret.
+func (r *SignForRequest) Validate() error {
+	if r.Account == "" {
+		return ErrNoAccount
+	}
+	if len(r.TxJSON) == 0 {
+		return ErrNoTxJSON
+	}
+	return validateSigningSecret(r.Secret, r.Seed, r.SeedHex, r.Passphrase, r.KeyType)
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// SignForResponse is the expected response from the sign_for method.
+type SignForResponse struct {
+	TxBlob string                      `json:"tx_blob"`
+	TxJSON transaction.FlatTransaction `json:"tx_json"`
+}
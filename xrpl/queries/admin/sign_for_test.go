@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignForRequest_Validate(t *testing.T) {
+	tx := transaction.FlatTransaction{"TransactionType": "Payment"}
+
+	tests := []struct {
+		name     string
+		req      SignForRequest
+		expected error
+	}{
+		{
+			name: "pass",
+			req:  SignForRequest{Account: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", TxJSON: tx, Secret: "secret"},
+		},
+		{
+			name:     "fail - no account",
+			req:      SignForRequest{TxJSON: tx, Secret: "secret"},
+			expected: ErrNoAccount,
+		},
+		{
+			name:     "fail - no tx_json",
+			req:      SignForRequest{Account: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", Secret: "secret"},
+			expected: ErrNoTxJSON,
+		},
+		{
+			name:     "fail - no secret",
+			req:      SignForRequest{Account: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", TxJSON: tx},
+			expected: ErrNoSigningSecret,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignRequest(t *testing.T) {
+	s := SignRequest{
+		TxJSON: transaction.FlatTransaction{
+			"TransactionType": "Payment",
+			"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			"Destination":     "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+			"Amount":          "1000",
+		},
+		Secret:  "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+		Offline: true,
+	}
+
+	j := `{
+	"tx_json": {
+		"Account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+		"Amount": "1000",
+		"Destination": "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+		"TransactionType": "Payment"
+	},
+	"secret": "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+	"offline": true
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSignRequest_Validate(t *testing.T) {
+	tx := transaction.FlatTransaction{"TransactionType": "Payment"}
+
+	tests := []struct {
+		name     string
+		req      SignRequest
+		expected error
+	}{
+		{
+			name: "pass - secret",
+			req:  SignRequest{TxJSON: tx, Secret: "secret"},
+		},
+		{
+			name: "pass - seed with key type",
+			req:  SignRequest{TxJSON: tx, Seed: "seed", KeyType: "ed25519"},
+		},
+		{
+			name:     "fail - no tx_json",
+			req:      SignRequest{Secret: "secret"},
+			expected: ErrNoTxJSON,
+		},
+		{
+			name:     "fail - no secret",
+			req:      SignRequest{TxJSON: tx},
+			expected: ErrNoSigningSecret,
+		},
+		{
+			name:     "fail - secret and seed",
+			req:      SignRequest{TxJSON: tx, Secret: "secret", Seed: "seed"},
+			expected: ErrMultipleSecrets,
+		},
+		{
+			name:     "fail - secret with key type",
+			req:      SignRequest{TxJSON: tx, Secret: "secret", KeyType: "ed25519"},
+			expected: ErrKeyTypeWithSecret,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// StopRequest gracefully shuts down the server.
+type StopRequest struct {
+	common.BaseRequest
+}
+
+// Method returns the XRPL JSON-RPC method name for StopRequest.
+func (*StopRequest) Method() string {
+	return "stop"
+}
+
+// APIVersion returns the XRPL API version for StopRequest.
+func (*StopRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the StopRequest is valid.
+func (*StopRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// StopResponse is the expected response from the stop method.
+type StopResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,13 @@
+package types
+
+// FetchInfo describes the progress of the server in fetching a ledger, as returned by the fetch_info method.
+type FetchInfo struct {
+	Hash                    string   `json:"hash"`
+	HaveHeader              bool     `json:"have_header"`
+	HaveState               bool     `json:"have_state,omitempty"`
+	HaveTransactions        bool     `json:"have_transactions,omitempty"`
+	NeededStateHashes       []string `json:"needed_state_hashes,omitempty"`
+	NeededTransactionHashes []string `json:"needed_transaction_hashes,omitempty"`
+	Peers                   uint     `json:"peers"`
+	Timeouts                uint     `json:"timeouts"`
+}
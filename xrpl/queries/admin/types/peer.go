@@ -0,0 +1,27 @@
+// Package types contains data structures for XRPL admin query types.
+package types
+
+// Peer describes a peer connected to the server, as returned by the peers method.
+type Peer struct {
+	Address         string `json:"address"`
+	Cluster         bool   `json:"cluster,omitempty"`
+	Name            string `json:"name,omitempty"`
+	CompleteLedgers string `json:"complete_ledgers,omitempty"`
+	Inbound         bool   `json:"inbound,omitempty"`
+	Latency         uint   `json:"latency,omitempty"`
+	Ledger          string `json:"ledger,omitempty"`
+	Load            uint   `json:"load,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	PublicKey       string `json:"public_key,omitempty"`
+	Sanity          string `json:"sanity,omitempty"`
+	Status          string `json:"status,omitempty"`
+	Uptime          uint   `json:"uptime,omitempty"`
+	Version         string `json:"version,omitempty"`
+}
+
+// ClusterNode describes a member of the server's cluster, as returned by the peers method.
+type ClusterNode struct {
+	Tag string `json:"tag,omitempty"`
+	Fee uint   `json:"fee,omitempty"`
+	Age uint   `json:"age,omitempty"`
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// ValidationCreateRequest generates the keys a rippled server can use to
+// sign validations. If no secret is specified, a random seed is generated.
+type ValidationCreateRequest struct {
+	common.BaseRequest
+	Secret string `json:"secret,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for ValidationCreateRequest.
+func (*ValidationCreateRequest) Method() string {
+	return "validation_create"
+}
+
+// APIVersion returns the XRPL API version for ValidationCreateRequest.
+func (*ValidationCreateRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures the ValidationCreateRequest is valid.
+func (*ValidationCreateRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// ValidationCreateResponse is the expected response from the validation_create method.
+type ValidationCreateResponse struct {
+	ValidationKey        string `json:"validation_key"`
+	ValidationPrivateKey string `json:"validation_private_key"`
+	ValidationPublicKey  string `json:"validation_public_key"`
+	ValidationSeed       string `json:"validation_seed"`
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// WalletProposeRequest creates a key pair and derives the address of an account.
+// If no seed, seed hex or passphrase is specified, a random seed is generated.
+type WalletProposeRequest struct {
+	common.BaseRequest
+	KeyType    string `json:"key_type,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+	Seed       string `json:"seed,omitempty"`
+	SeedHex    string `json:"seed_hex,omitempty"`
+}
+
+// Method returns the XRPL JSON-RPC method name for WalletProposeRequest.
+func (*WalletProposeRequest) Method() string {
+	return "wallet_propose"
+}
+
+// APIVersion returns the XRPL API version for WalletProposeRequest.
+func (*WalletProposeRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate ensures that at most one of Passphrase, Seed and SeedHex is specified.
+func (r *WalletProposeRequest) Validate() error {
+	if countSet(r.Passphrase, r.Seed, r.SeedHex) > 1 {
+		return ErrMultipleSecrets
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// WalletProposeResponse is the expected response from the wallet_propose method.
+type WalletProposeResponse struct {
+	AccountID     string `json:"account_id"`
+	KeyType       string `json:"key_type"`
+	MasterKey     string `json:"master_key"`
+	MasterSeed    string `json:"master_seed"`
+	MasterSeedHex string `json:"master_seed_hex"`
+	PublicKey     string `json:"public_key"`
+	PublicKeyHex  string `json:"public_key_hex"`
+	Warning       string `json:"warning,omitempty"`
+}
+
+// countSet returns the number of non-empty values.
+func countSet(values ...string) int {
+	n := 0
+	for _, v := range values {
+		if v != "" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletProposeRequest(t *testing.T) {
+	s := WalletProposeRequest{
+		KeyType:    "secp256k1",
+		Passphrase: "masterpassphrase",
+	}
+
+	j := `{
+	"key_type": "secp256k1",
+	"passphrase": "masterpassphrase"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWalletProposeRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      WalletProposeRequest
+		expected error
+	}{
+		{
+			name: "pass - random seed",
+			req:  WalletProposeRequest{},
+		},
+		{
+			name: "pass - seed",
+			req:  WalletProposeRequest{Seed: "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"},
+		},
+		{
+			name:     "fail - seed and passphrase",
+			req:      WalletProposeRequest{Seed: "snoPBrXtMeMyMHUVTgbuqAfg1SUTb", Passphrase: "masterpassphrase"},
+			expected: ErrMultipleSecrets,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestWalletProposeResponse(t *testing.T) {
+	s := WalletProposeResponse{
+		AccountID:     "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		KeyType:       "secp256k1",
+		MasterKey:     "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+		MasterSeed:    "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+		MasterSeedHex: "DEDCE9CE67B451D852FD4E846FCDE31C",
+		PublicKey:     "aBQG8RQAzjs1eTKFEAQXr2gS4utcDiEC9wmi7pfUPTi27VCahwgw",
+		PublicKeyHex:  "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+	}
+
+	j := `{
+	"account_id": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+	"key_type": "secp256k1",
+	"master_key": "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+	"master_seed": "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+	"master_seed_hex": "DEDCE9CE67B451D852FD4E846FCDE31C",
+	"public_key": "aBQG8RQAzjs1eTKFEAQXr2gS4utcDiEC9wmi7pfUPTi27VCahwgw",
+	"public_key_hex": "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
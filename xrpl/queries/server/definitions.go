@@ -0,0 +1,55 @@
+package server
+
+import (
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// DefinitionsRequest is the request type for the server_definitions command.
+// It returns the binary serialization definitions of the server, which may
+// differ from the ones embedded in the binary codec when the server runs
+// unreleased amendments or a sidechain.
+type DefinitionsRequest struct {
+	common.BaseRequest
+	// Hash of the definitions known by the client. If it matches the hash of
+	// the server definitions, only the hash is returned.
+	Hash string `json:"hash,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for the DefinitionsRequest.
+func (*DefinitionsRequest) Method() string {
+	return "server_definitions"
+}
+
+// APIVersion returns the API version required by the DefinitionsRequest.
+func (*DefinitionsRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate verifies the DefinitionsRequest parameters.
+func (*DefinitionsRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// DefinitionsResponse is the response type returned by the server_definitions command.
+// It contains the definitions document and its hash.
+type DefinitionsResponse struct {
+	definitions.Document
+	Hash string `json:"hash"`
+}
+
+// Definitions returns the binary codec definitions of the response, which can be used
+// to create a binarycodec.Codec. It returns an error if the response only contains the
+// hash of the definitions.
+func (r *DefinitionsResponse) Definitions() (*definitions.Definitions, error) {
+	return definitions.New(r.Document)
+}
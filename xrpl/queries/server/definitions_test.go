@@ -0,0 +1,106 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionsRequest(t *testing.T) {
+	s := DefinitionsRequest{
+		Hash: "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+	}
+
+	j := `{
+	"hash": "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E"
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDefinitionsResponse(t *testing.T) {
+	s := DefinitionsResponse{
+		Document: definitions.Document{
+			Types: map[string]int32{
+				"UInt16": 1,
+				"UInt32": 2,
+			},
+			LedgerEntryTypes: map[string]int32{
+				"AccountRoot": 97,
+			},
+			Fields: []definitions.FieldDefinition{
+				{
+					Name: "TransactionType",
+					Info: definitions.FieldInfo{Nth: 2, IsSerialized: true, IsSigningField: true, Type: "UInt16"},
+				},
+				{
+					Name: "Sequence",
+					Info: definitions.FieldInfo{Nth: 4, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+				},
+			},
+			TransactionResults: map[string]int32{
+				"tesSUCCESS": 0,
+			},
+			TransactionTypes: map[string]int32{
+				"Payment": 0,
+			},
+		},
+		Hash: "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+	}
+
+	j := `{
+	"TYPES": {
+		"UInt16": 1,
+		"UInt32": 2
+	},
+	"LEDGER_ENTRY_TYPES": {
+		"AccountRoot": 97
+	},
+	"FIELDS": [
+		[
+			"TransactionType",
+			{
+				"nth": 2,
+				"isVLEncoded": false,
+				"isSerialized": true,
+				"isSigningField": true,
+				"type": "UInt16"
+			}
+		],
+		[
+			"Sequence",
+			{
+				"nth": 4,
+				"isVLEncoded": false,
+				"isSerialized": true,
+				"isSigningField": true,
+				"type": "UInt32"
+			}
+		]
+	],
+	"TRANSACTION_RESULTS": {
+		"tesSUCCESS": 0
+	},
+	"TRANSACTION_TYPES": {
+		"Payment": 0
+	},
+	"hash": "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E"
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+
+	defs, err := s.Definitions()
+	require.NoError(t, err)
+	fh, err := defs.GetFieldHeaderByFieldName("Sequence")
+	require.NoError(t, err)
+	require.Equal(t, &definitions.FieldHeader{TypeCode: 2, FieldCode: 4}, fh)
+
+	_, err = (&DefinitionsResponse{Hash: s.Hash}).Definitions()
+	require.ErrorIs(t, err, definitions.ErrEmptyDefinitions)
+}
@@ -16,6 +16,8 @@ type ValidationStream struct {
 	// (May be omitted) The unscaled transaction cost (reference_fee value) this server
 	// wants to set by Fee Voting.
 	BaseFee int `json:"base_fee,omitempty"`
+	// (May be omitted) The serialized validation message, including its signature, in hex.
+	Data string `json:"data,omitempty"`
 	// (May be omitted) An arbitrary value chosen by the server at startup. If the same
 	// validation key pair signs validations with different cookies concurrently, that
 	// usually indicates that multiple servers are incorrectly configured to use the same
@@ -41,6 +43,8 @@ type ValidationStream struct {
 	// (May be omitted) The validator's master public key, if the validator is using a validator
 	// token, in the XRP Ledger's base58 format. (See also: Enable Validation on your rippled Server.)
 	MasterKey string `json:"master_key,omitempty"`
+	// (May be omitted) The network ID of the network the validator is validating.
+	NetworkID uint32 `json:"network_id,omitempty"`
 	// (May be omitted) The minimum reserve requirement (account_reserve value) this validator wants
 	// to set by Fee Voting.
 	ReserveBase int `json:"reserve_base,omitempty"`
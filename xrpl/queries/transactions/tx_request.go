@@ -1,6 +1,7 @@
 package transactions
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -43,6 +44,8 @@ func (*TxRequest) Validate() error {
 
 // TxResponse is the response type returned by the tx command.
 // It includes transaction details, metadata, and validation status.
+// In binary mode, the transaction and its metadata are returned as TxBlob and MetaBlob,
+// which DecodeBinary decodes into TxJSON and Meta.
 type TxResponse struct {
 	Date        uint                          `json:"date"`
 	Hash        types.Hash256                 `json:"hash"`
@@ -50,4 +53,29 @@ type TxResponse struct {
 	Meta        transaction.TxMetadataBuilder `json:"meta"`
 	Validated   bool                          `json:"validated"`
 	TxJSON      transaction.FlatTransaction   `json:"tx_json,omitempty"`
+	TxBlob      string                        `json:"tx_blob,omitempty"`
+	MetaBlob    string                        `json:"meta_blob,omitempty"`
+}
+
+// DecodeBinary decodes the TxBlob and MetaBlob of a binary mode response into TxJSON and Meta.
+// Blobs that are empty are left undecoded, so it is a no-op for JSON mode responses.
+func (r *TxResponse) DecodeBinary() error {
+	if r.TxBlob != "" {
+		tx, err := binarycodec.Decode(r.TxBlob)
+		if err != nil {
+			return err
+		}
+		r.TxJSON = tx
+	}
+	if r.MetaBlob != "" {
+		meta, err := binarycodec.Decode(r.MetaBlob)
+		if err != nil {
+			return err
+		}
+		r.Meta, err = transaction.NewTxMetadataBuilder(meta, r.TxJSON)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/mitchellh/mapstructure"
+)
+
+// batchMethod is the method of the rippled request that wraps the requests of a batch.
+const batchMethod = "batch"
+
+// batchRequest is the request of a chunk of a batch: rippled takes the requests of the batch as the params of
+// a request of the batch method, and replies with an array of responses, in the same order. It is the
+// xrpl.Request the interceptors see for the chunk.
+type batchRequest struct {
+	requests []Request
+}
+
+// Method returns the batch method.
+func (r *batchRequest) Method() string {
+	return batchMethod
+}
+
+// APIVersion returns the default API version. Every request of the batch has its own version.
+func (r *batchRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate does nothing, as the requests of the batch are validated before being added to it.
+func (r *batchRequest) Validate() error {
+	return nil
+}
+
+// MarshalJSON encodes the body of the batch request.
+func (r *batchRequest) MarshalJSON() ([]byte, error) {
+	return jsoniter.Marshal(struct {
+		Method string    `json:"method"`
+		Params []Request `json:"params"`
+	}{
+		Method: batchMethod,
+		Params: r.requests,
+	})
+}
+
+// batchResponse is the response of a chunk of a batch: the responses of its requests, in the order of the reply.
+type batchResponse []Response
+
+// GetResult decodes the results of the responses into v, a slice, using mapstructure.
+func (r batchResponse) GetResult(v any) error {
+	results := make([]AnyJSON, len(r))
+	for i, jr := range r {
+		results[i] = jr.Result
+	}
+
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json",
+		Result: v, DecodeHook: mapstructure.TextUnmarshallerHookFunc()})
+	if err != nil {
+		return err
+	}
+	return dec.Decode(results)
+}
+
+// BatchResponse holds the outcome of a single request sent as part of a batch.
+// Exactly one of Response and Err is set.
+type BatchResponse struct {
+	Response XRPLResponse
+	Err      error
+}
+
+// RequestBatch sends the given requests to the XRPL server as JSON-RPC batches and
+// returns one BatchResponse per request, in the same order as the requests.
+// Requests are sent in chunks of at most the configured batch size (see WithBatchSize),
+// one HTTP POST of a "batch" request per chunk. Responses are correlated with their requests
+// by id or, for responses without id, by their position in the reply.
+// Validation and server errors are reported per request in BatchResponse.Err, while
+// transport errors abort the whole batch and are returned as the error.
+// Each chunk goes through the configured interceptors, if any, as a single request of the "batch" method.
+func (c *Client) RequestBatch(reqs ...XRPLRequest) ([]BatchResponse, error) {
+	results := make([]BatchResponse, len(reqs))
+
+	batchSize := c.cfg.batchSize
+	if batchSize <= 0 {
+		batchSize = len(reqs)
+	}
+
+	for start := 0; start < len(reqs); start += batchSize {
+		end := min(start+batchSize, len(reqs))
+
+		err := c.sendBatch(reqs[start:end], start, results[start:end])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// sendBatch sends a single chunk of requests and fills in its results.
+// The id of each request is its index in the whole batch, plus one, so ids are never zero.
+func (c *Client) sendBatch(reqs []XRPLRequest, offset int, results []BatchResponse) error {
+	batch := &batchRequest{requests: make([]Request, 0, len(reqs))}
+	pending := make(map[int]int, len(reqs))
+
+	for i, req := range reqs {
+		if err := req.Validate(); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		id := offset + i + 1
+		r, err := buildRequest(req, id)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		batch.requests = append(batch.requests, r)
+		pending[id] = i
+	}
+
+	if len(batch.requests) == 0 {
+		return nil
+	}
+
+	var res xrpl.Response
+	var err error
+	if len(c.cfg.interceptors) == 0 {
+		res, err = c.invokeBatch(batch)
+	} else {
+		res, err = xrpl.Intercept(batch, c.invokeBatch, c.cfg.interceptors...)
+	}
+	if err != nil {
+		return err
+	}
+	responses, ok := res.(batchResponse)
+	if !ok {
+		return ErrUnsupportedBatchResponse
+	}
+
+	for k, jr := range responses {
+		if jr.ID == 0 && k < len(batch.requests) {
+			jr.ID = batch.requests[k].ID
+		}
+		i, ok := pending[jr.ID]
+		if !ok {
+			return ErrUnexpectedBatchResponseID{ID: jr.ID}
+		}
+		delete(pending, jr.ID)
+
+		if err := resultError(jr); err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].Response = &jr
+	}
+
+	for id, i := range pending {
+		results[i].Err = ErrMissingBatchResponse{ID: id}
+	}
+
+	return nil
+}
+
+// invokeBatch is the xrpl.Invoker that sends the request of a chunk of a batch once it went through the
+// interceptors.
+func (c *Client) invokeBatch(req xrpl.Request) (xrpl.Response, error) {
+	batch, ok := req.(*batchRequest)
+	if !ok {
+		return nil, ErrUnsupportedRequest
+	}
+
+	body, err := jsoniter.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.post(context.Background(), body)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrEmptyBatchResponse
+	}
+
+	responses, err := checkForBatchError(response)
+	if err != nil {
+		return nil, err
+	}
+	return batchResponse(responses), nil
+}
+
+// checkForBatchError reads the http response of a batch request and decodes the list of responses.
+// A single response, which rippled replies when it rejects the whole batch, is returned as an error.
+func checkForBatchError(res *http.Response) ([]Response, error) {
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// In case a different error code is returned
+	if res.StatusCode != 200 {
+		return nil, &ClientError{ErrorString: string(b)}
+	}
+
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		var jr Response
+		if err := decodeBatchResponse(trimmed, &jr); err != nil {
+			return nil, err
+		}
+		if err := resultError(jr); err != nil {
+			return nil, err
+		}
+		return nil, &ClientError{ErrorString: string(b)}
+	}
+
+	var responses []Response
+	if err := decodeBatchResponse(b, &responses); err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// decodeBatchResponse decodes the body of a batch response, keeping numbers as json.Number.
+func decodeBatchResponse(b []byte, v any) error {
+	jDec := json.NewDecoder(bytes.NewReader(b))
+	jDec.UseNumber()
+	return jDec.Decode(v)
+}
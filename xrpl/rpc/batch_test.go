@@ -0,0 +1,202 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+// batchEntry is a request of a batch, as decoded by the mock server.
+type batchEntry struct {
+	Method string           `json:"method"`
+	Params []map[string]any `json:"params"`
+	ID     int              `json:"id"`
+}
+
+// batchServer answers batch requests as rippled does: the body must be a request object of the batch
+// method, with the requests of the batch as params, and the reply is the array of responses of reply.
+func batchServer(t *testing.T, posts *int, reply func(batch []batchEntry) []map[string]any) func(req *http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		*posts++
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		var envelope struct {
+			Method string       `json:"method"`
+			Params []batchEntry `json:"params"`
+		}
+		if err := json.Unmarshal(body, &envelope); err != nil {
+			return testutil.MockResponse("Unable to parse request: "+err.Error(), 400, &testutil.JSONRPCMockClient{})(req)
+		}
+		require.Equal(t, "batch", envelope.Method)
+
+		resBody, err := json.Marshal(reply(envelope.Params))
+		require.NoError(t, err)
+		return testutil.MockResponse(string(resBody), 200, &testutil.JSONRPCMockClient{})(req)
+	}
+}
+
+// batchEchoResponse answers every request of a batch with its account, in reverse order.
+func batchEchoResponse(t *testing.T, posts *int, skip map[string]bool) func(req *http.Request) (*http.Response, error) {
+	return batchServer(t, posts, func(batch []batchEntry) []map[string]any {
+		responses := make([]map[string]any, 0, len(batch))
+		for i := len(batch) - 1; i >= 0; i-- {
+			acc := batch[i].Params[0]["account"].(string)
+			if skip[acc] {
+				continue
+			}
+			result := map[string]any{"account": acc}
+			if acc == "rError" {
+				result = map[string]any{"error": "actNotFound"}
+			}
+			responses = append(responses, map[string]any{"id": batch[i].ID, "result": result})
+		}
+		return responses
+	})
+}
+
+func TestClient_RequestBatch(t *testing.T) {
+	accounts := []types.Address{"rA", "rB", "rError", "rC", "rMissing"}
+
+	reqs := make([]XRPLRequest, 0, len(accounts)+1)
+	for _, acc := range accounts {
+		reqs = append(reqs, &account.InfoRequest{Account: acc})
+	}
+	// invalid request, must not be sent
+	reqs = append(reqs, &account.ChannelsRequest{})
+
+	t.Run("pass - correlates responses and chunks requests", func(t *testing.T) {
+		posts := 0
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = batchEchoResponse(t, &posts, map[string]bool{"rMissing": true})
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithBatchSize(2))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.NoError(t, err)
+		require.Len(t, res, len(reqs))
+		require.Equal(t, 3, posts)
+
+		for i, acc := range []types.Address{"rA", "rB"} {
+			var info map[string]any
+			require.NoError(t, res[i].Err)
+			require.NoError(t, res[i].Response.GetResult(&info))
+			require.Equal(t, string(acc), info["account"])
+		}
+
+		var clientErr *ClientError
+		require.True(t, errors.As(res[2].Err, &clientErr))
+		require.Equal(t, "actNotFound", clientErr.ErrorString)
+
+		require.NoError(t, res[3].Err)
+		require.Equal(t, ErrMissingBatchResponse{ID: 5}, res[4].Err)
+		require.ErrorIs(t, res[5].Err, account.ErrNoAccountID)
+		require.Nil(t, res[5].Response)
+	})
+
+	t.Run("pass - correlates responses without id by position", func(t *testing.T) {
+		posts := 0
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = batchServer(t, &posts, func(batch []batchEntry) []map[string]any {
+			responses := make([]map[string]any, 0, len(batch))
+			for _, entry := range batch {
+				responses = append(responses, map[string]any{"result": map[string]any{"account": entry.Params[0]["account"]}})
+			}
+			return responses
+		})
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.NoError(t, err)
+		require.Equal(t, 1, posts)
+
+		for i, acc := range accounts {
+			var info map[string]any
+			require.NoError(t, res[i].Err)
+			require.NoError(t, res[i].Response.GetResult(&info))
+			require.Equal(t, string(acc), info["account"])
+		}
+		require.ErrorIs(t, res[5].Err, account.ErrNoAccountID)
+	})
+
+	t.Run("pass - chunks go through the interceptors", func(t *testing.T) {
+		posts := 0
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = batchEchoResponse(t, &posts, nil)
+
+		var methods []string
+		var results [][]map[string]any
+		interceptor := func(req xrpl.Request, next xrpl.Invoker) (xrpl.Response, error) {
+			methods = append(methods, req.Method())
+			res, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+			var r []map[string]any
+			require.NoError(t, res.GetResult(&r))
+			results = append(results, r)
+			return res, nil
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithBatchSize(2), WithInterceptors(interceptor))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.NoError(t, err)
+		require.Len(t, res, len(reqs))
+		require.Equal(t, []string{"batch", "batch", "batch"}, methods)
+		require.Equal(t, []map[string]any{{"account": "rB"}, {"account": "rA"}}, results[0])
+	})
+
+	t.Run("fail - interceptor replaces the batch response", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = batchEchoResponse(t, new(int), nil)
+
+		interceptor := func(_ xrpl.Request, _ xrpl.Invoker) (xrpl.Response, error) {
+			return &Response{}, nil
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithInterceptors(interceptor))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.Nil(t, res)
+		require.ErrorIs(t, err, ErrUnsupportedBatchResponse)
+	})
+
+	t.Run("fail - batch rejected", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = testutil.MockResponse(`{"result":{"error":"invalidParams","status":"error"}}`, 200, mc)
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.Nil(t, res)
+		require.Equal(t, &ClientError{ErrorString: "invalidParams"}, err)
+	})
+
+	t.Run("fail - server error", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = testutil.MockResponse(`Internal Server Error`, 500, mc)
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc))
+		require.NoError(t, err)
+
+		res, err := NewClient(cfg).RequestBatch(reqs...)
+		require.Nil(t, res)
+		require.Equal(t, &ClientError{ErrorString: "Internal Server Error"}, err)
+	})
+}
@@ -4,21 +4,26 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"time"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/hash"
-	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 )
 
+var (
+	_ xrpl.Client       = (*Client)(nil)
+	_ xrpl.AdminQuerier = (*Client)(nil)
+)
+
 // Client is an XRPL RPC client for sending requests and managing transactions.
 type Client struct {
 	cfg *Config
@@ -34,78 +39,126 @@ func NewClient(cfg *Config) *Client {
 }
 
 // Request sends a request to the XRPL server and returns the response and any error encountered.
+// The request goes through the configured interceptors, if any.
 func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
+	if len(c.cfg.interceptors) == 0 {
+		return c.request(reqParams)
+	}
+	return xrpl.Intercept(reqParams, c.invoke, c.cfg.interceptors...)
+}
 
-	err := reqParams.Validate()
+// invoke is the xrpl.Invoker that sends the request once it went through the interceptors.
+func (c *Client) invoke(req xrpl.Request) (xrpl.Response, error) {
+	reqParams, ok := req.(XRPLRequest)
+	if !ok {
+		return nil, ErrUnsupportedRequest
+	}
+
+	res, err := c.request(reqParams)
 	if err != nil {
 		return nil, err
 	}
+	return res, nil
+}
 
-	body, err := createRequest(reqParams)
+// request sends a request to the XRPL server, without going through the interceptors.
+func (c *Client) request(reqParams XRPLRequest) (XRPLResponse, error) {
+
+	err := reqParams.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.cfg.URL, bytes.NewReader(body))
+	body, err := createRequest(reqParams)
 	if err != nil {
 		return nil, err
 	}
 
-	// add timeout context to prevent hanging
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-	defer cancel()
-	req = req.WithContext(ctx)
-
-	req.Header = c.cfg.Headers
-
-	var response *http.Response
-
-	response, err = c.cfg.HTTPClient.Do(req)
+	response, err := c.post(context.Background(), body)
 	if err != nil || response == nil {
 		return nil, err
 	}
 
-	// allow client to reuse persistent connection
-	defer func() {
-		_ = response.Body.Close()
-	}()
-	// Check for service unavailable response and retry if so
-	if response.StatusCode == 503 {
+	var jr Response
+	jr, err = checkForError(response)
+	if err != nil {
+		return nil, err
+	}
 
-		maxRetries := 3
-		backoffDuration := 1 * time.Second
+	return &jr, nil
+}
 
-		for i := 0; i < maxRetries; i++ {
-			time.Sleep(backoffDuration)
+// post sends the JSON-RPC body to the server and returns the HTTP response, with its body fully read.
+// Every attempt waits for the configured rate limiter and times out after 5 seconds.
+// Failed attempts are retried according to the configured retry policy.
+func (c *Client) post(ctx context.Context, body []byte) (*http.Response, error) {
+	policy := c.cfg.retryPolicy
+	if policy == nil {
+		policy = defaultRetryPolicy
+	}
 
-			// Make request again after waiting
-			response, err = c.cfg.HTTPClient.Do(req)
-			if err != nil {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		if c.cfg.rateLimiter != nil {
+			if err := c.cfg.rateLimiter.Wait(ctx); err != nil {
 				return nil, err
 			}
+		}
 
-			if response.StatusCode != 503 {
-				break
+		response, resBody, err := c.doPost(ctx, body)
+
+		wait, retry := policy.NextRetry(RetryAttempt{
+			Attempt:  attempt,
+			Elapsed:  time.Since(start),
+			Response: response,
+			Body:     resBody,
+			Err:      err,
+		})
+		if !retry || ctx.Err() != nil {
+			if err == nil && response != nil && response.StatusCode == http.StatusServiceUnavailable {
+				// Return service unavailable error here after retrying
+				return nil, &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"}
 			}
-
-			// Increase backoff duration for the next retry
-			backoffDuration *= 2
+			return response, err
 		}
 
-		if response.StatusCode == 503 {
-			// Return service unavailable error here after retry 3 times
-			return nil, &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
 		}
+	}
+}
 
+// doPost sends a single HTTP request with the JSON-RPC body and reads the response body.
+// The returned response body can still be read by the caller.
+func (c *Client) doPost(ctx context.Context, body []byte) (*http.Response, []byte, error) {
+	// add timeout context to prevent hanging
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var jr Response
-	jr, err = checkForError(response)
+	req.Header = c.cfg.Headers
+
+	response, err := c.cfg.HTTPClient.Do(req)
+	if err != nil || response == nil {
+		return nil, nil, err
+	}
+
+	// allow client to reuse persistent connection
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	resBody, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	response.Body = io.NopCloser(bytes.NewReader(resBody))
 
-	return &jr, nil
+	return response, resBody, nil
 }
 
 // SubmitTxBlob sends a pre-signed transaction blob to the server.
@@ -162,7 +215,12 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 		return nil, err
 	}
 
-	return c.waitForTransaction(txHash, lastLedgerSequence)
+	// In stand-alone mode, close ledgers until the transaction is validated instead of waiting for consensus.
+	if c.cfg.standalone {
+		return xrpl.WaitForTransactionStandalone(c, txHash, lastLedgerSequence, c.cfg.maxRetries)
+	}
+
+	return xrpl.WaitForTransaction(c, txHash, lastLedgerSequence, c.cfg.maxRetries, c.cfg.retryDelay)
 }
 
 // SubmitTx signs the transaction (if necessary) and submits it to the server
@@ -222,76 +280,14 @@ func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.Subm
 
 // Autofill fills in the missing fields in a transaction.
 func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
-	if err := c.setValidTransactionAddresses(tx); err != nil {
-		return err
-	}
-
-	err := c.setTransactionFlags(tx)
-	if err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["NetworkID"]; !ok {
-		if c.NetworkID != 0 {
-			(*tx)["NetworkID"] = c.NetworkID
-		}
-	}
-	if _, ok := (*tx)["Sequence"]; !ok {
-		err := c.setTransactionNextValidSequenceNumber(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["Fee"]; !ok {
-		err := c.calculateFeePerTransactionType(tx, 0)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
-		err := c.setLastLedgerSequence(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if txType, ok := (*tx)["TransactionType"].(string); ok {
-		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
-			err := c.checkAccountDeleteBlockers(acc)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.PaymentTx.String() {
-			err := c.checkPaymentAmounts(tx)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.BatchTx.String() {
-			err := c.autofillRawTransactions(tx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+	return c.autofiller().Autofill(tx)
 }
 
 // AutofillMultisigned fills in the missing fields in a multisigned transaction.
 // This function is used to fill in the missing fields in a multisigned transaction.
 // It fills in the missing fields in the transaction and calculates the fee per number of signers.
 func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
-	err := c.Autofill(tx)
-	if err != nil {
-		return err
-	}
-
-	err = c.calculateFeePerTransactionType(tx, nSigners)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return c.autofiller().AutofillMultisigned(tx, nSigners)
 }
 
 // FaucetProvider returns the faucet provider for the client.
@@ -312,82 +308,3 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 
 	return nil
 }
-
-func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	needsNetworkID, err := c.txNeedsNetworkID()
-	if err != nil {
-		return err
-	}
-
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
-	}
-
-	accountSeq := make(map[string]uint32, len(rawTxs))
-
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		// Validate `Fee` field
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		} else if innerRawTx["Fee"] != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		// Validate `SigningPubKey` field
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		} else if innerRawTx["SigningPubKey"] != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		// Validate `TxnSignature` field
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		// Validate `NetworkID` field
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		// Validate `Sequence` field
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-
-			acc, ok := innerRawTx["Account"].(string)
-			if !ok {
-				return ErrAccountFieldIsNotAString
-			}
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
-			}
-		}
-	}
-
-	return nil
-}
@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
@@ -519,7 +521,7 @@ func TestClient_SubmitMultisigned(t *testing.T) {
 	}
 }
 
-func TestClient_autofillRawTransactions(t *testing.T) {
+func TestAutofiller_AutofillRawTransactions(t *testing.T) {
 	tests := []struct {
 		name          string
 		tx            transaction.FlatTransaction
@@ -852,7 +854,7 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 				originalTx[k] = v
 			}
 
-			err := cl.autofillRawTransactions(&tt.tx)
+			err := cl.autofiller().AutofillRawTransactions(&tt.tx)
 
 			if tt.expectedErr != nil {
 				require.Error(t, err)
@@ -912,3 +914,71 @@ func setupTestRPCClientForAutofill(t *testing.T, mockResponses []string) *Client
 
 	return NewClient(cfg)
 }
+
+func TestClient_RequestWithInterceptors(t *testing.T) {
+	req := &account.ChannelsRequest{
+		Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu",
+	}
+
+	mc := &testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{"result":{"account":"rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"}}`, 200, mc)
+
+	var methods []string
+	var responses []xrpl.Response
+	recorder := func(req xrpl.Request, next xrpl.Invoker) (xrpl.Response, error) {
+		methods = append(methods, req.Method())
+		res, err := next(req)
+		responses = append(responses, res)
+		return res, err
+	}
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithInterceptors(recorder))
+	require.NoError(t, err)
+
+	res, err := NewClient(cfg).Request(req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"account_channels"}, methods)
+	require.Equal(t, []xrpl.Response{res}, responses)
+}
+
+func TestClient_SubmitTxBlobAndWaitStandalone(t *testing.T) {
+	txBlob, err := binarycodec.Encode(map[string]any{
+		"TransactionType":    "AccountSet",
+		"Account":            "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu",
+		"Fee":                "10",
+		"Sequence":           uint32(1),
+		"LastLedgerSequence": uint32(29),
+		"SigningPubKey":      "03AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB",
+		"TxnSignature":       "3045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE",
+	})
+	require.NoError(t, err)
+
+	responses := map[string]string{
+		"submit":        `{"result":{"engine_result":"tesSUCCESS"}}`,
+		"ledger_accept": `{"result":{"ledger_current_index":10}}`,
+		"tx":            `{"result":{"ledger_index":9,"validated":true}}`,
+	}
+
+	var methods []string
+	mc := &testutil.JSONRPCMockClient{}
+	mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+		var body Request
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		methods = append(methods, body.Method)
+		return testutil.MockResponse(responses[body.Method], 200, mc)(req)
+	}
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithStandalone())
+	require.NoError(t, err)
+
+	// The transaction is validated in the closed ledger, far below its LastLedgerSequence, so it is returned
+	// without waiting for the retry delay.
+	start := time.Now()
+	res, err := NewClient(cfg).SubmitTxBlobAndWait(txBlob, false)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), cfg.retryDelay)
+	require.True(t, res.Validated)
+	require.Equal(t, []string{"submit", "ledger_accept", "tx"}, methods)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 )
 
@@ -23,6 +24,10 @@ type Config struct {
 	maxRetries int
 	retryDelay time.Duration
 
+	// Request retry and rate limit config
+	retryPolicy RetryPolicy
+	rateLimiter RateLimiter
+
 	// Fee config
 	maxFeeXRP  float32
 	feeCushion float32
@@ -31,6 +36,15 @@ type Config struct {
 	faucetProvider common.FaucetProvider
 
 	timeout time.Duration
+
+	// Batch config
+	batchSize int
+
+	// Interceptors config
+	interceptors []xrpl.Interceptor
+
+	// Stand-alone mode config
+	standalone bool
 }
 
 // ConfigOpt represents a function that applies a configuration option to Config.
@@ -74,6 +88,51 @@ func WithTimeout(timeout time.Duration) ConfigOpt {
 	}
 }
 
+// WithRetryPolicy returns a ConfigOpt that sets the policy used to retry failed HTTP requests.
+// By default, only 503 Service Unavailable responses are retried, 3 times with a doubling delay starting at 1 second.
+// See NewExponentialBackoff for a policy suited to public nodes.
+func WithRetryPolicy(p RetryPolicy) ConfigOpt {
+	return func(c *Config) {
+		c.retryPolicy = p
+	}
+}
+
+// WithRateLimiter returns a ConfigOpt that sets a client-side rate limiter applied to every HTTP request,
+// including retries. See NewTokenBucket.
+func WithRateLimiter(l RateLimiter) ConfigOpt {
+	return func(c *Config) {
+		c.rateLimiter = l
+	}
+}
+
+// WithBatchSize returns a ConfigOpt that sets the maximum number of requests sent in a single
+// JSON-RPC batch. Larger batches are split into several HTTP requests.
+// A size of zero or less sends every batch in a single HTTP request.
+func WithBatchSize(size int) ConfigOpt {
+	return func(c *Config) {
+		c.batchSize = size
+	}
+}
+
+// WithInterceptors returns a ConfigOpt that appends interceptors to the chain wrapping every request.
+// The first interceptor is the outermost one. See xrpl.LoggingInterceptor and xrpl.MetricsInterceptor.
+// Each chunk of RequestBatch goes through the chain as a single request of the "batch" method.
+func WithInterceptors(interceptors ...xrpl.Interceptor) ConfigOpt {
+	return func(c *Config) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// WithStandalone returns a ConfigOpt that enables stand-alone mode, for local rippled servers
+// started with --standalone. In this mode, SubmitTxBlobAndWait and SubmitTxAndWait close ledgers
+// with ledger_accept after submitting until the transaction is validated, instead of waiting for consensus.
+// It requires an admin connection to the server.
+func WithStandalone() ConfigOpt {
+	return func(c *Config) {
+		c.standalone = true
+	}
+}
+
 // NewClientConfig creates a new Config with the given URL and applies any provided ConfigOpt options.
 func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 
@@ -98,6 +157,8 @@ func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 
 		maxFeeXRP:  common.DefaultMaxFeeXRP,
 		feeCushion: common.DefaultFeeCushion,
+
+		batchSize: common.DefaultBatchSize,
 	}
 
 	for _, opt := range opts {
@@ -53,7 +53,7 @@ func TestConfigCreation(t *testing.T) {
 			"Content-Type": {"application/json"},
 		}
 		req.Header = cfg.Headers
-		assert.Equal(t, &Config{HTTPClient: customHttpClient{}, URL: "http://s1.ripple.com:51234/", Headers: headers, maxRetries: common.DefaultMaxRetries, retryDelay: common.DefaultRetryDelay, feeCushion: common.DefaultFeeCushion, maxFeeXRP: common.DefaultMaxFeeXRP, faucetProvider: nil}, cfg)
+		assert.Equal(t, &Config{HTTPClient: customHttpClient{}, URL: "http://s1.ripple.com:51234/", Headers: headers, maxRetries: common.DefaultMaxRetries, retryDelay: common.DefaultRetryDelay, feeCushion: common.DefaultFeeCushion, maxFeeXRP: common.DefaultMaxFeeXRP, faucetProvider: nil, batchSize: common.DefaultBatchSize}, cfg)
 		assert.NoError(t, err)
 	})
 }
@@ -85,3 +85,15 @@ func TestWithTimeout(t *testing.T) {
 
 	require.Equal(t, timeOut, cfg.timeout)
 }
+
+func TestWithBatchSize(t *testing.T) {
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithBatchSize(25))
+
+	require.Equal(t, 25, cfg.batchSize)
+}
+
+func TestWithStandalone(t *testing.T) {
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithStandalone())
+
+	require.True(t, cfg.standalone)
+}
@@ -3,6 +3,8 @@ package rpc
 import (
 	"errors"
 	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 var (
@@ -17,35 +19,35 @@ var (
 	// ErrMissingWallet is returned when a wallet is required but not provided for an unsigned transaction.
 	ErrMissingWallet = errors.New("wallet must be provided when submitting an unsigned transaction")
 	// ErrMissingAccountInTransaction is returned when the Account field is missing from a transaction.
-	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
+	ErrMissingAccountInTransaction = xrpl.ErrMissingAccountInTransaction
 	// ErrTransactionTypeMissing is returned when the transaction type is missing from a transaction.
-	ErrTransactionTypeMissing = errors.New("transaction type is missing in transaction")
+	ErrTransactionTypeMissing = xrpl.ErrTransactionTypeMissing
 	// ErrTransactionNotFound is returned when a transaction cannot be found.
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound = xrpl.ErrTransactionNotFound
 	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
-	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+	ErrInvalidFulfillmentLength = xrpl.ErrInvalidFulfillmentLength
 	// ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
 
 	// fields
 
 	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	ErrRawTransactionsFieldIsNotAnArray = xrpl.ErrRawTransactionsFieldIsNotAnArray
 	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
-	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	ErrRawTransactionFieldIsNotAnObject = xrpl.ErrRawTransactionFieldIsNotAnObject
 	// ErrSigningPubKeyFieldMustBeEmpty is returned when the signingPubKey field should be empty but isn't.
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	ErrSigningPubKeyFieldMustBeEmpty = xrpl.ErrSigningPubKeyFieldMustBeEmpty
 	// ErrTxnSignatureFieldMustBeEmpty is returned when the txnSignature field should be empty but isn't.
-	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	ErrTxnSignatureFieldMustBeEmpty = xrpl.ErrTxnSignatureFieldMustBeEmpty
 	// ErrSignersFieldMustBeEmpty is returned when the signers field should be empty but isn't.
-	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	ErrSignersFieldMustBeEmpty = xrpl.ErrSignersFieldMustBeEmpty
 	// ErrAccountFieldIsNotAString is returned when the account field is not a string type.
-	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	ErrAccountFieldIsNotAString = xrpl.ErrAccountFieldIsNotAString
 	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
-	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	ErrRawTransactionsFieldMissing = xrpl.ErrRawTransactionsFieldMissing
 	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
-	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	ErrRawTransactionFieldMissing = xrpl.ErrRawTransactionFieldMissing
 	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
-	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+	ErrFeeFieldMissing = xrpl.ErrFeeFieldMissing
 
 	// wallet
 
@@ -55,19 +57,32 @@ var (
 	// fees
 
 	// ErrCouldNotGetBaseFeeXrp is returned when BaseFeeXrp cannot be retrieved from ServerInfo.
-	ErrCouldNotGetBaseFeeXrp = errors.New("get fee xrp: could not get BaseFeeXrp from ServerInfo")
+	ErrCouldNotGetBaseFeeXrp = xrpl.ErrCouldNotGetBaseFeeXrp
 	// ErrCouldNotFetchOwnerReserve is returned when the owner reserve fee cannot be fetched.
-	ErrCouldNotFetchOwnerReserve = errors.New("could not fetch Owner Reserve")
+	ErrCouldNotFetchOwnerReserve = xrpl.ErrCouldNotFetchOwnerReserve
 
 	// account
 
 	// ErrAccountCannotBeDeleted is returned when an account cannot be deleted due to associated objects.
-	ErrAccountCannotBeDeleted = errors.New("account cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
+	ErrAccountCannotBeDeleted = xrpl.ErrAccountCannotBeDeleted
 
 	// payment
 
 	// ErrAmountAndDeliverMaxMustBeIdentical is returned when Amount and DeliverMax fields are not identical.
-	ErrAmountAndDeliverMaxMustBeIdentical = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+	ErrAmountAndDeliverMaxMustBeIdentical = xrpl.ErrAmountAndDeliverMaxMustBeIdentical
+
+	// request
+
+	// ErrUnsupportedRequest is returned when an interceptor passes on a request that is not an XRPLRequest.
+	ErrUnsupportedRequest = errors.New("request must implement XRPLRequest")
+
+	// batch
+
+	// ErrEmptyBatchResponse is returned when the server returns no response to a batch request.
+	ErrEmptyBatchResponse = errors.New("empty response to batch request")
+	// ErrUnsupportedBatchResponse is returned when an interceptor returns a response to a batch request that is
+	// not the response of the server.
+	ErrUnsupportedBatchResponse = errors.New("batch response must be the response of the server")
 
 	// config
 
@@ -100,23 +115,27 @@ func (e ErrFailedToMarshalJSONRPCRequest) Error() string {
 }
 
 // ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
-type ErrMismatchedTag struct {
-	Expected string
-	Actual   string
+type ErrMismatchedTag = xrpl.ErrMismatchedTag
+
+// ErrFailedToParseFee is returned when fee parsing fails.
+type ErrFailedToParseFee = xrpl.ErrFailedToParseFee
+
+// ErrUnexpectedBatchResponseID is returned when a batch response contains an id that matches no pending request.
+type ErrUnexpectedBatchResponseID struct {
+	ID int
 }
 
-// Error implements the error interface for ErrMismatchedTag
-func (e ErrMismatchedTag) Error() string {
-	return fmt.Sprintf("transaction tag mismatch: %q must equal %q", e.Actual, e.Expected)
+// Error implements the error interface for ErrUnexpectedBatchResponseID
+func (e ErrUnexpectedBatchResponseID) Error() string {
+	return fmt.Sprintf("unexpected id in batch response: %d", e.ID)
 }
 
-// ErrFailedToParseFee is returned when fee parsing fails.
-type ErrFailedToParseFee struct {
-	Fee string
-	Err error
+// ErrMissingBatchResponse is returned for a request of a batch that got no response from the server.
+type ErrMissingBatchResponse struct {
+	ID int
 }
 
-// Error implements the error interface for ErrFailedToParseFee
-func (e ErrFailedToParseFee) Error() string {
-	return fmt.Sprintf("failed to parse fee: %q: %v", e.Fee, e.Err)
+// Error implements the error interface for ErrMissingBatchResponse
+func (e ErrMissingBatchResponse) Error() string {
+	return fmt.Sprintf("missing response for batch request with id %d", e.ID)
 }
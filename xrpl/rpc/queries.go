@@ -1,8 +1,11 @@
 package rpc
 
 import (
+	"encoding/json"
+
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/admin"
 	channel "github.com/Peersyst/xrpl-go/xrpl/queries/channel"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	ledger "github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
@@ -10,6 +13,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
 	path "github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -156,6 +160,12 @@ func (c *Client) GetAccountTransactions(req *account.TransactionsRequest) (*acco
 	if err != nil {
 		return nil, err
 	}
+	if req.Binary {
+		err = acr.DecodeBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &acr, nil
 }
 
@@ -489,6 +499,32 @@ func (c *Client) GetManifest(req *server.ManifestRequest) (*server.ManifestRespo
 	return &lr, nil
 }
 
+// GetServerDefinitions retrieves the binary serialization definitions of the server.
+// It takes a DefinitionsRequest as input and returns a DefinitionsResponse,
+// along with any error encountered.
+func (c *Client) GetServerDefinitions(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	// Fields are [name, info] pairs, which are decoded with their json.Unmarshaler.
+	var raw map[string]any
+	err = res.GetResult(&raw)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var dr server.DefinitionsResponse
+	err = json.Unmarshal(b, &dr)
+	if err != nil {
+		return nil, err
+	}
+	return &dr, nil
+}
+
 // GetServerState retrieves information about the current state of the server.
 // It takes a StateRequest as input and returns a StateResponse,
 // along with any error encountered.
@@ -505,6 +541,30 @@ func (c *Client) GetServerState(req *server.StateRequest) (*server.StateResponse
 	return &lr, nil
 }
 
+// Transaction queries
+
+// GetTx retrieves information on a single transaction by its identifying hash.
+// It takes a TxRequest as input and returns a TxResponse,
+// along with any error encountered.
+func (c *Client) GetTx(req *requests.TxRequest) (*requests.TxResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var tr requests.TxResponse
+	err = res.GetResult(&tr)
+	if err != nil {
+		return nil, err
+	}
+	if req.Binary {
+		err = tr.DecodeBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &tr, nil
+}
+
 // Oracle queries
 
 // GetAggregatePrice retrieves the aggregate price of an asset.
@@ -556,3 +616,198 @@ func (c *Client) GetRandom(req *utility.RandomRequest) (*utility.RandomResponse,
 	}
 	return &lr, nil
 }
+
+// Admin queries
+
+// LedgerAccept closes the current open ledger and moves to the next one.
+// It is only available in stand-alone mode.
+// It takes a LedgerAcceptRequest as input and returns a LedgerAcceptResponse,
+// along with any error encountered.
+func (c *Client) LedgerAccept(req *admin.LedgerAcceptRequest) (*admin.LedgerAcceptResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.LedgerAcceptResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// WalletPropose generates a key pair and derives the address of an account.
+// It takes a WalletProposeRequest as input and returns a WalletProposeResponse,
+// along with any error encountered.
+func (c *Client) WalletPropose(req *admin.WalletProposeRequest) (*admin.WalletProposeResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.WalletProposeResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ValidationCreate generates the keys a server can use to sign validations.
+// It takes a ValidationCreateRequest as input and returns a ValidationCreateResponse,
+// along with any error encountered.
+func (c *Client) ValidationCreate(req *admin.ValidationCreateRequest) (*admin.ValidationCreateResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.ValidationCreateResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Sign signs a transaction with the secret of the request on the server.
+// It takes a SignRequest as input and returns a SignResponse,
+// along with any error encountered.
+func (c *Client) Sign(req *admin.SignRequest) (*admin.SignResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.SignResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// SignFor provides one signature for a multi-signed transaction on the server.
+// It takes a SignForRequest as input and returns a SignForResponse,
+// along with any error encountered.
+func (c *Client) SignFor(req *admin.SignForRequest) (*admin.SignForResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.SignForResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GetPeers retrieves the peers connected to the server and the members of its cluster.
+// It takes a PeersRequest as input and returns a PeersResponse,
+// along with any error encountered.
+func (c *Client) GetPeers(req *admin.PeersRequest) (*admin.PeersResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.PeersResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GetFetchInfo retrieves the objects the server is currently fetching from the network.
+// It takes a FetchInfoRequest as input and returns a FetchInfoResponse,
+// along with any error encountered.
+func (c *Client) GetFetchInfo(req *admin.FetchInfoRequest) (*admin.FetchInfoResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.FetchInfoResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// LogLevel retrieves or changes the log verbosity of the server.
+// It takes a LogLevelRequest as input and returns a LogLevelResponse,
+// along with any error encountered.
+func (c *Client) LogLevel(req *admin.LogLevelRequest) (*admin.LogLevelResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.LogLevelResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// LedgerCleaner configures the ledger cleaner of the server.
+// It takes a LedgerCleanerRequest as input and returns a LedgerCleanerResponse,
+// along with any error encountered.
+func (c *Client) LedgerCleaner(req *admin.LedgerCleanerRequest) (*admin.LedgerCleanerResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.LedgerCleanerResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CanDelete retrieves or sets the latest ledger that online deletion may delete.
+// It takes a CanDeleteRequest as input and returns a CanDeleteResponse,
+// along with any error encountered.
+func (c *Client) CanDelete(req *admin.CanDeleteRequest) (*admin.CanDeleteResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.CanDeleteResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ConnectPeer forces the server to connect to a specific peer.
+// It takes a ConnectRequest as input and returns a ConnectResponse,
+// along with any error encountered.
+func (c *Client) ConnectPeer(req *admin.ConnectRequest) (*admin.ConnectResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.ConnectResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// StopServer gracefully shuts down the server.
+// It takes a StopRequest as input and returns a StopResponse,
+// along with any error encountered.
+func (c *Client) StopServer(req *admin.StopRequest) (*admin.StopResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var r admin.StopResponse
+	err = res.GetResult(&r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
@@ -18,6 +19,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -2664,6 +2666,86 @@ func TestClient_GetManifest(t *testing.T) {
 	}
 }
 
+func TestClient_GetServerDefinitions(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *server.DefinitionsRequest
+		expected      *server.DefinitionsResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"TYPES": {"UInt32": 2},
+					"LEDGER_ENTRY_TYPES": {"AccountRoot": 97},
+					"FIELDS": [
+						["Sequence", {"nth": 4, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]
+					],
+					"TRANSACTION_RESULTS": {"tesSUCCESS": 0},
+					"TRANSACTION_TYPES": {"Payment": 0},
+					"hash": "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+					"status": "success"
+				}
+			}`,
+			mockStatus: 200,
+			request:    &server.DefinitionsRequest{},
+			expected: &server.DefinitionsResponse{
+				Document: definitions.Document{
+					Types:            map[string]int32{"UInt32": 2},
+					LedgerEntryTypes: map[string]int32{"AccountRoot": 97},
+					Fields: []definitions.FieldDefinition{
+						{
+							Name: "Sequence",
+							Info: definitions.FieldInfo{Nth: 4, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+						},
+					},
+					TransactionResults: map[string]int32{"tesSUCCESS": 0},
+					TransactionTypes:   map[string]int32{"Payment": 0},
+				},
+				Hash: "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "invalidParams",
+					"status": "error"
+				}
+			}`,
+			mockStatus:    200,
+			request:       &server.DefinitionsRequest{},
+			expectedError: "invalidParams",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			resp, err := client.GetServerDefinitions(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestClient_GetServerState(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -2966,3 +3048,32 @@ func TestClient_GetRandom(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTx_Binary(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"hash": "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+			"ledger_index": 71766343,
+			"meta_blob": "201C00000001031000",
+			"tx_blob": "12000022800000002400000001614000000005F5E10068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100A7CCD1B5F67D76C7F2C0B6C199C9D2F3721A14A3C69F3CB134E9BF9D9DD6F8B002206A5F974C4F4D07B4F5A99391BF3E93D9B0A7346861E12E924B6451B6D8ED4F0981144B4E9C06F24296074F7BC48F92A97916C6DC5EA983143E9D4A2B8AA0780F682D136F7A56D6724EF53754",
+			"validated": true,
+			"status": "success"
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	resp, err := client.GetTx(&transactions.TxRequest{
+		Transaction: "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+		Binary:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Payment", resp.TxJSON["TransactionType"])
+	require.Equal(t, "100000000", resp.TxJSON["Amount"])
+	require.Equal(t, "tesSUCCESS", resp.Meta.TransactionResult)
+	require.Equal(t, uint64(1), resp.Meta.TransactionIndex)
+	require.Equal(t, "100000000", resp.Meta.DeliveredAmount)
+}
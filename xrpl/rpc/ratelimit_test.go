@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_Wait(t *testing.T) {
+	t.Run("pass - allows burst then limits rate", func(t *testing.T) {
+		b := NewTokenBucket(50, 2)
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			require.NoError(t, b.Wait(context.Background()))
+		}
+
+		// 2 requests are served by the burst, the next 2 wait 20ms each.
+		require.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+	})
+
+	t.Run("fail - context cancelled", func(t *testing.T) {
+		b := NewTokenBucket(1, 1)
+		require.NoError(t, b.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, b.Wait(ctx), context.DeadlineExceeded)
+	})
+}
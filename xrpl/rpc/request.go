@@ -4,6 +4,7 @@ package rpc
 type Request struct {
 	Method string         `json:"method"`
 	Params [1]interface{} `json:"params,omitempty"`
+	ID     int            `json:"id,omitempty"`
 }
 
 // APIVersionRequest defines the interface for requests that support API versioning.
@@ -11,6 +11,7 @@ type Response struct {
 	Warning   string                `json:"warning,omitempty"`
 	Warnings  []XRPLResponseWarning `json:"warnings,omitempty"`
 	Forwarded bool                  `json:"forwarded,omitempty"`
+	ID        int                   `json:"id,omitempty"`
 }
 
 // XRPLResponseWarning represents a warning returned by the XRPL server.
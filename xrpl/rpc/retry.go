@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// rippled error codes returned when the server is too busy to handle the request.
+	rippledSlowDown = "slowDown"
+	rippledTooBusy  = "tooBusy"
+)
+
+// RetryAttempt describes the outcome of a single attempt to send a request.
+type RetryAttempt struct {
+	// Attempt is the number of attempts made so far, starting at 1.
+	Attempt int
+	// Elapsed is the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Response is the HTTP response of the attempt, if any.
+	Response *http.Response
+	// Body is the body of the HTTP response of the attempt, if any.
+	Body []byte
+	// Err is the error returned by the HTTP client, if any.
+	Err error
+}
+
+// RetryPolicy decides whether a request is retried after a failed attempt.
+type RetryPolicy interface {
+	// NextRetry returns the delay to wait before the next attempt and whether the request should be retried.
+	NextRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// RetryClassifier reports whether an attempt failed with a retryable error.
+type RetryClassifier func(attempt RetryAttempt) bool
+
+// ExponentialBackoff is a RetryPolicy that retries retryable attempts with an exponentially growing delay.
+// If the server answers with a Retry-After header, the delay is at least the requested one.
+type ExponentialBackoff struct {
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between two attempts. Zero means no cap.
+	MaxInterval time.Duration
+	// Multiplier is the factor applied to the delay after every retry. Values below 1 are treated as 1.
+	Multiplier float64
+	// Jitter is the randomization factor applied to every delay, between 0 and 1.
+	// A delay d is randomized in the range [d*(1-Jitter), d*(1+Jitter)].
+	Jitter float64
+	// MaxRetries is the maximum number of retries. Zero means no limit.
+	MaxRetries int
+	// MaxElapsedTime is the maximum time spent retrying a request. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// Classifier reports whether an attempt is retryable. Defaults to IsRetryable.
+	Classifier RetryClassifier
+}
+
+// NewExponentialBackoff returns an ExponentialBackoff with sensible defaults for public nodes:
+// it retries any retryable error up to 5 times, starting at 500ms, with jitter, for at most 30 seconds.
+func NewExponentialBackoff() *ExponentialBackoff {
+	return &ExponentialBackoff{
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.5,
+		MaxRetries:      5,
+		MaxElapsedTime:  30 * time.Second,
+		Classifier:      IsRetryable,
+	}
+}
+
+// defaultRetryPolicy is the RetryPolicy used when none is configured.
+// It retries 503 Service Unavailable responses 3 times, starting at 1 second.
+var defaultRetryPolicy = &ExponentialBackoff{
+	InitialInterval: time.Second,
+	Multiplier:      2,
+	MaxRetries:      3,
+	Classifier:      IsServiceUnavailable,
+}
+
+// NextRetry implements the RetryPolicy interface.
+func (b *ExponentialBackoff) NextRetry(attempt RetryAttempt) (time.Duration, bool) {
+	classifier := b.Classifier
+	if classifier == nil {
+		classifier = IsRetryable
+	}
+	if !classifier(attempt) {
+		return 0, false
+	}
+	if b.MaxRetries > 0 && attempt.Attempt > b.MaxRetries {
+		return 0, false
+	}
+
+	multiplier := math.Max(b.Multiplier, 1)
+	delay := float64(b.InitialInterval) * math.Pow(multiplier, float64(attempt.Attempt-1))
+	if b.MaxInterval > 0 {
+		delay = math.Min(delay, float64(b.MaxInterval))
+	}
+	if b.Jitter > 0 {
+		delay *= 1 + b.Jitter*(2*rand.Float64()-1)
+	}
+
+	wait := time.Duration(delay)
+	if retryAfter, ok := parseRetryAfter(attempt.Response); ok && retryAfter > wait {
+		wait = retryAfter
+	}
+
+	if b.MaxElapsedTime > 0 && attempt.Elapsed+wait > b.MaxElapsedTime {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+// IsServiceUnavailable reports whether the attempt got a 503 Service Unavailable response.
+func IsServiceUnavailable(attempt RetryAttempt) bool {
+	return attempt.Response != nil && attempt.Response.StatusCode == http.StatusServiceUnavailable
+}
+
+// IsRetryable reports whether the attempt failed with a network error, a 429 Too Many Requests
+// or 503 Service Unavailable response, or a rippled slowDown or tooBusy error.
+// Attempts cancelled by the caller's context are never retryable.
+func IsRetryable(attempt RetryAttempt) bool {
+	if attempt.Err != nil {
+		return !errors.Is(attempt.Err, context.Canceled)
+	}
+	if attempt.Response == nil {
+		return false
+	}
+
+	switch attempt.Response.StatusCode {
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		return true
+	case http.StatusOK:
+		return isRippledBusyError(attempt.Body)
+	default:
+		return false
+	}
+}
+
+// isRippledBusyError reports whether the body is a JSON-RPC response with a slowDown or tooBusy error.
+func isRippledBusyError(body []byte) bool {
+	var res struct {
+		Result struct {
+			Error string `json:"error"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return false
+	}
+	return res.Result.Error == rippledSlowDown || res.Result.Error == rippledTooBusy
+}
+
+// parseRetryAfter parses the Retry-After header of the response, either in seconds or as an HTTP date.
+func parseRetryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil || res.Header == nil {
+		return 0, false
+	}
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
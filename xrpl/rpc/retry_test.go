@@ -0,0 +1,202 @@
+package rpc
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempt  RetryAttempt
+		expected bool
+	}{
+		{
+			name:     "pass - network error",
+			attempt:  RetryAttempt{Err: errors.New("connection reset by peer")},
+			expected: true,
+		},
+		{
+			name:     "pass - too many requests",
+			attempt:  RetryAttempt{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+			expected: true,
+		},
+		{
+			name:     "pass - service unavailable",
+			attempt:  RetryAttempt{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}},
+			expected: true,
+		},
+		{
+			name: "pass - rippled slowDown",
+			attempt: RetryAttempt{
+				Response: &http.Response{StatusCode: http.StatusOK},
+				Body:     []byte(`{"result":{"error":"slowDown","status":"error"}}`),
+			},
+			expected: true,
+		},
+		{
+			name: "pass - rippled tooBusy",
+			attempt: RetryAttempt{
+				Response: &http.Response{StatusCode: http.StatusOK},
+				Body:     []byte(`{"result":{"error":"tooBusy","status":"error"}}`),
+			},
+			expected: true,
+		},
+		{
+			name: "fail - other rippled error",
+			attempt: RetryAttempt{
+				Response: &http.Response{StatusCode: http.StatusOK},
+				Body:     []byte(`{"result":{"error":"actNotFound","status":"error"}}`),
+			},
+			expected: false,
+		},
+		{
+			name:     "fail - bad request",
+			attempt:  RetryAttempt{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsRetryable(tt.attempt))
+		})
+	}
+}
+
+func TestExponentialBackoff_NextRetry(t *testing.T) {
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable}
+
+	b := &ExponentialBackoff{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     300 * time.Millisecond,
+		Multiplier:      2,
+		MaxRetries:      4,
+		MaxElapsedTime:  time.Second,
+	}
+
+	tests := []struct {
+		name          string
+		attempt       RetryAttempt
+		expectedWait  time.Duration
+		expectedRetry bool
+	}{
+		{
+			name:          "pass - first retry",
+			attempt:       RetryAttempt{Attempt: 1, Response: unavailable},
+			expectedWait:  100 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:          "pass - exponential growth",
+			attempt:       RetryAttempt{Attempt: 2, Response: unavailable},
+			expectedWait:  200 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name:          "pass - capped at max interval",
+			attempt:       RetryAttempt{Attempt: 4, Response: unavailable},
+			expectedWait:  300 * time.Millisecond,
+			expectedRetry: true,
+		},
+		{
+			name: "pass - honours Retry-After",
+			attempt: RetryAttempt{Attempt: 1, Response: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"1"}},
+			}},
+			expectedWait:  time.Second,
+			expectedRetry: true,
+		},
+		{
+			name:          "fail - max retries reached",
+			attempt:       RetryAttempt{Attempt: 5, Response: unavailable},
+			expectedRetry: false,
+		},
+		{
+			name:          "fail - max elapsed time reached",
+			attempt:       RetryAttempt{Attempt: 1, Elapsed: 950 * time.Millisecond, Response: unavailable},
+			expectedRetry: false,
+		},
+		{
+			name:          "fail - not retryable",
+			attempt:       RetryAttempt{Attempt: 1, Response: &http.Response{StatusCode: http.StatusOK}},
+			expectedRetry: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, retry := b.NextRetry(tt.attempt)
+			require.Equal(t, tt.expectedRetry, retry)
+			if tt.expectedRetry {
+				require.Equal(t, tt.expectedWait, wait)
+			}
+		})
+	}
+
+	t.Run("pass - jitter stays in range", func(t *testing.T) {
+		jittered := &ExponentialBackoff{InitialInterval: 100 * time.Millisecond, Jitter: 0.5}
+		for i := 0; i < 100; i++ {
+			wait, retry := jittered.NextRetry(RetryAttempt{Attempt: 1, Response: unavailable})
+			require.True(t, retry)
+			require.GreaterOrEqual(t, wait, 50*time.Millisecond)
+			require.LessOrEqual(t, wait, 150*time.Millisecond)
+		}
+	})
+}
+
+func TestClient_RequestWithRetryPolicy(t *testing.T) {
+	req := &account.ChannelsRequest{
+		Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu",
+	}
+
+	t.Run("pass - retries network errors and rippled busy errors", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			switch mc.RequestCount {
+			case 1:
+				return nil, errors.New("connection reset by peer")
+			case 2:
+				return testutil.MockResponse(`{"result":{"error":"slowDown"}}`, 200, mc)(req)
+			default:
+				return testutil.MockResponse(`{"result":{"account":"rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"}}`, 200, mc)(req)
+			}
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithRetryPolicy(&ExponentialBackoff{
+			InitialInterval: time.Millisecond,
+			MaxRetries:      3,
+		}))
+		require.NoError(t, err)
+
+		_, err = NewClient(cfg).Request(req)
+		require.NoError(t, err)
+		require.Equal(t, 3, mc.RequestCount)
+	})
+
+	t.Run("fail - gives up after max retries", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			return testutil.MockResponse(`{"result":{"error":"tooBusy"}}`, 200, mc)(req)
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithRetryPolicy(&ExponentialBackoff{
+			InitialInterval: time.Millisecond,
+			MaxRetries:      2,
+		}))
+		require.NoError(t, err)
+
+		_, err = NewClient(cfg).Request(req)
+		require.EqualError(t, err, "tooBusy")
+		require.Equal(t, 3, mc.RequestCount)
+	})
+}
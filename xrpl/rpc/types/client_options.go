@@ -4,12 +4,8 @@
 package types
 
 import (
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 // SubmitOptions specifies options for submitting a single transaction via RPC.
-type SubmitOptions struct {
-	Autofill bool
-	Wallet   *wallet.Wallet
-	FailHard bool
-}
+type SubmitOptions = xrpl.SubmitOptions
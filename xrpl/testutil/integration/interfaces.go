@@ -1,10 +1,8 @@
 package integration
 
 import (
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction"
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 )
 
 // FaucetProvider provides faucet funding for wallets in integration tests.
@@ -14,13 +12,7 @@ type FaucetProvider interface {
 
 // Client defines the interface for submitting transactions and funding wallets in integration tests.
 type Client interface {
-	FaucetProvider() common.FaucetProvider
-
-	FundWallet(wallet *wallet.Wallet) error
-	Autofill(tx *transaction.FlatTransaction) error
-	SubmitTxBlob(txBlob string, failHard bool) (*transactions.SubmitResponse, error)
-	SubmitTxBlobAndWait(txBlob string, failHard bool) (*transactions.TxResponse, error)
-	SubmitMultisigned(blob string, validate bool) (*transactions.SubmitMultisignedResponse, error)
+	xrpl.Client
 }
 
 // Connectable defines methods to connect and disconnect the integration client.
@@ -1,6 +1,10 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // TxMetadataBuilder contains all `meta` transaction response fields and
 // enables specific transaction metadata building.
@@ -92,3 +96,30 @@ func (tmb TxMetadataBuilder) AsTxObjMeta() TxObjMeta {
 		ParentBatchID:          tmb.ParentBatchID,
 	}
 }
+
+// NewTxMetadataBuilder builds the metadata of a transaction from its flat representation, as returned by
+// binarycodec.Decode for the meta blob of a transaction requested in binary mode. The TransactionResult field
+// is expected to be the name of the result, which binarycodec.Decode maps from its numeric code.
+//
+// The delivered_amount field is not part of the meta blob, as rippled adds it to JSON responses only, so
+// DeliveredAmount is computed as rippled does: the DeliveredAmount field if present, or else the Amount of tx,
+// if tx is a successful Payment. tx, which may be nil, is the flat representation of the transaction.
+func NewTxMetadataBuilder(meta map[string]any, tx FlatTransaction) (TxMetadataBuilder, error) {
+	var tmb TxMetadataBuilder
+
+	b, err := json.Marshal(meta)
+	if err != nil {
+		return tmb, err
+	}
+	if err := json.Unmarshal(b, &tmb); err != nil {
+		return tmb, err
+	}
+
+	switch {
+	case tmb.PartialDeliveredAmount != nil:
+		tmb.DeliveredAmount = tmb.PartialDeliveredAmount
+	case tx != nil && tx["TransactionType"] == PaymentTx.String() && tmb.TransactionResult == "tesSUCCESS":
+		tmb.DeliveredAmount = tx["Amount"]
+	}
+	return tmb, nil
+}
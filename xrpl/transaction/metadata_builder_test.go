@@ -585,3 +585,92 @@ func TestTxMetadataBuilder_AsMPTokenIssuanceCreateMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTxMetadataBuilder(t *testing.T) {
+	tests := []struct {
+		name        string
+		meta        map[string]any
+		tx          FlatTransaction
+		expected    TxMetadataBuilder
+		expectedErr bool
+	}{
+		{
+			name: "pass - decoded meta blob",
+			meta: map[string]any{
+				"AffectedNodes": []any{
+					map[string]any{"ModifiedNode": map[string]any{
+						"LedgerEntryType":   "AccountRoot",
+						"LedgerIndex":       "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+						"FinalFields":       map[string]any{"Balance": "999999988", "Sequence": uint32(2)},
+						"PreviousFields":    map[string]any{"Balance": "1100000000"},
+						"PreviousTxnID":     "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+						"PreviousTxnLgrSeq": uint32(71766300),
+					}},
+				},
+				"TransactionIndex":  uint32(1),
+				"TransactionResult": "tesSUCCESS",
+			},
+			expected: TxMetadataBuilder{
+				AffectedNodes: []AffectedNode{
+					{ModifiedNode: &ModifiedNode{
+						LedgerEntryType:   "AccountRoot",
+						LedgerIndex:       "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+						FinalFields:       map[string]any{"Balance": "999999988", "Sequence": float64(2)},
+						PreviousFields:    map[string]any{"Balance": "1100000000"},
+						PreviousTxnID:     "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+						PreviousTxnLgrSeq: 71766300,
+					}},
+				},
+				TransactionIndex:  1,
+				TransactionResult: "tesSUCCESS",
+			},
+		},
+		{
+			name: "pass - delivered amount from DeliveredAmount",
+			meta: map[string]any{
+				"DeliveredAmount":   map[string]any{"currency": "USD", "issuer": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "value": "1"},
+				"TransactionResult": "tesSUCCESS",
+			},
+			tx: FlatTransaction{"TransactionType": "Payment", "Amount": "100000000"},
+			expected: TxMetadataBuilder{
+				PartialDeliveredAmount: map[string]any{"currency": "USD", "issuer": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "value": "1"},
+				DeliveredAmount:        map[string]any{"currency": "USD", "issuer": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "value": "1"},
+				TransactionResult:      "tesSUCCESS",
+			},
+		},
+		{
+			name: "pass - delivered amount from the payment amount",
+			meta: map[string]any{"TransactionResult": "tesSUCCESS"},
+			tx:   FlatTransaction{"TransactionType": "Payment", "Amount": "100000000"},
+			expected: TxMetadataBuilder{
+				DeliveredAmount:   "100000000",
+				TransactionResult: "tesSUCCESS",
+			},
+		},
+		{
+			name: "pass - no delivered amount for a failed payment",
+			meta: map[string]any{"TransactionResult": "tecPATH_DRY"},
+			tx:   FlatTransaction{"TransactionType": "Payment", "Amount": "100000000"},
+			expected: TxMetadataBuilder{
+				TransactionResult: "tecPATH_DRY",
+			},
+		},
+		{
+			name:        "fail - invalid affected nodes",
+			meta:        map[string]any{"AffectedNodes": "invalid"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmb, err := NewTxMetadataBuilder(tt.meta, tt.tx)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, tmb)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package unl
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUntrustedPublisher is returned when a validator list is published by another key than the expected one.
+	ErrUntrustedPublisher = errors.New("unl: untrusted publisher key")
+	// ErrPublisherManifestMismatch is returned when the publisher manifest is not for the publisher key.
+	ErrPublisherManifestMismatch = errors.New("unl: publisher manifest does not match publisher key")
+	// ErrPublisherRevoked is returned when the publisher manifest revokes the publisher key.
+	ErrPublisherRevoked = errors.New("unl: publisher key is revoked")
+	// ErrInvalidBlobSignature is returned when the signature of a blob does not verify.
+	ErrInvalidBlobSignature = errors.New("unl: invalid blob signature")
+	// ErrNoBlobs is returned when a validator list has no blob.
+	ErrNoBlobs = errors.New("unl: validator list has no blob")
+	// ErrUnsupportedVersion is returned when a validator list has an unknown version.
+	ErrUnsupportedVersion = errors.New("unl: unsupported validator list version")
+	// ErrValidatorManifestMismatch is returned when the manifest of a validator is not for its master key.
+	ErrValidatorManifestMismatch = errors.New("unl: validator manifest does not match validator key")
+)
+
+// Dynamic errors
+
+// ErrUnexpectedStatusCode is returned when an HTTP source answers with a non-200 status code.
+type ErrUnexpectedStatusCode struct {
+	Code int
+}
+
+// Error implements the error interface for ErrUnexpectedStatusCode
+func (e ErrUnexpectedStatusCode) Error() string {
+	return fmt.Sprintf("unl: unexpected HTTP status code %d", e.Code)
+}
+
+// ErrInvalidValidator is returned when a validator of a list is invalid.
+type ErrInvalidValidator struct {
+	PublicKey string
+	Err       error
+}
+
+// Error implements the error interface for ErrInvalidValidator
+func (e ErrInvalidValidator) Error() string {
+	return fmt.Sprintf("unl: invalid validator %s: %v", e.PublicKey, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e ErrInvalidValidator) Unwrap() error {
+	return e.Err
+}
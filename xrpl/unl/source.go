@@ -0,0 +1,74 @@
+package unl
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Source provides the raw JSON of a validator list.
+type Source interface {
+	// Fetch returns the raw JSON of the validator list.
+	Fetch(ctx context.Context) ([]byte, error)
+}
+
+// BytesSource is a Source that returns a validator list already in memory.
+type BytesSource []byte
+
+// Fetch implements the Source interface.
+func (s BytesSource) Fetch(context.Context) ([]byte, error) {
+	return s, nil
+}
+
+// HTTPSource is a Source that fetches a validator list from a validator list site.
+type HTTPSource struct {
+	// URL is the URL of the validator list, for example https://vl.ripple.com.
+	URL string
+	// Client is the HTTP client used to fetch the list. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// NewHTTPSource creates a new HTTPSource for the given URL, using the given HTTP client.
+// If client is nil, http.DefaultClient is used.
+func NewHTTPSource(url string, client *http.Client) *HTTPSource {
+	return &HTTPSource{
+		URL:    url,
+		Client: client,
+	}
+}
+
+// Fetch implements the Source interface.
+func (s *HTTPSource) Fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrUnexpectedStatusCode{Code: res.StatusCode}
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// Fetch fetches a validator list from the source and verifies it. See Verify.
+func Fetch(ctx context.Context, src Source, publisherKey string) (*VerifiedList, error) {
+	data, err := src.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Verify(data, publisherKey)
+}
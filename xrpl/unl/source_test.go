@@ -0,0 +1,60 @@
+package unl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSource_Fetch(t *testing.T) {
+	body := []byte(`{"version":1}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	data, err := NewHTTPSource(server.URL, server.Client()).Fetch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, body, data)
+
+	_, err = NewHTTPSource(server.URL+"/missing", nil).Fetch(context.Background())
+	require.ErrorIs(t, err, ErrUnexpectedStatusCode{Code: http.StatusNotFound})
+}
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+	p := newTestPublisher(t)
+	validators, keys := testValidators(t)
+	blob := p.blob(t, 1, time.Time{}, now.Add(time.Hour), validators)
+
+	data, err := json.Marshal(rawList{
+		PublicKey: p.master.public,
+		Manifest:  p.manifest,
+		Blob:      blob.Blob,
+		Signature: blob.Signature,
+		Version:   Version1,
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	list, err := Fetch(context.Background(), NewHTTPSource(server.URL, server.Client()), p.master.public)
+	require.NoError(t, err)
+	require.ElementsMatch(t, keys, list.TrustedKeys(now))
+
+	_, err = Fetch(context.Background(), BytesSource(data), keys[0])
+	require.ErrorIs(t, err, ErrUntrustedPublisher)
+}
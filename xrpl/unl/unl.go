@@ -0,0 +1,262 @@
+// Package unl fetches and verifies validator lists in the standard publisher format,
+// as served by validator list sites and used by rippled to build its UNL.
+//
+// A validator list is signed by a publisher. The publisher manifest binds the publisher
+// master key, which the user trusts, to the ephemeral key that signs the list blobs.
+// Version 1 lists have a single blob, while version 2 lists can have several blobs,
+// to publish future lists before they become effective.
+package unl
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/manifest"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+)
+
+const (
+	// Version1 is the version of validator lists with a single blob.
+	Version1 = 1
+	// Version2 is the version of validator lists with several blobs.
+	Version2 = 2
+)
+
+// Validator is a validator of a list.
+type Validator struct {
+	// PublicKey is the hex encoded master public key of the validator.
+	PublicKey string
+	// Manifest is the current manifest of the validator, if the list includes it.
+	Manifest *manifest.Manifest
+}
+
+// List is a verified list of validators.
+type List struct {
+	// Sequence is the sequence of the list. A higher sequence replaces a lower one.
+	Sequence uint32
+	// Effective is the time the list becomes effective. It is zero if the list is effective immediately.
+	Effective time.Time
+	// Expiration is the time the list expires.
+	Expiration time.Time
+	// Validators are the validators of the list.
+	Validators []Validator
+}
+
+// IsActive reports whether the list is effective and not expired at the given time.
+func (l *List) IsActive(now time.Time) bool {
+	return !now.Before(l.Effective) && now.Before(l.Expiration)
+}
+
+// VerifiedList is a validator list whose publisher manifest and blob signatures have been verified.
+type VerifiedList struct {
+	// Version is the version of the validator list format.
+	Version int
+	// PublisherKey is the hex encoded master public key of the publisher.
+	PublisherKey string
+	// PublisherManifest is the manifest of the publisher.
+	PublisherManifest *manifest.Manifest
+	// Lists are the lists of the validator list, one per blob, in the order they were published.
+	Lists []List
+}
+
+// Active returns the active list with the highest sequence at the given time, or nil if no list is active.
+func (v *VerifiedList) Active(now time.Time) *List {
+	var active *List
+	for i := range v.Lists {
+		l := &v.Lists[i]
+		if l.IsActive(now) && (active == nil || l.Sequence > active.Sequence) {
+			active = l
+		}
+	}
+	return active
+}
+
+// TrustedKeys returns the sorted hex encoded master public keys of the validators of the active list
+// at the given time, excluding the validators whose manifest revokes their master key.
+// It returns nil if no list is active.
+func (v *VerifiedList) TrustedKeys(now time.Time) []string {
+	active := v.Active(now)
+	if active == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(active.Validators))
+	for _, validator := range active.Validators {
+		if validator.Manifest != nil && validator.Manifest.IsRevoked() {
+			continue
+		}
+		keys = append(keys, validator.PublicKey)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// signedBlob is a blob of a validator list with its signature.
+// The manifest overrides the publisher manifest of the list, if set.
+type signedBlob struct {
+	Blob      string `json:"blob"`
+	Signature string `json:"signature"`
+	Manifest  string `json:"manifest,omitempty"`
+}
+
+// rawList is a validator list in the standard publisher format.
+type rawList struct {
+	PublicKey string       `json:"public_key"`
+	Manifest  string       `json:"manifest"`
+	Blob      string       `json:"blob,omitempty"`
+	Signature string       `json:"signature,omitempty"`
+	BlobsV2   []signedBlob `json:"blobs_v2,omitempty"`
+	Version   int          `json:"version"`
+}
+
+// rawBlob is the decoded blob of a validator list.
+type rawBlob struct {
+	Sequence   uint32         `json:"sequence"`
+	Effective  uint32         `json:"effective,omitempty"`
+	Expiration uint32         `json:"expiration"`
+	Validators []rawValidator `json:"validators"`
+}
+
+// rawValidator is a validator of a decoded blob.
+type rawValidator struct {
+	ValidationPublicKey string `json:"validation_public_key"`
+	Manifest            string `json:"manifest,omitempty"`
+}
+
+// Verify parses and verifies a validator list published by publisherKey, the hex encoded
+// master public key of a trusted publisher. It verifies the publisher manifest, the signature
+// of every blob, and the manifest of every validator.
+func Verify(data []byte, publisherKey string) (*VerifiedList, error) {
+	var raw rawList
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(raw.PublicKey, publisherKey) {
+		return nil, ErrUntrustedPublisher
+	}
+
+	publisherManifest, err := verifyPublisherManifest(raw.Manifest, publisherKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []signedBlob
+	switch raw.Version {
+	case Version1:
+		blobs = []signedBlob{{Blob: raw.Blob, Signature: raw.Signature}}
+	case Version2:
+		blobs = raw.BlobsV2
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+	if len(blobs) == 0 {
+		return nil, ErrNoBlobs
+	}
+
+	verified := &VerifiedList{
+		Version:           raw.Version,
+		PublisherKey:      raw.PublicKey,
+		PublisherManifest: publisherManifest,
+		Lists:             make([]List, 0, len(blobs)),
+	}
+
+	for _, blob := range blobs {
+		signer := publisherManifest
+		if blob.Manifest != "" {
+			signer, err = verifyPublisherManifest(blob.Manifest, publisherKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		list, err := verifyBlob(blob, signer)
+		if err != nil {
+			return nil, err
+		}
+		verified.Lists = append(verified.Lists, *list)
+	}
+
+	return verified, nil
+}
+
+// verifyPublisherManifest decodes and verifies a publisher manifest, and checks it is
+// an unrevoked manifest of the publisher key.
+func verifyPublisherManifest(encoded, publisherKey string) (*manifest.Manifest, error) {
+	m, err := manifest.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Verify(); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(m.MasterKey, publisherKey) {
+		return nil, ErrPublisherManifestMismatch
+	}
+	if m.IsRevoked() {
+		return nil, ErrPublisherRevoked
+	}
+	return m, nil
+}
+
+// verifyBlob verifies the signature of a blob with the ephemeral key of the signer manifest and decodes it.
+func verifyBlob(blob signedBlob, signer *manifest.Manifest) (*List, error) {
+	b, err := base64.StdEncoding.DecodeString(blob.Blob)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := keypairs.Validate(string(b), signer.SigningKey, blob.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidBlobSignature
+	}
+
+	var raw rawBlob
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	list := &List{
+		Sequence:   raw.Sequence,
+		Expiration: rippleTime(raw.Expiration),
+		Validators: make([]Validator, 0, len(raw.Validators)),
+	}
+	if raw.Effective != 0 {
+		list.Effective = rippleTime(raw.Effective)
+	}
+
+	for _, rv := range raw.Validators {
+		validator := Validator{PublicKey: strings.ToUpper(rv.ValidationPublicKey)}
+
+		if rv.Manifest != "" {
+			m, err := manifest.Decode(rv.Manifest)
+			if err != nil {
+				return nil, ErrInvalidValidator{PublicKey: validator.PublicKey, Err: err}
+			}
+			if err := m.Verify(); err != nil {
+				return nil, ErrInvalidValidator{PublicKey: validator.PublicKey, Err: err}
+			}
+			if !strings.EqualFold(m.MasterKey, validator.PublicKey) {
+				return nil, ErrInvalidValidator{PublicKey: validator.PublicKey, Err: ErrValidatorManifestMismatch}
+			}
+			validator.Manifest = m
+		}
+
+		list.Validators = append(list.Validators, validator)
+	}
+
+	return list, nil
+}
+
+// rippleTime converts a time in seconds since the Ripple Epoch to a time.Time.
+func rippleTime(t uint32) time.Time {
+	return time.UnixMilli(xrpltime.RippleTimeToUnixTime(int64(t))).UTC()
+}
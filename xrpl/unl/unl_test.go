@@ -0,0 +1,236 @@
+package unl
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/manifest"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	private string
+	public  string
+}
+
+func newTestKey(t *testing.T, entropy string, alg interfaces.KeypairCryptoAlg) testKey {
+	t.Helper()
+
+	seed, err := keypairs.GenerateSeed(entropy, alg, nil)
+	require.NoError(t, err)
+	private, public, err := keypairs.DeriveKeypair(seed, false)
+	require.NoError(t, err)
+
+	return testKey{private: private, public: public}
+}
+
+// newTestManifest returns a base64 manifest binding master to signing, signed by both keys.
+func newTestManifest(t *testing.T, master, signing testKey, sequence uint32) string {
+	t.Helper()
+
+	m := &manifest.Manifest{
+		MasterKey:  master.public,
+		SigningKey: signing.public,
+		Sequence:   sequence,
+	}
+	data, err := m.SigningData()
+	require.NoError(t, err)
+
+	m.MasterSignature, err = keypairs.Sign(string(data), master.private)
+	require.NoError(t, err)
+	m.Signature, err = keypairs.Sign(string(data), signing.private)
+	require.NoError(t, err)
+
+	encoded, err := m.Encode()
+	require.NoError(t, err)
+	return encoded
+}
+
+type testPublisher struct {
+	master   testKey
+	signing  testKey
+	manifest string
+}
+
+func newTestPublisher(t *testing.T) testPublisher {
+	t.Helper()
+
+	p := testPublisher{
+		master:  newTestKey(t, "publisher-master", crypto.ED25519()),
+		signing: newTestKey(t, "publisher-signin", crypto.SECP256K1()),
+	}
+	p.manifest = newTestManifest(t, p.master, p.signing, 1)
+	return p
+}
+
+// blob returns a base64 blob with the given validators and its signature.
+func (p testPublisher) blob(t *testing.T, sequence uint32, effective, expiration time.Time, validators []rawValidator) signedBlob {
+	t.Helper()
+
+	raw := rawBlob{
+		Sequence:   sequence,
+		Expiration: uint32(xrpltime.UnixTimeToRippleTime(expiration.Unix())),
+		Validators: validators,
+	}
+	if !effective.IsZero() {
+		raw.Effective = uint32(xrpltime.UnixTimeToRippleTime(effective.Unix()))
+	}
+
+	b, err := json.Marshal(raw)
+	require.NoError(t, err)
+
+	signature, err := keypairs.Sign(string(b), p.signing.private)
+	require.NoError(t, err)
+
+	return signedBlob{
+		Blob:      base64.StdEncoding.EncodeToString(b),
+		Signature: signature,
+	}
+}
+
+func testValidators(t *testing.T) ([]rawValidator, []string) {
+	t.Helper()
+
+	masters := []testKey{
+		newTestKey(t, "validator-1-mast", crypto.ED25519()),
+		newTestKey(t, "validator-2-mast", crypto.ED25519()),
+		newTestKey(t, "validator-3-mast", crypto.ED25519()),
+	}
+	signing := newTestKey(t, "validator-signin", crypto.SECP256K1())
+
+	validators := []rawValidator{
+		{ValidationPublicKey: masters[0].public, Manifest: newTestManifest(t, masters[0], signing, 1)},
+		{ValidationPublicKey: masters[1].public, Manifest: newTestManifest(t, masters[1], signing, 4)},
+		{ValidationPublicKey: masters[2].public},
+	}
+	return validators, []string{masters[0].public, masters[1].public, masters[2].public}
+}
+
+func TestVerify_Version1(t *testing.T) {
+	now := time.Now()
+	p := newTestPublisher(t)
+	validators, keys := testValidators(t)
+	blob := p.blob(t, 7, time.Time{}, now.Add(24*time.Hour), validators)
+
+	data, err := json.Marshal(rawList{
+		PublicKey: p.master.public,
+		Manifest:  p.manifest,
+		Blob:      blob.Blob,
+		Signature: blob.Signature,
+		Version:   Version1,
+	})
+	require.NoError(t, err)
+
+	list, err := Verify(data, p.master.public)
+	require.NoError(t, err)
+	require.Equal(t, Version1, list.Version)
+	require.Equal(t, p.signing.public, list.PublisherManifest.SigningKey)
+	require.Len(t, list.Lists, 1)
+	require.Equal(t, uint32(7), list.Lists[0].Sequence)
+	require.Equal(t, uint32(4), list.Lists[0].Validators[1].Manifest.Sequence)
+	require.Nil(t, list.Lists[0].Validators[2].Manifest)
+	require.ElementsMatch(t, keys, list.TrustedKeys(now))
+	require.Nil(t, list.TrustedKeys(now.Add(48*time.Hour)))
+}
+
+func TestVerify_Version2(t *testing.T) {
+	now := time.Now()
+	p := newTestPublisher(t)
+	validators, keys := testValidators(t)
+
+	current := p.blob(t, 1, time.Time{}, now.Add(time.Hour), validators[:1])
+	future := p.blob(t, 2, now.Add(30*time.Minute), now.Add(24*time.Hour), validators)
+
+	data, err := json.Marshal(rawList{
+		PublicKey: p.master.public,
+		Manifest:  p.manifest,
+		BlobsV2:   []signedBlob{current, future},
+		Version:   Version2,
+	})
+	require.NoError(t, err)
+
+	list, err := Verify(data, p.master.public)
+	require.NoError(t, err)
+	require.Len(t, list.Lists, 2)
+	require.Equal(t, []string{keys[0]}, list.TrustedKeys(now))
+	require.ElementsMatch(t, keys, list.TrustedKeys(now.Add(45*time.Minute)))
+}
+
+func TestVerify_Errors(t *testing.T) {
+	now := time.Now()
+	p := newTestPublisher(t)
+	validators, _ := testValidators(t)
+	blob := p.blob(t, 1, time.Time{}, now.Add(time.Hour), validators)
+	other := newTestKey(t, "someone-else-key", crypto.ED25519())
+
+	tests := []struct {
+		name     string
+		malleate func(l *rawList)
+		expected error
+	}{
+		{
+			name:     "fail - untrusted publisher",
+			malleate: func(l *rawList) { l.PublicKey = other.public },
+			expected: ErrUntrustedPublisher,
+		},
+		{
+			name:     "fail - publisher manifest for another key",
+			malleate: func(l *rawList) { l.Manifest = newTestManifest(t, other, p.signing, 1) },
+			expected: ErrPublisherManifestMismatch,
+		},
+		{
+			name: "fail - blob signed by another key",
+			malleate: func(l *rawList) {
+				l.Signature, _ = keypairs.Sign("tampered", p.signing.private)
+			},
+			expected: ErrInvalidBlobSignature,
+		},
+		{
+			name:     "fail - unsupported version",
+			malleate: func(l *rawList) { l.Version = 3 },
+			expected: ErrUnsupportedVersion,
+		},
+		{
+			name: "fail - no blobs",
+			malleate: func(l *rawList) {
+				l.Version = Version2
+			},
+			expected: ErrNoBlobs,
+		},
+		{
+			name: "fail - validator manifest for another key",
+			malleate: func(l *rawList) {
+				invalid := append([]rawValidator{}, validators...)
+				invalid[2].Manifest = invalid[0].Manifest
+				b := p.blob(t, 1, time.Time{}, now.Add(time.Hour), invalid)
+				l.Blob, l.Signature = b.Blob, b.Signature
+			},
+			expected: ErrValidatorManifestMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := rawList{
+				PublicKey: p.master.public,
+				Manifest:  p.manifest,
+				Blob:      blob.Blob,
+				Signature: blob.Signature,
+				Version:   Version1,
+			}
+			tt.malleate(&raw)
+
+			data, err := json.Marshal(raw)
+			require.NoError(t, err)
+
+			_, err = Verify(data, p.master.public)
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
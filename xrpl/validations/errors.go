@@ -0,0 +1,14 @@
+package validations
+
+import "errors"
+
+var (
+	// ErrInvalidValidationSignature is returned when the signature of a validation does not verify.
+	ErrInvalidValidationSignature = errors.New("validations: invalid validation signature")
+	// ErrValidationMismatch is returned when the serialized data of a validation does not match its other fields.
+	ErrValidationMismatch = errors.New("validations: serialized data does not match validation fields")
+	// ErrMissingValidationField is returned when a serialized validation lacks a required field.
+	ErrMissingValidationField = errors.New("validations: missing validation field")
+	// ErrInvalidCookie is returned when the cookie or server version of a validation is not a decimal integer.
+	ErrInvalidCookie = errors.New("validations: invalid cookie or server version")
+)
@@ -0,0 +1,314 @@
+package validations
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/manifest"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/unl"
+)
+
+const (
+	// DefaultMaxLedgers is the default number of ledger indexes the Tracker keeps validations for.
+	DefaultMaxLedgers = 256
+)
+
+// FullyValidatedEvent is emitted when a ledger reaches the quorum of trusted validations.
+type FullyValidatedEvent struct {
+	LedgerHash  string
+	LedgerIndex uint32
+	// Validations is the number of trusted validations of the ledger when it reached the quorum.
+	Validations int
+	Quorum      int
+}
+
+// ConflictEvent is emitted when a trusted validator validates two different ledgers with the same index.
+type ConflictEvent struct {
+	// MasterKey is the hex encoded master public key of the validator.
+	MasterKey   string
+	LedgerIndex uint32
+	// LedgerHash is the hash of the ledger the validator validated first. It keeps counting towards the quorum.
+	LedgerHash string
+	// ConflictingHash is the hash of the ledger the validator validated afterwards. It is ignored.
+	ConflictingHash string
+}
+
+// TrackerOpt represents a function that applies a configuration option to a Tracker.
+type TrackerOpt func(t *Tracker)
+
+// WithQuorum returns a TrackerOpt that sets the number of trusted validations a ledger needs
+// to be fully validated. By default, the quorum is 80% of the trusted validators, rounded up.
+func WithQuorum(quorum int) TrackerOpt {
+	return func(t *Tracker) {
+		t.quorum = quorum
+	}
+}
+
+// WithMaxLedgers returns a TrackerOpt that sets the number of ledger indexes, counting back
+// from the highest validated one, the Tracker keeps validations for.
+func WithMaxLedgers(maxLedgers uint32) TrackerOpt {
+	return func(t *Tracker) {
+		t.maxLedgers = maxLedgers
+	}
+}
+
+// Tracker verifies validations and counts the trusted validations of every ledger.
+// Validations signed with an ephemeral key count for the master key of the validator,
+// as declared by its manifest. It is safe for concurrent use.
+type Tracker struct {
+	mu sync.Mutex
+
+	trusted    map[string]struct{}
+	quorum     int
+	maxLedgers uint32
+
+	// manifests holds the current manifest of every master key.
+	manifests map[string]*manifest.Manifest
+	// masterKeys maps the current ephemeral key of every validator to its master key.
+	masterKeys map[string]string
+
+	// votes maps every ledger index to the ledger hash every trusted validator validated.
+	votes map[uint32]map[string]string
+	// counts holds the number of trusted validations of every ledger hash.
+	counts map[string]int
+	// validated holds the ledger hashes that reached the quorum.
+	validated map[string]uint32
+	maxIndex  uint32
+
+	onFullyValidated func(event FullyValidatedEvent)
+	onConflict       func(event ConflictEvent)
+}
+
+// NewTracker creates a new Tracker trusting the given hex encoded master public keys.
+func NewTracker(trustedKeys []string, opts ...TrackerOpt) *Tracker {
+	t := &Tracker{
+		trusted:    make(map[string]struct{}, len(trustedKeys)),
+		maxLedgers: DefaultMaxLedgers,
+		manifests:  make(map[string]*manifest.Manifest),
+		masterKeys: make(map[string]string),
+		votes:      make(map[uint32]map[string]string),
+		counts:     make(map[string]int),
+		validated:  make(map[string]uint32),
+	}
+	for _, key := range trustedKeys {
+		t.trusted[strings.ToUpper(key)] = struct{}{}
+	}
+	t.quorum = defaultQuorum(len(t.trusted))
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
+
+// NewTrackerFromList creates a new Tracker trusting the validators of the list active at the given time,
+// with their manifests. It trusts no validator if no list is active.
+func NewTrackerFromList(list *unl.VerifiedList, now time.Time, opts ...TrackerOpt) *Tracker {
+	t := NewTracker(list.TrustedKeys(now), opts...)
+
+	if active := list.Active(now); active != nil {
+		for _, validator := range active.Validators {
+			if validator.Manifest != nil {
+				t.addManifest(validator.Manifest)
+			}
+		}
+	}
+
+	return t
+}
+
+// OnFullyValidated sets the handler called when a ledger reaches the quorum of trusted validations.
+// The handler is called synchronously by Add, once per ledger.
+func (t *Tracker) OnFullyValidated(handler func(event FullyValidatedEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onFullyValidated = handler
+}
+
+// OnConflict sets the handler called when a trusted validator validates two different ledgers
+// with the same index. The handler is called synchronously by Add.
+func (t *Tracker) OnConflict(handler func(event ConflictEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onConflict = handler
+}
+
+// Quorum returns the number of trusted validations a ledger needs to be fully validated.
+func (t *Tracker) Quorum() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.quorum
+}
+
+// AddManifest verifies a manifest and, if it is newer than the current manifest of its master key,
+// maps its ephemeral key to its master key. A revocation stops counting the validations of the validator.
+func (t *Tracker) AddManifest(m *manifest.Manifest) error {
+	if err := m.Verify(); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.addManifest(m)
+
+	return nil
+}
+
+// addManifest adds a verified manifest. It must be called with the lock held, or before the Tracker is shared.
+func (t *Tracker) addManifest(m *manifest.Manifest) {
+	masterKey := strings.ToUpper(m.MasterKey)
+
+	current, ok := t.manifests[masterKey]
+	if ok {
+		if current.Sequence >= m.Sequence {
+			return
+		}
+		delete(t.masterKeys, strings.ToUpper(current.SigningKey))
+	}
+
+	t.manifests[masterKey] = m
+	if !m.IsRevoked() && m.SigningKey != "" {
+		t.masterKeys[strings.ToUpper(m.SigningKey)] = masterKey
+	}
+}
+
+// Add verifies a validation received from the validations stream and, if it is a full validation
+// from a trusted validator, counts it towards the quorum of its ledger.
+// It returns the verified validation, and whether it was counted.
+func (t *Tracker) Add(stream *streamtypes.ValidationStream) (*Validation, bool, error) {
+	v, err := Verify(stream)
+	if err != nil {
+		return nil, false, err
+	}
+
+	t.mu.Lock()
+	counted, fullyValidated, conflict := t.count(v)
+	onFullyValidated, onConflict := t.onFullyValidated, t.onConflict
+	t.mu.Unlock()
+
+	if conflict != nil && onConflict != nil {
+		onConflict(*conflict)
+	}
+	if fullyValidated != nil && onFullyValidated != nil {
+		onFullyValidated(*fullyValidated)
+	}
+
+	return v, counted, nil
+}
+
+// count counts a verified validation and returns whether it was counted, and the events it triggered.
+// It must be called with the lock held.
+func (t *Tracker) count(v *Validation) (bool, *FullyValidatedEvent, *ConflictEvent) {
+	if !v.IsFull() {
+		return false, nil, nil
+	}
+
+	masterKey, ok := t.masterKey(v.SigningKey)
+	if !ok {
+		return false, nil, nil
+	}
+	if _, ok := t.trusted[masterKey]; !ok {
+		return false, nil, nil
+	}
+	if t.maxIndex >= t.maxLedgers && v.LedgerIndex <= t.maxIndex-t.maxLedgers {
+		return false, nil, nil
+	}
+
+	ledgerHash := strings.ToUpper(v.LedgerHash)
+
+	votes, ok := t.votes[v.LedgerIndex]
+	if !ok {
+		votes = make(map[string]string)
+		t.votes[v.LedgerIndex] = votes
+	}
+	if previous, ok := votes[masterKey]; ok {
+		if previous == ledgerHash {
+			return false, nil, nil
+		}
+		return false, nil, &ConflictEvent{
+			MasterKey:       masterKey,
+			LedgerIndex:     v.LedgerIndex,
+			LedgerHash:      previous,
+			ConflictingHash: ledgerHash,
+		}
+	}
+
+	votes[masterKey] = ledgerHash
+	t.counts[ledgerHash]++
+
+	var fullyValidated *FullyValidatedEvent
+	if _, ok := t.validated[ledgerHash]; !ok && t.counts[ledgerHash] >= t.quorum {
+		t.validated[ledgerHash] = v.LedgerIndex
+		fullyValidated = &FullyValidatedEvent{
+			LedgerHash:  ledgerHash,
+			LedgerIndex: v.LedgerIndex,
+			Validations: t.counts[ledgerHash],
+			Quorum:      t.quorum,
+		}
+	}
+
+	if v.LedgerIndex > t.maxIndex {
+		t.maxIndex = v.LedgerIndex
+		t.prune()
+	}
+
+	return true, fullyValidated, nil
+}
+
+// masterKey returns the master key of the validator signing with the given key.
+// Validators without a manifest sign with their master key. It returns false if the
+// master key of the validator is revoked, or if the key is a replaced ephemeral key.
+func (t *Tracker) masterKey(signingKey string) (string, bool) {
+	signingKey = strings.ToUpper(signingKey)
+
+	if masterKey, ok := t.masterKeys[signingKey]; ok {
+		return masterKey, true
+	}
+	if _, ok := t.manifests[signingKey]; ok {
+		// Validators with a manifest must sign with their ephemeral key.
+		return "", false
+	}
+	return signingKey, true
+}
+
+// prune forgets the validations of the ledgers too old to be tracked. It must be called with the lock held.
+func (t *Tracker) prune() {
+	if t.maxIndex < t.maxLedgers {
+		return
+	}
+	minIndex := t.maxIndex - t.maxLedgers
+
+	for index, votes := range t.votes {
+		if index > minIndex {
+			continue
+		}
+		for _, hash := range votes {
+			delete(t.counts, hash)
+			delete(t.validated, hash)
+		}
+		delete(t.votes, index)
+	}
+}
+
+// Validations returns the number of trusted validations of the given ledger.
+func (t *Tracker) Validations(ledgerHash string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.counts[strings.ToUpper(ledgerHash)]
+}
+
+// IsFullyValidated reports whether the given ledger reached the quorum of trusted validations.
+func (t *Tracker) IsFullyValidated(ledgerHash string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.validated[strings.ToUpper(ledgerHash)]
+	return ok
+}
+
+// defaultQuorum returns 80% of the given number of trusted validators, rounded up, and at least 1.
+func defaultQuorum(trusted int) int {
+	return max((trusted*4+4)/5, 1)
+}
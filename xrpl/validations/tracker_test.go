@@ -0,0 +1,194 @@
+package validations
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeys(t *testing.T, n int) []testKey {
+	t.Helper()
+
+	keys := make([]testKey, n)
+	for i := range keys {
+		keys[i] = newTestKey(t, fmt.Sprintf("%03d-validator-key", i))
+	}
+	return keys
+}
+
+func publicKeys(keys []testKey) []string {
+	public := make([]string, len(keys))
+	for i, key := range keys {
+		public[i] = key.public
+	}
+	return public
+}
+
+func newTestManifest(t *testing.T, master, signing testKey, sequence uint32) *manifest.Manifest {
+	t.Helper()
+
+	m := &manifest.Manifest{
+		MasterKey: master.public,
+		Sequence:  sequence,
+	}
+	if sequence != manifest.RevokedSequence {
+		m.SigningKey = signing.public
+	}
+
+	data, err := m.SigningData()
+	require.NoError(t, err)
+	m.MasterSignature, err = keypairs.Sign(string(data), master.private)
+	require.NoError(t, err)
+	if m.SigningKey != "" {
+		m.Signature, err = keypairs.Sign(string(data), signing.private)
+		require.NoError(t, err)
+	}
+
+	return m
+}
+
+func TestNewTracker_Quorum(t *testing.T) {
+	require.Equal(t, 1, NewTracker(nil).Quorum())
+	require.Equal(t, 4, NewTracker(publicKeys(newTestKeys(t, 5))).Quorum())
+	require.Equal(t, 2, NewTracker(publicKeys(newTestKeys(t, 5)), WithQuorum(2)).Quorum())
+}
+
+func TestTracker_FullyValidated(t *testing.T) {
+	keys := newTestKeys(t, 5)
+	tracker := NewTracker(publicKeys(keys))
+
+	var events []FullyValidatedEvent
+	tracker.OnFullyValidated(func(event FullyValidatedEvent) {
+		events = append(events, event)
+	})
+
+	for i, key := range keys {
+		_, counted, err := tracker.Add(newTestValidation(t, key, 10, testLedgerHash, FullValidationFlag))
+		require.NoError(t, err)
+		require.True(t, counted)
+		require.Equal(t, i+1, tracker.Validations(testLedgerHash))
+		require.Equal(t, i >= 3, tracker.IsFullyValidated(testLedgerHash))
+	}
+
+	require.Equal(t, []FullyValidatedEvent{
+		{LedgerHash: testLedgerHash, LedgerIndex: 10, Validations: 4, Quorum: 4},
+	}, events)
+}
+
+func TestTracker_IgnoredValidations(t *testing.T) {
+	keys := newTestKeys(t, 2)
+	tracker := NewTracker([]string{keys[0].public})
+
+	_, counted, err := tracker.Add(newTestValidation(t, keys[1], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted, "untrusted validator")
+
+	_, counted, err = tracker.Add(newTestValidation(t, keys[0], 10, testLedgerHash, 0))
+	require.NoError(t, err)
+	require.False(t, counted, "partial validation")
+
+	invalid := newTestValidation(t, keys[0], 10, testLedgerHash, FullValidationFlag)
+	invalid.Data = ""
+	invalid.SigningTime++
+	_, _, err = tracker.Add(invalid)
+	require.ErrorIs(t, err, ErrInvalidValidationSignature)
+
+	require.Zero(t, tracker.Validations(testLedgerHash))
+}
+
+func TestTracker_Conflict(t *testing.T) {
+	keys := newTestKeys(t, 3)
+	tracker := NewTracker(publicKeys(keys))
+
+	var conflicts []ConflictEvent
+	tracker.OnConflict(func(event ConflictEvent) {
+		conflicts = append(conflicts, event)
+	})
+
+	_, counted, err := tracker.Add(newTestValidation(t, keys[0], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.True(t, counted)
+
+	_, counted, err = tracker.Add(newTestValidation(t, keys[0], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted, "duplicate validation")
+	require.Empty(t, conflicts)
+
+	_, counted, err = tracker.Add(newTestValidation(t, keys[0], 10, testOtherHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted, "conflicting validation")
+
+	require.Equal(t, []ConflictEvent{
+		{MasterKey: keys[0].public, LedgerIndex: 10, LedgerHash: testLedgerHash, ConflictingHash: testOtherHash},
+	}, conflicts)
+	require.Equal(t, 1, tracker.Validations(testLedgerHash))
+	require.Zero(t, tracker.Validations(testOtherHash))
+}
+
+func TestTracker_Manifests(t *testing.T) {
+	master := newTestKey(t, "validator-master")
+	ephemeral := newTestKeys(t, 2)
+	tracker := NewTracker([]string{master.public})
+
+	// Without a manifest, the ephemeral key is not trusted.
+	_, counted, err := tracker.Add(newTestValidation(t, ephemeral[0], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted)
+
+	require.NoError(t, tracker.AddManifest(newTestManifest(t, master, ephemeral[0], 1)))
+
+	_, counted, err = tracker.Add(newTestValidation(t, ephemeral[0], 11, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.True(t, counted)
+
+	// Validators with a manifest must sign with their ephemeral key.
+	_, counted, err = tracker.Add(newTestValidation(t, master, 12, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted)
+
+	// A newer manifest replaces the ephemeral key, and an older one is ignored.
+	require.NoError(t, tracker.AddManifest(newTestManifest(t, master, ephemeral[1], 2)))
+	require.NoError(t, tracker.AddManifest(newTestManifest(t, master, ephemeral[0], 1)))
+
+	_, counted, err = tracker.Add(newTestValidation(t, ephemeral[0], 13, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted)
+	_, counted, err = tracker.Add(newTestValidation(t, ephemeral[1], 13, testOtherHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.True(t, counted)
+
+	// A revocation stops counting the validator.
+	require.NoError(t, tracker.AddManifest(newTestManifest(t, master, testKey{}, manifest.RevokedSequence)))
+
+	_, counted, err = tracker.Add(newTestValidation(t, ephemeral[1], 14, testOtherHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted)
+
+	// Invalid manifests are rejected.
+	invalid := newTestManifest(t, master, ephemeral[0], 3)
+	invalid.Signature = hex.EncodeToString([]byte("invalid"))
+	require.Error(t, tracker.AddManifest(invalid))
+}
+
+func TestTracker_Prune(t *testing.T) {
+	keys := newTestKeys(t, 1)
+	tracker := NewTracker(publicKeys(keys), WithMaxLedgers(2))
+
+	_, counted, err := tracker.Add(newTestValidation(t, keys[0], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.True(t, counted)
+	require.True(t, tracker.IsFullyValidated(testLedgerHash))
+
+	_, counted, err = tracker.Add(newTestValidation(t, keys[0], 12, testOtherHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.True(t, counted)
+	require.False(t, tracker.IsFullyValidated(testLedgerHash))
+
+	_, counted, err = tracker.Add(newTestValidation(t, keys[0], 10, testLedgerHash, FullValidationFlag))
+	require.NoError(t, err)
+	require.False(t, counted, "too old ledger")
+}
@@ -0,0 +1,179 @@
+// Package validations verifies ledger validations received from the validations stream
+// and tracks which ledgers are fully validated by a set of trusted validators.
+//
+// It allows clients to judge ledger validation independently, instead of trusting
+// the validated flag of a single server.
+package validations
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+)
+
+const (
+	// FullValidationFlag is set on full validations. Partial validations do not vote for any ledger.
+	FullValidationFlag uint32 = 0x00000001
+)
+
+// Validation is a verified ledger validation.
+type Validation struct {
+	// LedgerHash is the hash of the validated ledger.
+	LedgerHash string
+	// LedgerIndex is the index of the validated ledger.
+	LedgerIndex uint32
+	// SigningKey is the hex encoded public key that signed the validation.
+	// It is the ephemeral key of validators using a manifest.
+	SigningKey string
+	// SigningTime is the time the validation was signed.
+	SigningTime time.Time
+	// Flags are the flags of the validation.
+	Flags uint32
+}
+
+// IsFull reports whether the validation is a full validation.
+func (v *Validation) IsFull() bool {
+	return v.Flags&FullValidationFlag != 0
+}
+
+// Verify verifies the signature of a validation received from the validations stream
+// and returns the verified validation.
+//
+// If the stream message includes the serialized validation (data), the signature is verified
+// against it and the returned fields are read from it. Otherwise, the serialized validation is
+// rebuilt from the fields of the message, which only succeeds if the message includes every
+// field signed by the validator.
+func Verify(stream *streamtypes.ValidationStream) (*Validation, error) {
+	fields, err := validationFields(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _ := fields["Signature"].(string)
+	signingKey, _ := fields["SigningPubKey"].(string)
+	ledgerHash, _ := fields["LedgerHash"].(string)
+	ledgerIndex, okIndex := fields["LedgerSequence"].(uint32)
+	signingTime, okTime := fields["SigningTime"].(uint32)
+	flags, okFlags := fields["Flags"].(uint32)
+	if signature == "" || signingKey == "" || ledgerHash == "" || !okIndex || !okTime || !okFlags {
+		return nil, ErrMissingValidationField
+	}
+
+	encoded, err := binarycodec.EncodeForSigningValidation(fields)
+	if err != nil {
+		return nil, err
+	}
+	data, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := keypairs.Validate(string(data), signingKey, signature)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidValidationSignature
+	}
+
+	return &Validation{
+		LedgerHash:  ledgerHash,
+		LedgerIndex: ledgerIndex,
+		SigningKey:  signingKey,
+		SigningTime: time.UnixMilli(xrpltime.RippleTimeToUnixTime(int64(signingTime))).UTC(),
+		Flags:       flags,
+	}, nil
+}
+
+// validationFields returns the fields of the serialized validation, in the binary-codec JSON format.
+func validationFields(stream *streamtypes.ValidationStream) (map[string]any, error) {
+	if stream.Data != "" {
+		fields, err := binarycodec.Decode(stream.Data)
+		if err != nil {
+			return nil, err
+		}
+		if hash, _ := fields["LedgerHash"].(string); !strings.EqualFold(hash, string(stream.LedgerHash)) {
+			return nil, ErrValidationMismatch
+		}
+		return fields, nil
+	}
+	return rebuildFields(stream)
+}
+
+// rebuildFields rebuilds the fields of the serialized validation from the fields of the stream message.
+func rebuildFields(stream *streamtypes.ValidationStream) (map[string]any, error) {
+	signingKey, err := addresscodec.DecodeNodePublicKey(stream.ValidationPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]any{
+		"Flags":          stream.Flags,
+		"LedgerSequence": uint32(stream.LedgerIndex),
+		"SigningTime":    uint32(stream.SigningTime),
+		"LedgerHash":     string(stream.LedgerHash),
+		"SigningPubKey":  strings.ToUpper(hex.EncodeToString(signingKey)),
+		"Signature":      stream.Signature,
+	}
+
+	if stream.ValidatedHash != "" {
+		fields["ValidatedHash"] = stream.ValidatedHash
+	}
+	if stream.LoadFee != 0 {
+		fields["LoadFee"] = uint32(stream.LoadFee)
+	}
+	if stream.BaseFee != 0 {
+		fields["BaseFee"] = fmt.Sprintf("%016X", stream.BaseFee)
+	}
+	if stream.ReserveBase != 0 {
+		fields["ReserveBase"] = uint32(stream.ReserveBase)
+	}
+	if stream.ReserveInc != 0 {
+		fields["ReserveIncrement"] = uint32(stream.ReserveInc)
+	}
+	if stream.NetworkID != 0 {
+		fields["NetworkID"] = stream.NetworkID
+	}
+	if len(stream.Amendments) > 0 {
+		fields["Amendments"] = stream.Amendments
+	}
+
+	for name, value := range map[string]any{"Cookie": stream.Cookie, "ServerVersion": stream.ServerVersion} {
+		if value == nil {
+			continue
+		}
+		u, err := parseUint64(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = fmt.Sprintf("%016X", u)
+	}
+
+	return fields, nil
+}
+
+// parseUint64 parses a decimal integer, encoded as a JSON string or number.
+func parseUint64(value any) (uint64, error) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		s = fmt.Sprint(v)
+	}
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCookie
+	}
+	return u, nil
+}
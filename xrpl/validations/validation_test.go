@@ -0,0 +1,147 @@
+package validations
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLedgerHash = "4B5E9C2B8FBB1C1B4F7D0D8E7E1F6D0C1A2B3C4D5E6F708192A3B4C5D6E7F809"
+	testOtherHash  = "0A5E9C2B8FBB1C1B4F7D0D8E7E1F6D0C1A2B3C4D5E6F708192A3B4C5D6E7F809"
+	testAmendment  = "56B241D7A43D40354D02A9DC4C8DF5C7A1F930D92A9035C4E12291B3CA3E1C2B"
+)
+
+type testKey struct {
+	private string
+	public  string
+}
+
+func newTestKey(t *testing.T, entropy string) testKey {
+	t.Helper()
+
+	seed, err := keypairs.GenerateSeed(entropy, crypto.SECP256K1(), nil)
+	require.NoError(t, err)
+	private, public, err := keypairs.DeriveKeypair(seed, false)
+	require.NoError(t, err)
+
+	return testKey{private: private, public: public}
+}
+
+// newTestValidation returns a validations stream message signed by key, with its serialized data.
+func newTestValidation(t *testing.T, key testKey, ledgerIndex uint32, ledgerHash string, flags uint32) *streamtypes.ValidationStream {
+	t.Helper()
+
+	fields := func() map[string]any {
+		return map[string]any{
+			"Flags":          flags,
+			"LedgerSequence": ledgerIndex,
+			"SigningTime":    uint32(770000000),
+			"Cookie":         "00000000075BCD15",
+			"LedgerHash":     ledgerHash,
+			"ValidatedHash":  testOtherHash,
+			"Amendments":     []string{testAmendment},
+			"SigningPubKey":  key.public,
+		}
+	}
+
+	encoded, err := binarycodec.EncodeForSigningValidation(fields())
+	require.NoError(t, err)
+	data, err := hex.DecodeString(encoded)
+	require.NoError(t, err)
+	signature, err := keypairs.Sign(string(data), key.private)
+	require.NoError(t, err)
+
+	signed := fields()
+	signed["Signature"] = signature
+	serialized, err := binarycodec.Encode(signed)
+	require.NoError(t, err)
+
+	pub, err := hex.DecodeString(key.public)
+	require.NoError(t, err)
+	nodeKey, err := addresscodec.EncodeNodePublicKey(pub)
+	require.NoError(t, err)
+
+	return &streamtypes.ValidationStream{
+		Type:                "validationReceived",
+		Amendments:          []string{testAmendment},
+		Cookie:              "123456789",
+		Data:                serialized,
+		Flags:               flags,
+		Full:                flags&FullValidationFlag != 0,
+		LedgerHash:          common.LedgerHash(ledgerHash),
+		LedgerIndex:         common.LedgerIndex(ledgerIndex),
+		Signature:           signature,
+		SigningTime:         770000000,
+		ValidatedHash:       testOtherHash,
+		ValidationPublicKey: nodeKey,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	key := newTestKey(t, "validator-signin")
+
+	tests := []struct {
+		name     string
+		malleate func(s *streamtypes.ValidationStream)
+		expected error
+	}{
+		{
+			name:     "pass - serialized data",
+			malleate: func(*streamtypes.ValidationStream) {},
+		},
+		{
+			name:     "pass - rebuilt from fields",
+			malleate: func(s *streamtypes.ValidationStream) { s.Data = "" },
+		},
+		{
+			name: "fail - rebuilt from tampered fields",
+			malleate: func(s *streamtypes.ValidationStream) {
+				s.Data = ""
+				s.LedgerIndex++
+			},
+			expected: ErrInvalidValidationSignature,
+		},
+		{
+			name:     "fail - data for another ledger",
+			malleate: func(s *streamtypes.ValidationStream) { s.LedgerHash = testOtherHash },
+			expected: ErrValidationMismatch,
+		},
+		{
+			name: "fail - invalid cookie",
+			malleate: func(s *streamtypes.ValidationStream) {
+				s.Data = ""
+				s.Cookie = "not a number"
+			},
+			expected: ErrInvalidCookie,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestValidation(t, key, 42, testLedgerHash, FullValidationFlag)
+			tt.malleate(s)
+
+			v, err := Verify(s)
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testLedgerHash, v.LedgerHash)
+			require.Equal(t, uint32(42), v.LedgerIndex)
+			require.Equal(t, strings.ToUpper(key.public), v.SigningKey)
+			require.Equal(t, int64(770000000+946684800), v.SigningTime.Unix())
+			require.True(t, v.IsFull())
+		})
+	}
+}
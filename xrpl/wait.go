@@ -0,0 +1,109 @@
+package xrpl
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/admin"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+)
+
+const (
+	// txnNotFound is the error message returned by the xrpl node when requesting for a not found transaction.
+	txnNotFound = "txnNotFound"
+)
+
+// WaitForTransaction polls the server until the transaction with the given hash is validated,
+// is included in a ledger at or after lastLedgerSequence, or until the current ledger passes lastLedgerSequence.
+// It polls at most maxRetries times, waiting retryDelay between attempts.
+// It returns ErrTransactionNotFound if the transaction was never found.
+func WaitForTransaction(q TxQuerier, txHash string, lastLedgerSequence uint32, maxRetries int, retryDelay time.Duration) (*transactions.TxResponse, error) {
+	var txResponse *transactions.TxResponse
+	i := 0
+
+	for i < maxRetries {
+		// Get the current ledger index
+		currentLedger, err := q.GetLedgerIndex()
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the transaction has been included in the current ledger
+		if currentLedger.Int() >= int(lastLedgerSequence) {
+			break
+		}
+
+		// Request the transaction from the server
+		res, err := q.GetTx(&transactions.TxRequest{
+			Transaction: txHash,
+		})
+		if err != nil && !strings.Contains(err.Error(), txnNotFound) {
+			return nil, err
+		}
+
+		if res != nil {
+			txResponse = res
+
+			// A validated transaction is final, so there is no need to wait for lastLedgerSequence
+			if txResponse.Validated {
+				break
+			}
+
+			// Check if the transaction has been included in the current ledger
+			if txResponse.LedgerIndex.Int() >= int(lastLedgerSequence) {
+				break
+			}
+		}
+
+		// Wait for the retry delay before retrying
+		time.Sleep(retryDelay)
+		i++
+	}
+
+	if txResponse == nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	return txResponse, nil
+}
+
+// WaitForTransactionStandalone closes ledgers with ledger_accept, on a server in stand-alone mode, until the
+// transaction with the given hash is validated or a ledger at or after lastLedgerSequence is closed.
+// It closes at most maxLedgers ledgers, without waiting between them.
+// It returns ErrTransactionNotFound if the transaction was never found.
+func WaitForTransactionStandalone(q StandaloneTxQuerier, txHash string, lastLedgerSequence uint32, maxLedgers int) (*transactions.TxResponse, error) {
+	var txResponse *transactions.TxResponse
+
+	for i := 0; i < maxLedgers; i++ {
+		accepted, err := q.LedgerAccept(&admin.LedgerAcceptRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := q.GetTx(&transactions.TxRequest{
+			Transaction: txHash,
+		})
+		if err != nil && !strings.Contains(err.Error(), txnNotFound) {
+			return nil, err
+		}
+
+		if res != nil {
+			txResponse = res
+			if txResponse.Validated {
+				break
+			}
+		}
+
+		// The closed ledger is the one before the new current ledger. Once it reaches lastLedgerSequence,
+		// the transaction can no longer be included in a ledger.
+		if accepted.LedgerCurrentIndex.Int()-1 >= int(lastLedgerSequence) {
+			break
+		}
+	}
+
+	if txResponse == nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	return txResponse, nil
+}
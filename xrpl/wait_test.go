@@ -0,0 +1,139 @@
+package xrpl
+
+import (
+	"errors"
+	"testing"
+
+	querycommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForTransaction(t *testing.T) {
+	errServer := errors.New("server error")
+
+	tests := []struct {
+		name        string
+		q           *mockQuerier
+		expected    *transactions.TxResponse
+		txCalls     int
+		expectedErr error
+	}{
+		{
+			name: "pass - transaction included",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{10},
+				tx:          &transactions.TxResponse{LedgerIndex: 20, Validated: true},
+			},
+			expected: &transactions.TxResponse{LedgerIndex: 20, Validated: true},
+			txCalls:  1,
+		},
+		{
+			name: "pass - transaction validated before last ledger sequence",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{10},
+				tx:          &transactions.TxResponse{LedgerIndex: 11, Validated: true},
+			},
+			expected: &transactions.TxResponse{LedgerIndex: 11, Validated: true},
+			txCalls:  1,
+		},
+		{
+			name: "pass - unvalidated transaction polled until max retries",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{10},
+				tx:          &transactions.TxResponse{LedgerIndex: 11},
+			},
+			expected: &transactions.TxResponse{LedgerIndex: 11},
+			txCalls:  5,
+		},
+		{
+			name: "fail - transaction never found",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{10, 11, 20},
+				txErr:       errors.New("txnNotFound"),
+			},
+			expectedErr: ErrTransactionNotFound,
+		},
+		{
+			name: "fail - server error",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{10},
+				txErr:       errServer,
+			},
+			expectedErr: errServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := WaitForTransaction(tt.q, "HASH", 20, 5, 0)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+			require.Equal(t, tt.txCalls, tt.q.txCalls)
+		})
+	}
+}
+
+func TestWaitForTransactionStandalone(t *testing.T) {
+	errServer := errors.New("server error")
+
+	tests := []struct {
+		name        string
+		q           *mockQuerier
+		expected    *transactions.TxResponse
+		accepted    int
+		expectedErr error
+	}{
+		{
+			name: "pass - transaction validated in the first closed ledger",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{11},
+				tx:          &transactions.TxResponse{LedgerIndex: 10, Validated: true},
+			},
+			expected: &transactions.TxResponse{LedgerIndex: 10, Validated: true},
+			accepted: 1,
+		},
+		{
+			name: "pass - ledgers closed until last ledger sequence",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{11, 12, 13},
+				tx:          &transactions.TxResponse{},
+			},
+			expected: &transactions.TxResponse{},
+			accepted: 2,
+		},
+		{
+			name: "fail - transaction never found",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{11, 12, 13},
+				txErr:       errors.New("txnNotFound"),
+			},
+			expectedErr: ErrTransactionNotFound,
+		},
+		{
+			name: "fail - server error",
+			q: &mockQuerier{
+				ledgerIndex: []querycommon.LedgerIndex{11},
+				txErr:       errServer,
+			},
+			expectedErr: errServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := WaitForTransactionStandalone(tt.q, "HASH", 11, 5)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+			require.Equal(t, tt.accepted, tt.q.accepted)
+		})
+	}
+}
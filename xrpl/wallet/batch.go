@@ -2,11 +2,9 @@ package wallet
 
 import (
 	"cmp"
-	"encoding/hex"
 	"slices"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/keypairs"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	wallettypes "github.com/Peersyst/xrpl-go/xrpl/wallet/types"
@@ -77,12 +75,7 @@ func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMu
 		return err
 	}
 
-	hexBatch, err := hex.DecodeString(encodedBatch)
-	if err != nil {
-		return err
-	}
-
-	signature, err := keypairs.Sign(string(hexBatch), wallet.PrivateKey)
+	signature, err := wallet.computeSignature(encodedBatch)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// nolint
+func BenchmarkSign(b *testing.B) {
+	w := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	tx := &transaction.OfferCreate{
+		BaseTx: transaction.BaseTx{
+			Account:            "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+			Fee:                types.XRPCurrencyAmount(12),
+			Sequence:           1798962,
+			LastLedgerSequence: 1799000,
+			Memos: []types.MemoWrapper{
+				{Memo: types.Memo{MemoType: "687474703A2F2F6578616D706C652E636F6D", MemoData: "72656E74"}},
+			},
+		},
+		TakerGets: types.XRPCurrencyAmount(1000000),
+		TakerPays: types.IssuedCurrencyAmount{
+			Issuer:   "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			Currency: "USD",
+			Value:    "1.5",
+		},
+	}
+
+	b.Run("Sign flattened transaction", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := w.Sign(tx.Flatten()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("SignTx", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := w.SignTx(tx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// nolint
+func BenchmarkSignTx_PrivateKey(b *testing.B) {
+	seeds := []struct{ name, seed string }{
+		{"ed25519", "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"},
+		{"secp256k1", "sh1HiK7SwjS1VxFdXi7qeMHRedrYX"},
+	}
+	for _, s := range seeds {
+		w, err := FromSeed(s.seed, "")
+		if err != nil {
+			b.Fatal(err)
+		}
+		signer, err := w.Signer()
+		if err != nil {
+			b.Fatal(err)
+		}
+		tx := &transaction.Payment{
+			BaseTx: transaction.BaseTx{
+				Account:  w.ClassicAddress,
+				Fee:      types.XRPCurrencyAmount(12),
+				Sequence: 1798962,
+			},
+			Amount:      types.XRPCurrencyAmount(1000000),
+			Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		}
+
+		b.Run(s.name+" wallet", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := w.SignTx(tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(s.name+" signer", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := signer.SignTx(tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
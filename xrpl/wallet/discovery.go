@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+)
+
+const (
+	// DefaultGapLimit is the number of consecutive unfunded accounts after which discovery stops, as in BIP44.
+	DefaultGapLimit = 20
+
+	// actNotFound is the error message returned by the xrpl node when requesting a not found account.
+	actNotFound = "actNotFound"
+)
+
+// AccountInfoGetter retrieves the information of an account. It is implemented by rpc.Client and websocket.Client.
+type AccountInfoGetter interface {
+	GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error)
+}
+
+// DiscoveryScan is the derivation path component scanned by Discover.
+type DiscoveryScan int
+
+const (
+	// ScanIndex scans the address index, m/44'/144'/account'/change/i, as most software wallets.
+	ScanIndex DiscoveryScan = iota
+	// ScanAccount scans the account, m/44'/144'/i'/change/index, as some hardware wallets.
+	ScanAccount
+)
+
+// DiscoveredWallet is a funded wallet found by Discover.
+type DiscoveredWallet struct {
+	Wallet *Wallet
+	Path   DerivationPath
+	Info   *account.InfoResponse
+}
+
+// Discover derives wallets from a BIP39 mnemonic along the scanned component of the derivation path,
+// starting from the path set with WithDerivationPath, and returns the wallets funded in the validated ledger.
+// It stops after gapLimit consecutive unfunded wallets. If gapLimit is not positive, DefaultGapLimit is used.
+// Other errors returned by the client stop the discovery and are returned.
+func Discover(client AccountInfoGetter, mnemonic string, scan DiscoveryScan, gapLimit int, opts ...MnemonicOpt) ([]DiscoveredWallet, error) {
+	o := mnemonicOptions{path: DefaultDerivationPath}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if gapLimit <= 0 {
+		gapLimit = DefaultGapLimit
+	}
+
+	var discovered []DiscoveredWallet
+	for gap, path := 0, o.path; gap < gapLimit; gap++ {
+		o.path = path
+		w, err := fromBIP39Mnemonic(mnemonic, o)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := client.GetAccountInfo(&account.InfoRequest{
+			Account:     w.ClassicAddress,
+			LedgerIndex: common.LedgerTitle("validated"),
+		})
+		switch {
+		case err == nil:
+			discovered = append(discovered, DiscoveredWallet{Wallet: w, Path: path, Info: info})
+			gap = -1
+		case !strings.Contains(err.Error(), actNotFound):
+			return nil, err
+		}
+
+		switch scan {
+		case ScanAccount:
+			path.Account++
+		default:
+			path.Index++
+		}
+	}
+
+	return discovered, nil
+}
@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAccountInfoGetter returns account info for the funded addresses and actNotFound for the others.
+type mockAccountInfoGetter struct {
+	funded   map[types.Address]bool
+	err      error
+	requests int
+}
+
+func (m *mockAccountInfoGetter) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error) {
+	m.requests++
+	if m.err != nil {
+		return nil, m.err
+	}
+	if !m.funded[req.Account] {
+		return nil, errors.New(actNotFound)
+	}
+	return &account.InfoResponse{Validated: true}, nil
+}
+
+// addressAt returns the address derived from the test mnemonic at the given path.
+func addressAt(t *testing.T, path DerivationPath) types.Address {
+	t.Helper()
+
+	w, err := FromMnemonic(testMnemonic, WithDerivationPath(path))
+	require.NoError(t, err)
+
+	return w.ClassicAddress
+}
+
+func TestDiscover(t *testing.T) {
+	t.Run("pass - scan index", func(t *testing.T) {
+		client := &mockAccountInfoGetter{funded: map[types.Address]bool{
+			addressAt(t, DerivationPath{Index: 0}): true,
+			addressAt(t, DerivationPath{Index: 3}): true,
+		}}
+
+		discovered, err := Discover(client, testMnemonic, ScanIndex, 3)
+		require.NoError(t, err)
+		require.Len(t, discovered, 2)
+		require.Equal(t, DerivationPath{Index: 0}, discovered[0].Path)
+		require.Equal(t, DerivationPath{Index: 3}, discovered[1].Path)
+		require.Equal(t, addressAt(t, DerivationPath{Index: 3}), discovered[1].Wallet.ClassicAddress)
+		// Indexes 0 to 3, then 3 unfunded indexes.
+		require.Equal(t, 7, client.requests)
+	})
+
+	t.Run("pass - scan account from a start path", func(t *testing.T) {
+		client := &mockAccountInfoGetter{funded: map[types.Address]bool{
+			addressAt(t, DerivationPath{Account: 2, Change: 1}): true,
+		}}
+
+		discovered, err := Discover(client, testMnemonic, ScanAccount, 2, WithDerivationPath(DerivationPath{Account: 1, Change: 1}))
+		require.NoError(t, err)
+		require.Len(t, discovered, 1)
+		require.Equal(t, DerivationPath{Account: 2, Change: 1}, discovered[0].Path)
+	})
+
+	t.Run("pass - default gap limit", func(t *testing.T) {
+		client := &mockAccountInfoGetter{}
+
+		discovered, err := Discover(client, testMnemonic, ScanIndex, 0)
+		require.NoError(t, err)
+		require.Empty(t, discovered)
+		require.Equal(t, DefaultGapLimit, client.requests)
+	})
+
+	t.Run("fail - client error", func(t *testing.T) {
+		client := &mockAccountInfoGetter{err: errors.New("connection refused")}
+
+		_, err := Discover(client, testMnemonic, ScanIndex, 0)
+		require.EqualError(t, err, "connection refused")
+	})
+}
@@ -1,6 +1,9 @@
 package wallet
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// address
@@ -8,6 +11,33 @@ var (
 	// ErrAddressTagNotZero is returned when the address tag is not zero.
 	ErrAddressTagNotZero = errors.New("address tag is not zero")
 
+	// mnemonic
+
+	// ErrUnknownMnemonicEncoding is returned when the mnemonic encoding is not supported.
+	ErrUnknownMnemonicEncoding = errors.New("unknown mnemonic encoding")
+	// ErrInvalidDerivationPath is returned when a derivation path is not of the form m/44'/144'/account'/change/index.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path, expected m/44'/144'/account'/change/index")
+	// ErrInvalidRFC1751SeedLength is returned when an RFC1751 mnemonic does not encode a 16 bytes family seed.
+	ErrInvalidRFC1751SeedLength = errors.New("RFC1751 mnemonic must encode a 16 bytes family seed")
+
+	// message
+
+	// ErrInvalidMessageSignature is returned when a message signature is not valid for the message and the public key.
+	ErrInvalidMessageSignature = errors.New("invalid message signature")
+	// ErrMessageKeyAddressMismatch is returned when the public key of a signed message does not derive the address.
+	ErrMessageKeyAddressMismatch = errors.New("message public key does not derive the address")
+	// ErrMessageMasterKeyDisabled is returned when a message is signed with the master key of an account that disabled it.
+	ErrMessageMasterKeyDisabled = errors.New("message signed with a disabled master key")
+	// ErrMessageKeyNotAuthorized is returned when a message is signed with a key that can not sign for the account.
+	ErrMessageKeyNotAuthorized = errors.New("message public key is not the master key, regular key or a signer of the account")
+
+	// vanity
+
+	// ErrEmptyVanityPattern is returned when a vanity prefix or suffix is empty.
+	ErrEmptyVanityPattern = errors.New("vanity pattern is empty")
+	// ErrNoVanityAlgorithms is returned when a vanity address is searched without any algorithm.
+	ErrNoVanityAlgorithms = errors.New("no algorithm to derive vanity addresses")
+
 	// batch
 
 	// ErrBatchAccountNotFound is returned when the batch account is not found in the transaction.
@@ -23,3 +53,16 @@ var (
 	// ErrBatchSignableNotEqual is returned when the batch signable is not equal.
 	ErrBatchSignableNotEqual = errors.New("batch signable is not equal")
 )
+
+// Dynamic errors
+
+// ErrInvalidVanityCharacter is returned when a vanity pattern uses a character that is not in the XRPL base58 alphabet.
+type ErrInvalidVanityCharacter struct {
+	Pattern string
+	Char    rune
+}
+
+// Error implements the error interface for ErrInvalidVanityCharacter
+func (e ErrInvalidVanityCharacter) Error() string {
+	return fmt.Sprintf("vanity pattern %q uses %q, which is not in the XRPL base58 alphabet", e.Pattern, e.Char)
+}
@@ -0,0 +1,214 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KDF is a password-based key derivation function.
+type KDF string
+
+const (
+	// KDFScrypt is the scrypt key derivation function.
+	KDFScrypt KDF = "scrypt"
+	// KDFArgon2id is the Argon2id key derivation function.
+	KDFArgon2id KDF = "argon2id"
+)
+
+// Cipher is an authenticated encryption algorithm.
+type Cipher string
+
+const (
+	// CipherAES256GCM is AES-256 in Galois/Counter Mode.
+	CipherAES256GCM Cipher = "aes-256-gcm"
+	// CipherXChaCha20Poly1305 is XChaCha20-Poly1305, with 24 bytes nonces.
+	CipherXChaCha20Poly1305 Cipher = "xchacha20-poly1305"
+)
+
+const (
+	// keyLength is the length in bytes of the encryption keys.
+	keyLength = 32
+	// saltLength is the length in bytes of the key derivation salts.
+	saltLength = 32
+
+	// DefaultScryptN is the default scrypt CPU/memory cost.
+	DefaultScryptN = 1 << 17
+	// DefaultScryptR is the default scrypt block size.
+	DefaultScryptR = 8
+	// DefaultScryptP is the default scrypt parallelization.
+	DefaultScryptP = 1
+
+	// DefaultArgon2Time is the default number of Argon2id passes.
+	DefaultArgon2Time = 3
+	// DefaultArgon2Memory is the default Argon2id memory, in KiB.
+	DefaultArgon2Memory = 64 * 1024
+	// DefaultArgon2Threads is the default Argon2id parallelism.
+	DefaultArgon2Threads = 4
+)
+
+// KDFParams are the parameters of the key derivation function of a wallet.
+type KDFParams struct {
+	// Salt is the hex encoded salt.
+	Salt string `json:"salt"`
+	// KeyLength is the length in bytes of the derived key.
+	KeyLength int `json:"dklen"`
+
+	// N, R and P are the scrypt parameters.
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	// Time, Memory (in KiB) and Threads are the Argon2id parameters.
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// CryptoParams are the encryption parameters and the encrypted secrets of a wallet.
+type CryptoParams struct {
+	KDF       KDF       `json:"kdf"`
+	KDFParams KDFParams `json:"kdfparams"`
+	Cipher    Cipher    `json:"cipher"`
+	// Nonce is the hex encoded nonce of the cipher.
+	Nonce string `json:"nonce"`
+	// Ciphertext is the hex encoded encrypted secrets, including the authentication tag.
+	Ciphertext string `json:"ciphertext"`
+}
+
+// newKDFParams returns the parameters of the key derivation function of the options, with a random salt.
+func newKDFParams(o options) (KDFParams, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return KDFParams{}, err
+	}
+
+	params := KDFParams{
+		Salt:      hex.EncodeToString(salt),
+		KeyLength: keyLength,
+	}
+	switch o.kdf {
+	case KDFScrypt:
+		params.N, params.R, params.P = o.scryptN, o.scryptR, o.scryptP
+	case KDFArgon2id:
+		params.Time, params.Memory, params.Threads = o.argon2Time, o.argon2Memory, o.argon2Threads
+	default:
+		return KDFParams{}, ErrUnsupportedKDF{KDF: o.kdf}
+	}
+
+	return params, nil
+}
+
+// deriveKey derives the encryption key from the password. The caller must clear it after use.
+func deriveKey(password []byte, kdf KDF, params KDFParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(salt) == 0 || params.KeyLength != keyLength {
+		return nil, ErrInvalidKDFParams
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		key, err := scrypt.Key(password, salt, params.N, params.R, params.P, params.KeyLength)
+		if err != nil {
+			return nil, ErrInvalidKDFParams
+		}
+		return key, nil
+	case KDFArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, ErrInvalidKDFParams
+		}
+		return argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, uint32(params.KeyLength)), nil
+	default:
+		return nil, ErrUnsupportedKDF{KDF: kdf}
+	}
+}
+
+// newAEAD returns the authenticated encryption algorithm of the cipher, keyed with key.
+func newAEAD(c Cipher, key []byte) (cipher.AEAD, error) {
+	switch c {
+	case CipherAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case CipherXChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+	default:
+		return nil, ErrUnsupportedCipher{Cipher: c}
+	}
+}
+
+// encrypt encrypts the plaintext with a key derived from the password, authenticating the additional data.
+func encrypt(password, plaintext, additionalData []byte, o options) (CryptoParams, error) {
+	params, err := newKDFParams(o)
+	if err != nil {
+		return CryptoParams{}, err
+	}
+
+	key, err := deriveKey(password, o.kdf, params)
+	if err != nil {
+		return CryptoParams{}, err
+	}
+	defer clear(key)
+
+	aead, err := newAEAD(o.cipher, key)
+	if err != nil {
+		return CryptoParams{}, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return CryptoParams{}, err
+	}
+
+	return CryptoParams{
+		KDF:        o.kdf,
+		KDFParams:  params,
+		Cipher:     o.cipher,
+		Nonce:      hex.EncodeToString(nonce),
+		Ciphertext: hex.EncodeToString(aead.Seal(nil, nonce, plaintext, additionalData)),
+	}, nil
+}
+
+// decrypt decrypts the ciphertext with a key derived from the password, checking the additional data.
+// The caller must clear the plaintext after use.
+func decrypt(password []byte, c CryptoParams, additionalData []byte) ([]byte, error) {
+	key, err := deriveKey(password, c.KDF, c.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	aead, err := newAEAD(c.Cipher, key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(c.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrInvalidPassword
+	}
+	ciphertext, err := hex.DecodeString(c.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrInvalidPassword
+	}
+
+	return plaintext, nil
+}
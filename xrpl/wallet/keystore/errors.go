@@ -0,0 +1,54 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidPassword is returned when a wallet cannot be decrypted, either because the password is wrong
+	// or because its encrypted secrets or metadata have been tampered with.
+	ErrInvalidPassword = errors.New("keystore: invalid password or corrupted wallet")
+	// ErrWalletNotFound is returned when no wallet of the keystore matches an address or label.
+	ErrWalletNotFound = errors.New("keystore: wallet not found")
+	// ErrWalletExists is returned when adding a wallet whose address is already in the keystore.
+	ErrWalletExists = errors.New("keystore: wallet already exists")
+	// ErrMissingPrivateKey is returned when adding a wallet without a private key.
+	ErrMissingPrivateKey = errors.New("keystore: wallet has no private key")
+	// ErrInvalidKDFParams is returned when the key derivation parameters are invalid.
+	ErrInvalidKDFParams = errors.New("keystore: invalid key derivation parameters")
+	// ErrMalformedSecrets is returned when the decrypted secrets of a wallet are malformed.
+	ErrMalformedSecrets = errors.New("keystore: malformed secrets")
+)
+
+// Dynamic errors
+
+// ErrUnsupportedVersion is returned when decoding a keystore of an unsupported version.
+type ErrUnsupportedVersion struct {
+	Version int
+}
+
+// Error implements the error interface for ErrUnsupportedVersion
+func (e ErrUnsupportedVersion) Error() string {
+	return fmt.Sprintf("keystore: unsupported version %d", e.Version)
+}
+
+// ErrUnsupportedKDF is returned when a wallet uses an unsupported key derivation function.
+type ErrUnsupportedKDF struct {
+	KDF KDF
+}
+
+// Error implements the error interface for ErrUnsupportedKDF
+func (e ErrUnsupportedKDF) Error() string {
+	return fmt.Sprintf("keystore: unsupported key derivation function %q", e.KDF)
+}
+
+// ErrUnsupportedCipher is returned when a wallet uses an unsupported cipher.
+type ErrUnsupportedCipher struct {
+	Cipher Cipher
+}
+
+// Error implements the error interface for ErrUnsupportedCipher
+func (e ErrUnsupportedCipher) Error() string {
+	return fmt.Sprintf("keystore: unsupported cipher %q", e.Cipher)
+}
@@ -0,0 +1,395 @@
+// Package keystore provides an encrypted file format to store one or many wallets.
+//
+// The secrets of every wallet, its private key and seed, are encrypted with a key derived from a password
+// with scrypt or Argon2id, using AES-256-GCM or XChaCha20-Poly1305. Its address, public key and algorithm
+// are stored in clear text, authenticated by the encryption, so wallets can be listed without the password.
+// Labels are not authenticated, so they can be changed without the password.
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const (
+	// Version is the version of the keystore format.
+	Version = 1
+
+	// AlgorithmEd25519 is the algorithm of ED25519 wallets.
+	AlgorithmEd25519 = "ed25519"
+	// AlgorithmSecp256k1 is the algorithm of SECP256K1 wallets.
+	AlgorithmSecp256k1 = "secp256k1"
+
+	// fileMode is the mode of the keystore files, readable and writable by the owner only.
+	fileMode = 0o600
+	// secretsSeparator separates the private key and the seed in the encrypted secrets.
+	secretsSeparator = '\n'
+)
+
+// options are the encryption options of new wallets.
+type options struct {
+	kdf           KDF
+	cipher        Cipher
+	scryptN       int
+	scryptR       int
+	scryptP       int
+	argon2Time    uint32
+	argon2Memory  uint32
+	argon2Threads uint8
+}
+
+// Opt is a function that configures the encryption of the wallets added to a Keystore.
+type Opt func(*options)
+
+// WithKDF sets the key derivation function.
+// Default: KDFScrypt.
+func WithKDF(kdf KDF) Opt {
+	return func(o *options) {
+		o.kdf = kdf
+	}
+}
+
+// WithCipher sets the cipher.
+// Default: CipherAES256GCM.
+func WithCipher(c Cipher) Opt {
+	return func(o *options) {
+		o.cipher = c
+	}
+}
+
+// WithScryptParams sets the scrypt parameters. N must be a power of two greater than 1.
+// Default: DefaultScryptN, DefaultScryptR and DefaultScryptP.
+func WithScryptParams(n, r, p int) Opt {
+	return func(o *options) {
+		o.scryptN, o.scryptR, o.scryptP = n, r, p
+	}
+}
+
+// WithArgon2Params sets the Argon2id parameters, with memory in KiB.
+// Default: DefaultArgon2Time, DefaultArgon2Memory and DefaultArgon2Threads.
+func WithArgon2Params(time, memory uint32, threads uint8) Opt {
+	return func(o *options) {
+		o.argon2Time, o.argon2Memory, o.argon2Threads = time, memory, threads
+	}
+}
+
+// Keystore is a set of encrypted wallets. It is not safe for concurrent use.
+type Keystore struct {
+	Version int      `json:"version"`
+	Wallets []*Entry `json:"wallets"`
+
+	opts options
+}
+
+// New creates an empty Keystore. The options apply to the wallets added to it.
+func New(opts ...Opt) *Keystore {
+	k := &Keystore{Version: Version}
+	k.SetOptions(opts...)
+	return k
+}
+
+// Decode decodes a Keystore from its JSON encoding. The options apply to the wallets added to it.
+func Decode(data []byte, opts ...Opt) (*Keystore, error) {
+	k := New(opts...)
+	if err := json.Unmarshal(data, k); err != nil {
+		return nil, err
+	}
+	if k.Version != Version {
+		return nil, ErrUnsupportedVersion{Version: k.Version}
+	}
+	return k, nil
+}
+
+// Load reads and decodes a Keystore file. The options apply to the wallets added to it.
+func Load(path string, opts ...Opt) (*Keystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data, opts...)
+}
+
+// SetOptions sets the encryption options of the wallets added to the keystore and of password changes.
+func (k *Keystore) SetOptions(opts ...Opt) {
+	k.opts = options{
+		kdf:           KDFScrypt,
+		cipher:        CipherAES256GCM,
+		scryptN:       DefaultScryptN,
+		scryptR:       DefaultScryptR,
+		scryptP:       DefaultScryptP,
+		argon2Time:    DefaultArgon2Time,
+		argon2Memory:  DefaultArgon2Memory,
+		argon2Threads: DefaultArgon2Threads,
+	}
+	for _, opt := range opts {
+		opt(&k.opts)
+	}
+}
+
+// Encode returns the JSON encoding of the keystore.
+func (k *Keystore) Encode() ([]byte, error) {
+	return json.MarshalIndent(k, "", "  ")
+}
+
+// Save writes the keystore to a file, readable and writable by the owner only.
+// The file is replaced atomically, so it is never left partially written.
+func (k *Keystore) Save(path string) error {
+	data, err := k.Encode()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := tmp.Chmod(fileMode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Add encrypts a wallet with the password and adds it to the keystore, with an optional label.
+func (k *Keystore) Add(w *wallet.Wallet, label string, password []byte) (*Entry, error) {
+	if w.PrivateKey == "" {
+		return nil, ErrMissingPrivateKey
+	}
+	if _, err := k.Find(w.ClassicAddress); err == nil {
+		return nil, ErrWalletExists
+	}
+
+	e := &Entry{
+		Label:     label,
+		Address:   w.ClassicAddress,
+		PublicKey: w.PublicKey,
+		Algorithm: algorithmOf(w.PublicKey),
+	}
+
+	secrets := make([]byte, 0, len(w.PrivateKey)+1+len(w.Seed))
+	secrets = append(secrets, w.PrivateKey...)
+	secrets = append(secrets, secretsSeparator)
+	secrets = append(secrets, w.Seed...)
+	defer clear(secrets)
+
+	var err error
+	e.Crypto, err = encrypt(password, secrets, e.additionalData(), k.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Wallets = append(k.Wallets, e)
+	return e, nil
+}
+
+// Find returns the wallet of the keystore with the given address.
+func (k *Keystore) Find(address types.Address) (*Entry, error) {
+	for _, e := range k.Wallets {
+		if e.Address == address {
+			return e, nil
+		}
+	}
+	return nil, ErrWalletNotFound
+}
+
+// FindByLabel returns the first wallet of the keystore with the given label.
+func (k *Keystore) FindByLabel(label string) (*Entry, error) {
+	for _, e := range k.Wallets {
+		if e.Label == label {
+			return e, nil
+		}
+	}
+	return nil, ErrWalletNotFound
+}
+
+// Remove removes the wallet with the given address from the keystore.
+func (k *Keystore) Remove(address types.Address) error {
+	for i, e := range k.Wallets {
+		if e.Address == address {
+			k.Wallets = append(k.Wallets[:i], k.Wallets[i+1:]...)
+			return nil
+		}
+	}
+	return ErrWalletNotFound
+}
+
+// ChangePassword re-encrypts every wallet of the keystore with the new password, using the options of the keystore.
+// Either all wallets are re-encrypted or, if any of them cannot be decrypted with the old password, none is.
+func (k *Keystore) ChangePassword(oldPassword, newPassword []byte) error {
+	reencrypted := make([]CryptoParams, len(k.Wallets))
+	for i, e := range k.Wallets {
+		c, err := e.reencrypt(oldPassword, newPassword, k.opts)
+		if err != nil {
+			return err
+		}
+		reencrypted[i] = c
+	}
+
+	for i, e := range k.Wallets {
+		e.Crypto = reencrypted[i]
+	}
+	return nil
+}
+
+// Entry is an encrypted wallet of a keystore.
+type Entry struct {
+	// Label is a free text name for the wallet.
+	Label string `json:"label,omitempty"`
+	// Address is the classic address of the wallet.
+	Address types.Address `json:"address"`
+	// PublicKey is the hex encoded public key of the wallet.
+	PublicKey string `json:"public_key"`
+	// Algorithm is the algorithm of the keys of the wallet, AlgorithmEd25519 or AlgorithmSecp256k1.
+	Algorithm string `json:"algorithm"`
+	// Crypto holds the encrypted private key and seed of the wallet.
+	Crypto CryptoParams `json:"crypto"`
+}
+
+// Decrypt decrypts the wallet with the password.
+// The PrivateKey and Seed of the returned wallet are regular strings, which cannot be cleared; prefer Use to sign.
+func (e *Entry) Decrypt(password []byte) (*wallet.Wallet, error) {
+	secrets, i, err := e.decryptSecrets(password)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(secrets)
+
+	return &wallet.Wallet{
+		PublicKey:      e.PublicKey,
+		PrivateKey:     string(secrets[:i]),
+		ClassicAddress: e.Address,
+		Seed:           string(secrets[i+1:]),
+	}, nil
+}
+
+// Use decrypts the private key of the wallet with the password and calls fn with a signer of the wallet.
+// The private key is parsed straight from the decrypted secrets, without going through a string, and the
+// secrets and the parsed key are overwritten with zeros when fn returns, so fn must not retain the signer.
+func (e *Entry) Use(password []byte, fn func(s *wallet.Signer) error) error {
+	secrets, i, err := e.decryptSecrets(password)
+	if err != nil {
+		return err
+	}
+	defer clear(secrets)
+
+	raw := make([]byte, hex.DecodedLen(i))
+	defer clear(raw)
+	if _, err := hex.Decode(raw, secrets[:i]); err != nil {
+		return ErrMalformedSecrets
+	}
+
+	key, err := keypairs.NewPrivateKey(raw)
+	if err != nil {
+		return ErrMalformedSecrets
+	}
+	defer key.Zero()
+
+	return fn(wallet.NewSigner(key, e.Address))
+}
+
+// Sign decrypts the wallet with the password and signs the transaction, returning its blob and hash.
+// See Use for how the secrets are cleared.
+func (e *Entry) Sign(password []byte, tx map[string]any) (blob, hash string, err error) {
+	err = e.Use(password, func(s *wallet.Signer) error {
+		blob, hash, err = s.Sign(tx)
+		return err
+	})
+	return blob, hash, err
+}
+
+// Multisign decrypts the wallet with the password and signs the transaction as a multisig signer,
+// returning its blob and hash. See Use for how the secrets are cleared.
+func (e *Entry) Multisign(password []byte, tx map[string]any) (blob, hash string, err error) {
+	err = e.Use(password, func(s *wallet.Signer) error {
+		blob, hash, err = s.Multisign(tx)
+		return err
+	})
+	return blob, hash, err
+}
+
+// ChangePassword re-encrypts the wallet with the new password, with the same key derivation function and cipher.
+func (e *Entry) ChangePassword(oldPassword, newPassword []byte) error {
+	c, err := e.reencrypt(oldPassword, newPassword, e.options())
+	if err != nil {
+		return err
+	}
+	e.Crypto = c
+	return nil
+}
+
+// decryptSecrets decrypts the secrets of the wallet with the password, returning them with the index of the
+// separator between the private key and the seed. The secrets must be cleared by the caller.
+func (e *Entry) decryptSecrets(password []byte) ([]byte, int, error) {
+	secrets, err := decrypt(password, e.Crypto, e.additionalData())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	i := bytes.IndexByte(secrets, secretsSeparator)
+	if i <= 0 {
+		clear(secrets)
+		return nil, 0, ErrMalformedSecrets
+	}
+	return secrets, i, nil
+}
+
+// reencrypt decrypts the secrets with the old password and encrypts them with the new one.
+func (e *Entry) reencrypt(oldPassword, newPassword []byte, o options) (CryptoParams, error) {
+	secrets, err := decrypt(oldPassword, e.Crypto, e.additionalData())
+	if err != nil {
+		return CryptoParams{}, err
+	}
+	defer clear(secrets)
+
+	return encrypt(newPassword, secrets, e.additionalData(), o)
+}
+
+// options returns the encryption options the wallet was encrypted with.
+func (e *Entry) options() options {
+	p := e.Crypto.KDFParams
+	return options{
+		kdf:           e.Crypto.KDF,
+		cipher:        e.Crypto.Cipher,
+		scryptN:       p.N,
+		scryptR:       p.R,
+		scryptP:       p.P,
+		argon2Time:    p.Time,
+		argon2Memory:  p.Memory,
+		argon2Threads: p.Threads,
+	}
+}
+
+// additionalData returns the metadata of the wallet authenticated by the encryption.
+func (e *Entry) additionalData() []byte {
+	return []byte(strings.Join([]string{e.Address.String(), e.PublicKey, e.Algorithm}, "\n"))
+}
+
+// algorithmOf returns the algorithm of a hex encoded public key.
+func algorithmOf(publicKey string) string {
+	if strings.HasPrefix(strings.ToUpper(publicKey), "ED") {
+		return AlgorithmEd25519
+	}
+	return AlgorithmSecp256k1
+}
@@ -0,0 +1,289 @@
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPassword = []byte("correct horse battery staple")
+
+	// fastOpts are weak key derivation parameters, to keep the tests fast.
+	fastOpts = []Opt{WithScryptParams(1<<4, 8, 1), WithArgon2Params(1, 64, 1)}
+)
+
+// testWallet returns a wallet derived from a seed.
+func testWallet(t *testing.T, seed string) *wallet.Wallet {
+	t.Helper()
+
+	w, err := wallet.FromSeed(seed, "")
+	require.NoError(t, err)
+
+	return &w
+}
+
+func TestKeystore_AddDecrypt(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Opt
+		seed      string
+		algorithm string
+	}{
+		{
+			name:      "pass - scrypt and aes-256-gcm",
+			seed:      "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r",
+			algorithm: AlgorithmEd25519,
+		},
+		{
+			name:      "pass - scrypt and xchacha20-poly1305",
+			opts:      []Opt{WithCipher(CipherXChaCha20Poly1305)},
+			seed:      "sp5fghtJtpUorTwvof1NpDXAzNwf5",
+			algorithm: AlgorithmSecp256k1,
+		},
+		{
+			name:      "pass - argon2id and aes-256-gcm",
+			opts:      []Opt{WithKDF(KDFArgon2id)},
+			seed:      "sp5fghtJtpUorTwvof1NpDXAzNwf5",
+			algorithm: AlgorithmSecp256k1,
+		},
+		{
+			name:      "pass - argon2id and xchacha20-poly1305",
+			opts:      []Opt{WithKDF(KDFArgon2id), WithCipher(CipherXChaCha20Poly1305)},
+			seed:      "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r",
+			algorithm: AlgorithmEd25519,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := New(append(fastOpts, tt.opts...)...)
+			w := testWallet(t, tt.seed)
+
+			e, err := k.Add(w, "main", testPassword)
+			require.NoError(t, err)
+			require.Equal(t, "main", e.Label)
+			require.Equal(t, w.ClassicAddress, e.Address)
+			require.Equal(t, w.PublicKey, e.PublicKey)
+			require.Equal(t, tt.algorithm, e.Algorithm)
+			require.NotContains(t, e.Crypto.Ciphertext, strings.ToLower(w.PrivateKey))
+
+			decrypted, err := e.Decrypt(testPassword)
+			require.NoError(t, err)
+			require.Equal(t, w, decrypted)
+
+			_, err = e.Decrypt([]byte("wrong password"))
+			require.ErrorIs(t, err, ErrInvalidPassword)
+		})
+	}
+}
+
+func TestKeystore_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wallets.json")
+
+	k := New(fastOpts...)
+	ed := testWallet(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r")
+	secp := testWallet(t, "sp5fghtJtpUorTwvof1NpDXAzNwf5")
+	_, err := k.Add(ed, "ed", testPassword)
+	require.NoError(t, err)
+	_, err = k.Add(secp, "secp", []byte("another password"))
+	require.NoError(t, err)
+	require.NoError(t, k.Save(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(fileMode), info.Mode().Perm())
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Wallets, 2)
+
+	e, err := loaded.FindByLabel("secp")
+	require.NoError(t, err)
+	decrypted, err := e.Decrypt([]byte("another password"))
+	require.NoError(t, err)
+	require.Equal(t, secp, decrypted)
+
+	e, err = loaded.Find(ed.ClassicAddress)
+	require.NoError(t, err)
+	decrypted, err = e.Decrypt(testPassword)
+	require.NoError(t, err)
+	require.Equal(t, ed, decrypted)
+
+	require.NoError(t, loaded.Remove(ed.ClassicAddress))
+	_, err = loaded.Find(ed.ClassicAddress)
+	require.ErrorIs(t, err, ErrWalletNotFound)
+	require.ErrorIs(t, loaded.Remove(ed.ClassicAddress), ErrWalletNotFound)
+}
+
+func TestKeystore_ChangePassword(t *testing.T) {
+	newPassword := []byte("new password")
+
+	t.Run("pass - all wallets", func(t *testing.T) {
+		k := New(fastOpts...)
+		for _, seed := range []string{"sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r", "sp5fghtJtpUorTwvof1NpDXAzNwf5"} {
+			_, err := k.Add(testWallet(t, seed), "", testPassword)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, k.ChangePassword(testPassword, newPassword))
+		for _, e := range k.Wallets {
+			_, err := e.Decrypt(testPassword)
+			require.ErrorIs(t, err, ErrInvalidPassword)
+			_, err = e.Decrypt(newPassword)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("fail - nothing is changed if a wallet has another password", func(t *testing.T) {
+		k := New(fastOpts...)
+		_, err := k.Add(testWallet(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"), "", testPassword)
+		require.NoError(t, err)
+		_, err = k.Add(testWallet(t, "sp5fghtJtpUorTwvof1NpDXAzNwf5"), "", []byte("other"))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, k.ChangePassword(testPassword, newPassword), ErrInvalidPassword)
+		_, err = k.Wallets[0].Decrypt(testPassword)
+		require.NoError(t, err)
+	})
+
+	t.Run("pass - single wallet keeps its algorithms", func(t *testing.T) {
+		k := New(append(fastOpts, WithKDF(KDFArgon2id), WithCipher(CipherXChaCha20Poly1305))...)
+		e, err := k.Add(testWallet(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"), "", testPassword)
+		require.NoError(t, err)
+		salt := e.Crypto.KDFParams.Salt
+
+		require.NoError(t, e.ChangePassword(testPassword, newPassword))
+		require.Equal(t, KDFArgon2id, e.Crypto.KDF)
+		require.Equal(t, CipherXChaCha20Poly1305, e.Crypto.Cipher)
+		require.NotEqual(t, salt, e.Crypto.KDFParams.Salt)
+		_, err = e.Decrypt(newPassword)
+		require.NoError(t, err)
+	})
+}
+
+func TestEntry_Metadata(t *testing.T) {
+	k := New(fastOpts...)
+	e, err := k.Add(testWallet(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"), "old", testPassword)
+	require.NoError(t, err)
+
+	t.Run("pass - label is not authenticated", func(t *testing.T) {
+		e.Label = "new"
+		_, err := e.Decrypt(testPassword)
+		require.NoError(t, err)
+	})
+
+	t.Run("fail - address is authenticated", func(t *testing.T) {
+		tampered := *e
+		tampered.Address = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+		_, err := tampered.Decrypt(testPassword)
+		require.ErrorIs(t, err, ErrInvalidPassword)
+	})
+}
+
+func TestEntry_Use(t *testing.T) {
+	tests := []struct {
+		name string
+		seed string
+	}{
+		{name: "pass - ed25519", seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"},
+		{name: "pass - secp256k1", seed: "sh1HiK7SwjS1VxFdXi7qeMHRedrYX"},
+	}
+
+	message := []byte("keystore")
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k := New(fastOpts...)
+			w := testWallet(t, tc.seed)
+			e, err := k.Add(w, "", testPassword)
+			require.NoError(t, err)
+
+			var retained *wallet.Signer
+			err = e.Use(testPassword, func(s *wallet.Signer) error {
+				require.Equal(t, w.PublicKey, s.PublicKey())
+				require.Equal(t, w.ClassicAddress, s.Address())
+
+				sig, err := s.SignMessage(message)
+				require.NoError(t, err)
+				require.NoError(t, wallet.VerifyMessage(w.ClassicAddress.String(), message, sig, w.PublicKey))
+				retained = s
+				return nil
+			})
+			require.NoError(t, err)
+
+			// The private key is zeroed when fn returns, so the retained signer no longer signs for the wallet.
+			sig, err := retained.SignMessage(message)
+			if err == nil {
+				require.Error(t, wallet.VerifyMessage(w.ClassicAddress.String(), message, sig, w.PublicKey))
+			}
+		})
+	}
+}
+
+func TestEntry_Sign(t *testing.T) {
+	k := New(fastOpts...)
+	w := &wallet.Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	e, err := k.Add(w, "", testPassword)
+	require.NoError(t, err)
+
+	blob, hash, err := e.Sign(testPassword, map[string]any{
+		"Account":         "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		"TransactionType": "Payment",
+		"Amount":          "15",
+		"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		"Flags":           uint32(0),
+		"Fee":             "12",
+		"Sequence":        uint32(1798962),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "120000220000000024001B733261400000000000000F68400000000000000C7321EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D57440A973391D589C1D81E55516420A8D095DD98D2FC1F85E53C427EEEC22C6D3DEBADFA184005F5539E6A672CC4FA468125981584DDCE9365A6C7076F2E9CAF86B0E81143A18A088CF12B2D3E51F47A75D2A9859EF61ECA78314858233827B488ECB8D0EB940E7AC85CE41E343CF", blob)
+	require.Equal(t, "37186C50D0A3FAB1218B5F7DAA235E4592F5080AF1C81F9B2678D4751C103CDF", hash)
+
+	_, _, err = e.Sign([]byte("wrong"), map[string]any{})
+	require.ErrorIs(t, err, ErrInvalidPassword)
+}
+
+func TestKeystore_Errors(t *testing.T) {
+	k := New(fastOpts...)
+	w := testWallet(t, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r")
+	_, err := k.Add(w, "", testPassword)
+	require.NoError(t, err)
+
+	t.Run("fail - wallet exists", func(t *testing.T) {
+		_, err := k.Add(w, "", testPassword)
+		require.ErrorIs(t, err, ErrWalletExists)
+	})
+
+	t.Run("fail - missing private key", func(t *testing.T) {
+		_, err := k.Add(&wallet.Wallet{ClassicAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}, "", testPassword)
+		require.ErrorIs(t, err, ErrMissingPrivateKey)
+	})
+
+	t.Run("fail - unsupported kdf", func(t *testing.T) {
+		_, err := New(WithKDF("pbkdf2")).Add(w, "", testPassword)
+		require.ErrorIs(t, err, ErrUnsupportedKDF{KDF: "pbkdf2"})
+	})
+
+	t.Run("fail - unsupported cipher", func(t *testing.T) {
+		_, err := New(append(fastOpts, WithCipher("rot13"))...).Add(w, "", testPassword)
+		require.ErrorIs(t, err, ErrUnsupportedCipher{Cipher: "rot13"})
+	})
+
+	t.Run("fail - unsupported version", func(t *testing.T) {
+		_, err := Decode([]byte(`{"version": 2, "wallets": []}`))
+		require.ErrorIs(t, err, ErrUnsupportedVersion{Version: 2})
+	})
+
+	t.Run("fail - invalid scrypt params", func(t *testing.T) {
+		_, err := New(WithScryptParams(3, 8, 1)).Add(w, "", testPassword)
+		require.ErrorIs(t, err, ErrInvalidKDFParams)
+	})
+}
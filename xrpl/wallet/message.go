@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// SignedMessagePrefix is prepended to messages before they are signed. It separates message signatures
+// from transaction signatures, whose data starts with a hash prefix, so that a signed message can never be
+// submitted as a transaction, nor reused by another protocol.
+const SignedMessagePrefix = "\x19XRPL Signed Message:\n"
+
+// MessageKey is the key of an account that signed a message, as verified by VerifyMessageOnLedger.
+type MessageKey int
+
+const (
+	// MessageKeyMaster is the master key of the account, derived from its address.
+	MessageKeyMaster MessageKey = iota
+	// MessageKeyRegular is the regular key of the account.
+	MessageKeyRegular
+	// MessageKeySigner is a key of a member of the signer list of the account whose weight alone meets the quorum of
+	// the list: the master key of the member, if it is not disabled, or its regular key.
+	MessageKeySigner
+)
+
+// String returns the name of the message key.
+func (k MessageKey) String() string {
+	switch k {
+	case MessageKeyMaster:
+		return "master"
+	case MessageKeyRegular:
+		return "regular"
+	case MessageKeySigner:
+		return "signer"
+	default:
+		return "unknown"
+	}
+}
+
+// MessageSigningData returns the data signed for a message: SignedMessagePrefix, followed by the
+// decimal length of the message, a newline, and the message.
+func MessageSigningData(message []byte) []byte {
+	data := make([]byte, 0, len(SignedMessagePrefix)+len(message)+8)
+	data = append(data, SignedMessagePrefix...)
+	data = strconv.AppendInt(data, int64(len(message)), 10)
+	data = append(data, '\n')
+	return append(data, message...)
+}
+
+// SignMessage signs an arbitrary message with the wallet, for example to prove the ownership of its address.
+// The message is prefixed as described by MessageSigningData, and the signature is returned as an uppercase hex string.
+// It is verified with VerifyMessage, along with the public key of the wallet.
+func (w *Wallet) SignMessage(message []byte) (string, error) {
+	s, err := w.Signer()
+	if err != nil {
+		return "", err
+	}
+	return s.SignMessage(message)
+}
+
+// SignMessage signs an arbitrary message as Wallet.SignMessage does.
+func (s *Signer) SignMessage(message []byte) (string, error) {
+	signature, err := s.key.Sign(MessageSigningData(message))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(signature)), nil
+}
+
+// VerifyMessage verifies that signature is a signature of message, as signed by SignMessage, by the public key,
+// and that the public key derives the address. It returns nil if the message is verified.
+// It does not check the account on ledger, so the master key may be disabled. See VerifyMessageOnLedger.
+func VerifyMessage(address string, message []byte, signature, publicKey string) error {
+	signer, err := verifyMessageSignature(message, signature, publicKey)
+	if err != nil {
+		return err
+	}
+	if signer != address {
+		return ErrMessageKeyAddressMismatch
+	}
+	return nil
+}
+
+// VerifyMessageOnLedger verifies that signature is a signature of message by the public key, as VerifyMessage,
+// and that the public key is allowed to sign for the account in the validated ledger: either the master key,
+// if it is not disabled, the regular key, or a key of a member of the signer list of the account that can sign for
+// it alone, as its weight meets the quorum of the list. It returns the kind of key that signed the message.
+func VerifyMessageOnLedger(client AccountInfoGetter, address string, message []byte, signature, publicKey string) (MessageKey, error) {
+	signer, err := verifyMessageSignature(message, signature, publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := client.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address(address),
+		LedgerIndex: common.LedgerTitle("validated"),
+		SignerLists: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if signer == address {
+		if info.AccountData.IsLsfDisableMaster() {
+			return 0, ErrMessageMasterKeyDisabled
+		}
+		return MessageKeyMaster, nil
+	}
+	if info.AccountData.RegularKey.String() == signer {
+		return MessageKeyRegular, nil
+	}
+	for _, list := range info.SignerLists {
+		for _, entry := range list.SignerEntries {
+			if uint32(entry.SignerEntry.SignerWeight) < list.SignerQuorum {
+				continue
+			}
+			ok, err := signsForAccount(client, entry.SignerEntry.Account, signer)
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				return MessageKeySigner, nil
+			}
+		}
+	}
+	return 0, ErrMessageKeyNotAuthorized
+}
+
+// signsForAccount reports whether the key of the signer address can sign for an account in the validated ledger:
+// it is the master key of the account, if it is not disabled, or its regular key. A signer list member does not
+// need to be funded, and an account not found can only sign with its master key.
+func signsForAccount(client AccountInfoGetter, address types.Address, signer string) (bool, error) {
+	info, err := client.GetAccountInfo(&account.InfoRequest{
+		Account:     address,
+		LedgerIndex: common.LedgerTitle("validated"),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), actNotFound) {
+			return address.String() == signer, nil
+		}
+		return false, err
+	}
+
+	if address.String() == signer {
+		return !info.AccountData.IsLsfDisableMaster(), nil
+	}
+	return info.AccountData.RegularKey.String() == signer, nil
+}
+
+// verifyMessageSignature verifies the signature of a message and returns the classic address of the public key.
+func verifyMessageSignature(message []byte, signature, publicKey string) (string, error) {
+	pubKey, err := keypairs.ParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return "", ErrInvalidMessageSignature
+	}
+	if !pubKey.Verify(MessageSigningData(message), sig) {
+		return "", ErrInvalidMessageSignature
+	}
+	return pubKey.ClassicAddress()
+}
@@ -0,0 +1,279 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMessage          = "Log in to example.com\nNonce: 42"
+	testMessageSignature = "97408B514BAE62C9F9CC741B4A7681C87CD817A7C32B99891211447E60FED20260E6BAE1714982DF69D1AD179937D3F7E052D2318B8A58757DEBD16B56320505"
+)
+
+// testMessageWallet is the wallet that signs testMessage.
+var testMessageWallet = Wallet{
+	PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+	PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+	ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+}
+
+// staticAccountInfoGetter returns the same account info for every request, except for the accounts of others.
+// An account of others with nil info is not found. It records the first request.
+type staticAccountInfoGetter struct {
+	info   *account.InfoResponse
+	err    error
+	others map[types.Address]*account.InfoResponse
+	req    *account.InfoRequest
+}
+
+func (m *staticAccountInfoGetter) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error) {
+	if m.req == nil {
+		m.req = req
+	}
+	if info, ok := m.others[req.Account]; ok {
+		if info == nil {
+			return nil, errors.New(actNotFound)
+		}
+		return info, nil
+	}
+	return m.info, m.err
+}
+
+func TestMessageSigningData(t *testing.T) {
+	require.Equal(t, []byte("\x19XRPL Signed Message:\n5\nhello"), MessageSigningData([]byte("hello")))
+	require.Equal(t, []byte("\x19XRPL Signed Message:\n0\n"), MessageSigningData(nil))
+}
+
+func TestWallet_SignMessage(t *testing.T) {
+	t.Run("pass - ed25519", func(t *testing.T) {
+		signature, err := testMessageWallet.SignMessage([]byte(testMessage))
+		require.NoError(t, err)
+		require.Equal(t, testMessageSignature, signature)
+	})
+
+	t.Run("pass - secp256k1", func(t *testing.T) {
+		w, err := FromSeed("sp5fghtJtpUorTwvof1NpDXAzNwf5", "")
+		require.NoError(t, err)
+
+		signature, err := w.SignMessage([]byte(testMessage))
+		require.NoError(t, err)
+		require.NoError(t, VerifyMessage(w.ClassicAddress.String(), []byte(testMessage), signature, w.PublicKey))
+	})
+
+	t.Run("fail - invalid private key", func(t *testing.T) {
+		w := Wallet{PrivateKey: "ED00"}
+		_, err := w.SignMessage([]byte(testMessage))
+		require.Error(t, err)
+	})
+}
+
+func TestVerifyMessage(t *testing.T) {
+	tt := []struct {
+		name        string
+		address     string
+		message     string
+		signature   string
+		publicKey   string
+		expectedErr error
+	}{
+		{
+			name:      "pass - valid signature",
+			address:   "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+			message:   testMessage,
+			signature: testMessageSignature,
+			publicKey: testMessageWallet.PublicKey,
+		},
+		{
+			name:        "fail - tampered message",
+			address:     "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+			message:     testMessage + "3",
+			signature:   testMessageSignature,
+			publicKey:   testMessageWallet.PublicKey,
+			expectedErr: ErrInvalidMessageSignature,
+		},
+		{
+			name:        "fail - signature is not hex",
+			address:     "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+			message:     testMessage,
+			signature:   "not hex",
+			publicKey:   testMessageWallet.PublicKey,
+			expectedErr: ErrInvalidMessageSignature,
+		},
+		{
+			name:        "fail - public key of another account",
+			address:     "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			message:     testMessage,
+			signature:   testMessageSignature,
+			publicKey:   testMessageWallet.PublicKey,
+			expectedErr: ErrMessageKeyAddressMismatch,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyMessage(tc.address, []byte(tc.message), tc.signature, tc.publicKey)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("fail - signature of the unprefixed message", func(t *testing.T) {
+		signature, err := keypairs.Sign(testMessage, testMessageWallet.PrivateKey)
+		require.NoError(t, err)
+
+		err = VerifyMessage(testMessageWallet.ClassicAddress.String(), []byte(testMessage), signature, testMessageWallet.PublicKey)
+		require.ErrorIs(t, err, ErrInvalidMessageSignature)
+	})
+
+	t.Run("fail - invalid public key", func(t *testing.T) {
+		err := VerifyMessage("raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5", []byte(testMessage), testMessageSignature, "ED00")
+		require.Error(t, err)
+	})
+}
+
+func TestVerifyMessageOnLedger(t *testing.T) {
+	signer := testMessageWallet.ClassicAddress
+	other := types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	member := types.Address("rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW")
+
+	signerList := func(weight uint16, quorum uint32, entry types.Address) *account.InfoResponse {
+		return &account.InfoResponse{
+			AccountData: ledger.AccountRoot{Account: other},
+			SignerLists: []ledger.SignerList{{
+				SignerQuorum: quorum,
+				SignerEntries: []ledger.SignerEntryWrapper{
+					{SignerEntry: ledger.SignerEntry{Account: "raKEEVSGnKSD9Zyvxu4z6Pqpm4ABH8FS6n", SignerWeight: 1}},
+					{SignerEntry: ledger.SignerEntry{Account: entry, SignerWeight: weight}},
+				},
+			}},
+		}
+	}
+
+	disabledMaster := ledger.AccountRoot{Account: signer}
+	disabledMaster.SetLsfDisableMaster()
+
+	tt := []struct {
+		name        string
+		address     types.Address
+		info        *account.InfoResponse
+		err         error
+		others      map[types.Address]*account.InfoResponse
+		expected    MessageKey
+		expectedErr error
+	}{
+		{
+			name:     "pass - master key",
+			address:  signer,
+			info:     &account.InfoResponse{AccountData: ledger.AccountRoot{Account: signer}},
+			expected: MessageKeyMaster,
+		},
+		{
+			name:    "pass - regular key",
+			address: other,
+			info: &account.InfoResponse{
+				AccountData: ledger.AccountRoot{Account: other, RegularKey: signer},
+			},
+			expected: MessageKeyRegular,
+		},
+		{
+			name:     "pass - signer list member meeting the quorum",
+			address:  other,
+			info:     signerList(2, 2, signer),
+			others:   map[types.Address]*account.InfoResponse{signer: {AccountData: ledger.AccountRoot{Account: signer}}},
+			expected: MessageKeySigner,
+		},
+		{
+			name:     "pass - unfunded signer list member meeting the quorum",
+			address:  other,
+			info:     signerList(3, 2, signer),
+			others:   map[types.Address]*account.InfoResponse{signer: nil},
+			expected: MessageKeySigner,
+		},
+		{
+			name:    "pass - regular key of a signer list member meeting the quorum",
+			address: other,
+			info:    signerList(2, 2, member),
+			others: map[types.Address]*account.InfoResponse{
+				member: {AccountData: ledger.AccountRoot{Account: member, RegularKey: signer}},
+			},
+			expected: MessageKeySigner,
+		},
+		{
+			name:        "fail - signer list member below the quorum",
+			address:     other,
+			info:        signerList(1, 3, signer),
+			others:      map[types.Address]*account.InfoResponse{signer: {AccountData: ledger.AccountRoot{Account: signer}}},
+			expectedErr: ErrMessageKeyNotAuthorized,
+		},
+		{
+			name:        "fail - signer list member with the master key disabled",
+			address:     other,
+			info:        signerList(2, 2, signer),
+			others:      map[types.Address]*account.InfoResponse{signer: {AccountData: disabledMaster}},
+			expectedErr: ErrMessageKeyNotAuthorized,
+		},
+		{
+			name:        "fail - unfunded signer list member signed by another key",
+			address:     other,
+			info:        signerList(2, 2, member),
+			others:      map[types.Address]*account.InfoResponse{member: nil},
+			expectedErr: ErrMessageKeyNotAuthorized,
+		},
+		{
+			name:        "fail - master key disabled",
+			address:     signer,
+			info:        &account.InfoResponse{AccountData: disabledMaster},
+			expectedErr: ErrMessageMasterKeyDisabled,
+		},
+		{
+			name:    "fail - key not authorized",
+			address: other,
+			info: &account.InfoResponse{
+				AccountData: ledger.AccountRoot{Account: other, RegularKey: "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW"},
+			},
+			expectedErr: ErrMessageKeyNotAuthorized,
+		},
+		{
+			name:        "fail - client error",
+			address:     signer,
+			err:         errors.New(actNotFound),
+			expectedErr: errors.New(actNotFound),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &staticAccountInfoGetter{info: tc.info, err: tc.err, others: tc.others}
+
+			key, err := VerifyMessageOnLedger(client, tc.address.String(), []byte(testMessage), testMessageSignature, testMessageWallet.PublicKey)
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, key)
+
+			require.Equal(t, tc.address, client.req.Account)
+			require.Equal(t, common.LedgerTitle("validated"), client.req.LedgerIndex)
+			require.True(t, client.req.SignerLists)
+		})
+	}
+
+	t.Run("fail - invalid signature is not checked on ledger", func(t *testing.T) {
+		client := &staticAccountInfoGetter{}
+
+		_, err := VerifyMessageOnLedger(client, signer.String(), []byte("other message"), testMessageSignature, testMessageWallet.PublicKey)
+		require.ErrorIs(t, err, ErrInvalidMessageSignature)
+		require.Nil(t, client.req)
+	})
+}
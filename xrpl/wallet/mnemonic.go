@@ -0,0 +1,231 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/rfc1751"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	bip32 "github.com/bsv-blockchain/go-sdk/compat/bip32"
+	"github.com/bsv-blockchain/go-sdk/compat/bip39"
+	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
+)
+
+const (
+	// bip44Purpose is the BIP44 purpose of derivation paths.
+	bip44Purpose = 44
+	// xrpCoinType is the SLIP-44 coin type of XRP.
+	xrpCoinType = 144
+)
+
+var (
+	nilHDPrivateKeyID = [4]byte{0x00, 0x00, 0x00, 0x00}
+)
+
+// MnemonicEncoding is the encoding of a mnemonic.
+type MnemonicEncoding int
+
+const (
+	// MnemonicEncodingBIP39 is a BIP39 mnemonic, from which keys are derived with BIP32/BIP44.
+	MnemonicEncodingBIP39 MnemonicEncoding = iota
+	// MnemonicEncodingRFC1751 is an RFC1751 mnemonic encoding a family seed,
+	// as returned in the key field of the rippled wallet_propose method.
+	MnemonicEncodingRFC1751
+)
+
+// DerivationPath is a BIP44 derivation path for the XRP Ledger: m/44'/144'/account'/change/index.
+type DerivationPath struct {
+	Account uint32
+	Change  uint32
+	Index   uint32
+}
+
+// DefaultDerivationPath is the derivation path used by most wallets, m/44'/144'/0'/0/0.
+var DefaultDerivationPath = DerivationPath{}
+
+// ParseDerivationPath parses a derivation path of the form m/44'/144'/account'/change/index.
+// Hardened components can be marked with ' or h.
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 6 || parts[0] != "m" {
+		return DerivationPath{}, ErrInvalidDerivationPath
+	}
+
+	components := make([]uint32, 0, 5)
+	for i, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened != (i < 3) {
+			return DerivationPath{}, ErrInvalidDerivationPath
+		}
+		n, err := strconv.ParseUint(strings.TrimRight(part, "'h"), 10, 31)
+		if err != nil {
+			return DerivationPath{}, ErrInvalidDerivationPath
+		}
+		components = append(components, uint32(n))
+	}
+	if components[0] != bip44Purpose || components[1] != xrpCoinType {
+		return DerivationPath{}, ErrInvalidDerivationPath
+	}
+
+	return DerivationPath{Account: components[2], Change: components[3], Index: components[4]}, nil
+}
+
+// String returns the derivation path in the m/44'/144'/account'/change/index form.
+func (p DerivationPath) String() string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", bip44Purpose, xrpCoinType, p.Account, p.Change, p.Index)
+}
+
+// components returns the BIP32 child numbers of the derivation path.
+func (p DerivationPath) components() []uint32 {
+	return []uint32{
+		bip44Purpose + bip32.HardenedKeyStart,
+		xrpCoinType + bip32.HardenedKeyStart,
+		p.Account + bip32.HardenedKeyStart,
+		p.Change,
+		p.Index,
+	}
+}
+
+// mnemonicOptions are the options used to derive a wallet from a mnemonic.
+type mnemonicOptions struct {
+	encoding   MnemonicEncoding
+	passphrase string
+	path       DerivationPath
+	algorithm  interfaces.CryptoImplementation
+}
+
+// MnemonicOpt is a function that configures the derivation of a wallet from a mnemonic.
+type MnemonicOpt func(*mnemonicOptions)
+
+// WithMnemonicEncoding sets the encoding of the mnemonic.
+// Default: MnemonicEncodingBIP39.
+func WithMnemonicEncoding(encoding MnemonicEncoding) MnemonicOpt {
+	return func(o *mnemonicOptions) {
+		o.encoding = encoding
+	}
+}
+
+// WithPassphrase sets the BIP39 passphrase, also known as the 25th word. Ignored for RFC1751 mnemonics.
+// Default: no passphrase.
+func WithPassphrase(passphrase string) MnemonicOpt {
+	return func(o *mnemonicOptions) {
+		o.passphrase = passphrase
+	}
+}
+
+// WithDerivationPath sets the BIP44 derivation path. Ignored for RFC1751 mnemonics.
+// Default: DefaultDerivationPath.
+func WithDerivationPath(path DerivationPath) MnemonicOpt {
+	return func(o *mnemonicOptions) {
+		o.path = path
+	}
+}
+
+// WithAlgorithm sets the algorithm of the family seed encoded by an RFC1751 mnemonic.
+// BIP39 mnemonics always derive SECP256K1 keys.
+// Default: SECP256K1, as the rippled wallet_propose method.
+func WithAlgorithm(alg interfaces.CryptoImplementation) MnemonicOpt {
+	return func(o *mnemonicOptions) {
+		o.algorithm = alg
+	}
+}
+
+// SeedToRFC1751 encodes the entropy of a family seed as an RFC1751 mnemonic, as returned in the
+// key field of the rippled wallet_propose method. The algorithm of the seed is not part of the mnemonic.
+func SeedToRFC1751(seed string) (string, error) {
+	entropy, _, err := addresscodec.DecodeSeed(seed)
+	if err != nil {
+		return "", err
+	}
+	// rippled encodes the seed bytes in reverse order.
+	slices.Reverse(entropy)
+	return rfc1751.Encode(entropy)
+}
+
+// RFC1751ToSeed decodes an RFC1751 mnemonic into a family seed of the given algorithm.
+func RFC1751ToSeed(mnemonic string, alg interfaces.CryptoImplementation) (string, error) {
+	entropy, err := rfc1751.Decode(mnemonic)
+	if err != nil {
+		return "", err
+	}
+	if len(entropy) != addresscodec.FamilySeedLength {
+		return "", ErrInvalidRFC1751SeedLength
+	}
+	// rippled encodes the seed bytes in reverse order.
+	slices.Reverse(entropy)
+	return addresscodec.EncodeSeed(entropy, alg)
+}
+
+// fromBIP39Mnemonic derives a wallet from a BIP39 mnemonic, with the passphrase and derivation path of the options.
+func fromBIP39Mnemonic(mnemonic string, o mnemonicOptions) (*Wallet, error) {
+	// Validate the mnemonic
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+
+	// Generate seed from mnemonic
+	seed := bip39.NewSeed(mnemonic, o.passphrase)
+
+	// Derive the master key
+	params := &chaincfg.Params{
+		HDPrivateKeyID: nilHDPrivateKeyID,
+	}
+	masterKey, err := bip32.NewMaster(seed, params)
+	if err != nil {
+		return nil, err
+	}
+
+	key := masterKey
+	for _, childNum := range o.path.components() {
+		key, err = key.Child(childNum)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert the private key to the format expected by the XRPL library
+	ecPriv, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	privKey := strings.ToUpper(ecPriv.Hex())
+	pubKey := strings.ToUpper(hex.EncodeToString(ecPriv.PubKey().Compressed()))
+
+	// Derive classic address
+	classicAddr, err := keypairs.DeriveClassicAddress(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wallet{
+		PublicKey:      pubKey,
+		PrivateKey:     fmt.Sprintf("00%s", privKey),
+		ClassicAddress: types.Address(classicAddr),
+		Seed:           "", // BIP39 mnemonics do not derive a family seed
+	}, nil
+}
+
+// fromRFC1751Mnemonic derives a wallet from the family seed encoded by an RFC1751 mnemonic.
+func fromRFC1751Mnemonic(mnemonic string, o mnemonicOptions) (*Wallet, error) {
+	alg := o.algorithm
+	if alg == nil {
+		alg = crypto.SECP256K1()
+	}
+	seed, err := RFC1751ToSeed(mnemonic, alg)
+	if err != nil {
+		return nil, err
+	}
+	w, err := FromSeed(seed, "")
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/rfc1751"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/bsv-blockchain/go-sdk/compat/bip39"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected DerivationPath
+		err      error
+	}{
+		{
+			name:     "pass - default path",
+			path:     "m/44'/144'/0'/0/0",
+			expected: DefaultDerivationPath,
+		},
+		{
+			name:     "pass - custom path",
+			path:     "m/44'/144'/3'/1/7",
+			expected: DerivationPath{Account: 3, Change: 1, Index: 7},
+		},
+		{
+			name:     "pass - h hardened marker",
+			path:     "m/44h/144h/2h/0/5",
+			expected: DerivationPath{Account: 2, Index: 5},
+		},
+		{
+			name: "fail - wrong coin type",
+			path: "m/44'/60'/0'/0/0",
+			err:  ErrInvalidDerivationPath,
+		},
+		{
+			name: "fail - hardened index",
+			path: "m/44'/144'/0'/0/0'",
+			err:  ErrInvalidDerivationPath,
+		},
+		{
+			name: "fail - missing components",
+			path: "m/44'/144'/0'",
+			err:  ErrInvalidDerivationPath,
+		},
+		{
+			name: "fail - not a number",
+			path: "m/44'/144'/a'/0/0",
+			err:  ErrInvalidDerivationPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := ParseDerivationPath(tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, path)
+		})
+	}
+}
+
+func TestDerivationPath_String(t *testing.T) {
+	require.Equal(t, "m/44'/144'/0'/0/0", DefaultDerivationPath.String())
+	require.Equal(t, "m/44'/144'/3'/1/7", DerivationPath{Account: 3, Change: 1, Index: 7}.String())
+}
+
+func TestSeedToRFC1751(t *testing.T) {
+	// Test vector from the rippled wallet_propose method.
+	seed := "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"
+	mnemonic := "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE"
+
+	encoded, err := SeedToRFC1751(seed)
+	require.NoError(t, err)
+	require.Equal(t, mnemonic, encoded)
+
+	decoded, err := RFC1751ToSeed(mnemonic, crypto.SECP256K1())
+	require.NoError(t, err)
+	require.Equal(t, seed, decoded)
+
+	t.Run("pass - ed25519 round trip", func(t *testing.T) {
+		seed := "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"
+		encoded, err := SeedToRFC1751(seed)
+		require.NoError(t, err)
+		decoded, err := RFC1751ToSeed(encoded, crypto.ED25519())
+		require.NoError(t, err)
+		require.Equal(t, seed, decoded)
+	})
+
+	t.Run("fail - 64 bits mnemonic", func(t *testing.T) {
+		_, err := RFC1751ToSeed("TIDE ITCH SLOW REIN RULE MOT", crypto.SECP256K1())
+		require.ErrorIs(t, err, ErrInvalidRFC1751SeedLength)
+	})
+
+	t.Run("fail - invalid parity", func(t *testing.T) {
+		_, err := RFC1751ToSeed("I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DART", crypto.SECP256K1())
+		require.ErrorIs(t, err, rfc1751.ErrInvalidParity)
+	})
+}
+
+func TestFromMnemonic_Options(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		opts     []MnemonicOpt
+		expected types.Address
+		seed     string
+		err      error
+	}{
+		{
+			name:     "pass - default path",
+			mnemonic: testMnemonic,
+			expected: "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3",
+		},
+		{
+			name:     "pass - address index",
+			mnemonic: testMnemonic,
+			opts:     []MnemonicOpt{WithDerivationPath(DerivationPath{Index: 1})},
+			expected: "r3AgF9mMBFtaLhKcg96weMhbbEFLZ3mx17",
+		},
+		{
+			name:     "pass - rfc1751",
+			mnemonic: "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+			opts:     []MnemonicOpt{WithMnemonicEncoding(MnemonicEncodingRFC1751)},
+			expected: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			seed:     "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+		},
+		{
+			name:     "fail - invalid bip39 mnemonic",
+			mnemonic: "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+			err:      bip39.ErrInvalidMnemonic,
+		},
+		{
+			name:     "fail - unknown encoding",
+			mnemonic: testMnemonic,
+			opts:     []MnemonicOpt{WithMnemonicEncoding(MnemonicEncoding(42))},
+			err:      ErrUnknownMnemonicEncoding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := FromMnemonic(tt.mnemonic, tt.opts...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, w.ClassicAddress)
+			require.Equal(t, tt.seed, w.Seed)
+		})
+	}
+
+	t.Run("pass - passphrase changes the wallet", func(t *testing.T) {
+		w, err := FromMnemonic(testMnemonic, WithPassphrase("TREZOR"))
+		require.NoError(t, err)
+		require.NotEqual(t, types.Address("rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3"), w.ClassicAddress)
+	})
+
+	t.Run("pass - account and change change the wallet", func(t *testing.T) {
+		seen := map[types.Address]bool{}
+		for _, path := range []DerivationPath{{}, {Account: 1}, {Change: 1}} {
+			w, err := FromMnemonic(testMnemonic, WithDerivationPath(path))
+			require.NoError(t, err)
+			require.False(t, seen[w.ClassicAddress])
+			seen[w.ClassicAddress] = true
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+)
+
+// FromSecretNumbers derives a Wallet from secret numbers, the eight blocks of six digits exported by
+// the Xaman wallet and the xrpl-secret-numbers library. The blocks can be separated by spaces or not separated.
+// Secret numbers only encode the entropy of the seed, so the algorithm must be given.
+// Xaman and xrpl.js derive SECP256K1 keys by default.
+func FromSecretNumbers(secretNumbers string, alg interfaces.CryptoImplementation) (Wallet, error) {
+	seed, err := SecretNumbersToSeed(secretNumbers, alg)
+	if err != nil {
+		return Wallet{}, err
+	}
+	return FromSeed(seed, "")
+}
+
+// SecretNumbersToSeed decodes secret numbers into a family seed of the given algorithm.
+// An invalid block is reported as an addresscodec.SecretNumbersBlockError.
+func SecretNumbersToSeed(secretNumbers string, alg interfaces.CryptoImplementation) (string, error) {
+	entropy, err := addresscodec.DecodeSecretNumbers(secretNumbers)
+	if err != nil {
+		return "", err
+	}
+	return addresscodec.EncodeSeed(entropy, alg)
+}
+
+// SeedToSecretNumbers encodes the entropy of a family seed as secret numbers.
+// The algorithm of the seed is not part of the secret numbers.
+func SeedToSecretNumbers(seed string) ([]string, error) {
+	entropy, _, err := addresscodec.DecodeSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	return addresscodec.EncodeSecretNumbers(entropy)
+}
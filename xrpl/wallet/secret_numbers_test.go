@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretNumbers = "399150 474506 009147 088773 432160 282843 253738 605430"
+
+func TestFromSecretNumbers(t *testing.T) {
+	tt := []struct {
+		name          string
+		secretNumbers string
+		alg           interfaces.CryptoImplementation
+		expected      Wallet
+		expectedErr   error
+	}{
+		{
+			name:          "pass - secp256k1",
+			secretNumbers: testSecretNumbers,
+			alg:           crypto.SECP256K1(),
+			expected: Wallet{
+				PublicKey:      "03BFC2F7AE242C3493187FA0B72BE97B2DF71194FB772E507FF9DEA0AD13CA1625",
+				PrivateKey:     "00B6FE8507D977E46E988A8A94DB3B8B35E404B60F8B11AC5213FA8B5ABC8A8D19",
+				ClassicAddress: "rQKQsPeE3iTRyfUypLhuq74gZdcRdwWqDp",
+				Seed:           "sh1HiK7SwjS1VxFdXi7qeMHRedrYX",
+			},
+		},
+		{
+			name:          "pass - blocks not separated",
+			secretNumbers: strings.ReplaceAll(testSecretNumbers, " ", ""),
+			alg:           crypto.SECP256K1(),
+			expected: Wallet{
+				PublicKey:      "03BFC2F7AE242C3493187FA0B72BE97B2DF71194FB772E507FF9DEA0AD13CA1625",
+				PrivateKey:     "00B6FE8507D977E46E988A8A94DB3B8B35E404B60F8B11AC5213FA8B5ABC8A8D19",
+				ClassicAddress: "rQKQsPeE3iTRyfUypLhuq74gZdcRdwWqDp",
+				Seed:           "sh1HiK7SwjS1VxFdXi7qeMHRedrYX",
+			},
+		},
+		{
+			name:          "fail - invalid checksum",
+			secretNumbers: "399150 474506 009147 088773 432160 282843 253738 605431",
+			alg:           crypto.SECP256K1(),
+			expectedErr:   addresscodec.ErrSecretNumbersChecksum,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromSecretNumbers(tc.secretNumbers, tc.alg)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, w)
+		})
+	}
+
+	t.Run("pass - ed25519", func(t *testing.T) {
+		w, err := FromSecretNumbers(testSecretNumbers, crypto.ED25519())
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(w.PublicKey, "ED"))
+		require.True(t, strings.HasPrefix(w.Seed, "sEd"))
+	})
+}
+
+func TestSeedToSecretNumbers(t *testing.T) {
+	t.Run("pass - round trip", func(t *testing.T) {
+		blocks, err := SeedToSecretNumbers("sh1HiK7SwjS1VxFdXi7qeMHRedrYX")
+		require.NoError(t, err)
+		require.Equal(t, strings.Fields(testSecretNumbers), blocks)
+
+		seed, err := SecretNumbersToSeed(strings.Join(blocks, " "), crypto.SECP256K1())
+		require.NoError(t, err)
+		require.Equal(t, "sh1HiK7SwjS1VxFdXi7qeMHRedrYX", seed)
+	})
+
+	t.Run("fail - invalid seed", func(t *testing.T) {
+		_, err := SeedToSecretNumbers("invalid")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Signer signs transactions and messages for an account with a parsed private key, so the key is not
+// parsed on every signature as it is by the signing methods of Wallet. It is created with Wallet.Signer
+// or NewSigner, and is safe for concurrent use.
+type Signer struct {
+	key       *keypairs.PrivateKey
+	publicKey string
+	address   types.Address
+}
+
+// NewSigner returns a signer of the account address with a parsed private key. The public key is derived
+// from the private key.
+func NewSigner(key *keypairs.PrivateKey, address types.Address) *Signer {
+	return &Signer{key: key, publicKey: key.PublicKey().Hex(), address: address}
+}
+
+// PublicKey returns the public key of the signer, as set in the SigningPubKey field of transactions.
+func (s *Signer) PublicKey() string {
+	return s.publicKey
+}
+
+// Address returns the classic address of the account of the signer.
+func (s *Signer) Address() types.Address {
+	return s.address
+}
+
+// Sign signs a transaction offline as Wallet.Sign does.
+func (s *Signer) Sign(tx map[string]interface{}) (string, string, error) {
+	// Copy the transaction to avoid modifying the original transaction
+	signTx := make(map[string]interface{}, len(tx)+2)
+	for k, v := range tx {
+		signTx[k] = v
+	}
+	signTx["SigningPubKey"] = s.publicKey
+
+	encodedTx, err := binarycodec.EncodeForSigning(signTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signature, err := s.computeSignature(encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signTx["TxnSignature"] = signature
+
+	txBlob, err := binarycodec.Encode(signTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	txHash, err := hash.SignTxBlob(txBlob)
+	if err != nil {
+		return "", "", err
+	}
+
+	return txBlob, txHash, nil
+}
+
+// SignTx signs a typed transaction offline as Wallet.SignTx does.
+func (s *Signer) SignTx(tx transaction.Tx) (string, string, error) {
+	fields := map[string]any{
+		"TransactionType": tx.TxType().String(),
+		"SigningPubKey":   s.publicKey,
+		"TxnSignature":    nil,
+	}
+
+	encodedTx, err := binarycodec.EncodeStructForSigning(tx, fields)
+	if err != nil {
+		// Only transactions that are not structs are signed in their flattened form: the struct encoding
+		// errors of other transactions are returned, as the flattened form would not encode them either.
+		if flat, ok := flattenTx(tx); ok && errors.Is(err, binarycodec.ErrNotAStruct) {
+			return s.Sign(flat)
+		}
+		return "", "", err
+	}
+
+	signature, err := s.signBytes(encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	fields["TxnSignature"] = signature
+	b, err := binarycodec.EncodeStruct(tx, fields)
+	if err != nil {
+		return "", "", err
+	}
+
+	// The blob is signed, so it is hashed right away instead of being decoded and checked by hash.SignTxBlob.
+	payload := binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(b)), hash.TransactionPrefix)
+	txHash := strings.ToUpper(hex.EncodeToString(crypto.Sha512Half(append(payload, b...))))
+
+	return strings.ToUpper(hex.EncodeToString(b)), txHash, nil
+}
+
+// flattenTx returns the flattened form of a transaction, to be signed by Sign, and whether it can be flattened.
+// Transactions are flattened either to a FlatTransaction or to a map.
+func flattenTx(tx transaction.Tx) (map[string]interface{}, bool) {
+	switch flat := tx.(type) {
+	case interface {
+		Flatten() transaction.FlatTransaction
+	}:
+		return flat.Flatten(), true
+	case interface{ Flatten() map[string]interface{} }:
+		return flat.Flatten(), true
+	default:
+		return nil, false
+	}
+}
+
+// Multisign signs a multisigned transaction offline as Wallet.Multisign does.
+func (s *Signer) Multisign(tx map[string]interface{}) (string, string, error) {
+	encodedTx, err := binarycodec.EncodeForMultisigning(tx, s.address.String())
+	if err != nil {
+		return "", "", err
+	}
+
+	txHash, err := s.computeSignature(encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signer := types.Signer{
+		SignerData: types.SignerData{
+			Account:       s.address,
+			TxnSignature:  txHash,
+			SigningPubKey: s.publicKey,
+		},
+	}
+
+	signedTx := make(map[string]interface{}, len(tx)+1)
+	for k, v := range tx {
+		signedTx[k] = v
+	}
+	signedTx["Signers"] = []any{signer.Flatten()}
+	blob, err := binarycodec.Encode(signedTx)
+	if err != nil {
+		return "", "", err
+	}
+	blobHash, err := hash.SignTxBlob(blob)
+	if err != nil {
+		return "", "", err
+	}
+
+	return blob, blobHash, nil
+}
+
+// computeSignature signs a hex encoded transaction, returning the signature as an uppercase hex string.
+func (s *Signer) computeSignature(encodedTx string) (string, error) {
+	rawTx, err := hex.DecodeString(encodedTx)
+	if err != nil {
+		return "", err
+	}
+
+	return s.signBytes(rawTx)
+}
+
+// signBytes signs encoded transaction bytes, returning the signature as an uppercase hex string.
+func (s *Signer) signBytes(rawTx []byte) (string, error) {
+	signature, err := s.key.Sign(rawTx)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(hex.EncodeToString(signature)), nil
+}
@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSigner(t *testing.T) {
+	testCases := []struct {
+		name string
+		seed string
+	}{
+		{name: "pass - ed25519", seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"},
+		{name: "pass - secp256k1", seed: "sh1HiK7SwjS1VxFdXi7qeMHRedrYX"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromSeed(tc.seed, "")
+			require.NoError(t, err)
+			key, err := keypairs.ParsePrivateKey(w.PrivateKey)
+			require.NoError(t, err)
+
+			s := NewSigner(key, w.ClassicAddress)
+			require.Equal(t, w.PublicKey, s.PublicKey())
+			require.Equal(t, w.ClassicAddress, s.Address())
+		})
+	}
+}
+
+func TestSigner_SignsAsWallet(t *testing.T) {
+	testCases := []struct {
+		name string
+		seed string
+	}{
+		{name: "pass - ed25519", seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"},
+		{name: "pass - secp256k1", seed: "sh1HiK7SwjS1VxFdXi7qeMHRedrYX"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromSeed(tc.seed, "")
+			require.NoError(t, err)
+			s, err := w.Signer()
+			require.NoError(t, err)
+
+			tx := &transaction.Payment{
+				BaseTx: transaction.BaseTx{
+					Account:  w.ClassicAddress,
+					Fee:      types.XRPCurrencyAmount(12),
+					Sequence: 1798962,
+				},
+				Amount:      types.XRPCurrencyAmount(1000000),
+				Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			}
+
+			// SECP256K1 signatures are deterministic (RFC6979), as are ED25519 ones.
+			expectedBlob, expectedHash, err := w.SignTx(tx)
+			require.NoError(t, err)
+			blob, hash, err := s.SignTx(tx)
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+
+			expectedBlob, expectedHash, err = w.Multisign(tx.Flatten())
+			require.NoError(t, err)
+			blob, hash, err = s.Multisign(tx.Flatten())
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+
+			expectedSig, err := w.SignMessage([]byte("message"))
+			require.NoError(t, err)
+			sig, err := s.SignMessage([]byte("message"))
+			require.NoError(t, err)
+			require.Equal(t, expectedSig, sig)
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"regexp/syntax"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/random"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+)
+
+// VanityMatcher reports whether a classic address is a vanity address.
+type VanityMatcher interface {
+	Match(address string) bool
+}
+
+// VanityMatcherFunc is a function that implements the VanityMatcher interface.
+type VanityMatcherFunc func(address string) bool
+
+// Match implements the VanityMatcher interface.
+func (f VanityMatcherFunc) Match(address string) bool {
+	return f(address)
+}
+
+// VanityPrefix returns a VanityMatcher for addresses starting with prefix, right after the leading r
+// every classic address starts with. For example, VanityPrefix("XRP") matches rXRP... addresses.
+// It returns an error if the prefix uses characters that are not in the XRPL base58 alphabet.
+func VanityPrefix(prefix string) (VanityMatcher, error) {
+	if err := validateVanityPattern(prefix); err != nil {
+		return nil, err
+	}
+	return VanityMatcherFunc(func(address string) bool {
+		return len(address) > 0 && strings.HasPrefix(address[1:], prefix)
+	}), nil
+}
+
+// VanitySuffix returns a VanityMatcher for addresses ending with suffix.
+// It returns an error if the suffix uses characters that are not in the XRPL base58 alphabet.
+func VanitySuffix(suffix string) (VanityMatcher, error) {
+	if err := validateVanityPattern(suffix); err != nil {
+		return nil, err
+	}
+	return VanityMatcherFunc(func(address string) bool {
+		return strings.HasSuffix(address, suffix)
+	}), nil
+}
+
+// VanityRegexp returns a VanityMatcher for addresses matching the regular expression expr, which is
+// matched against the whole address, leading r included. It returns an error if a literal of the
+// expression, or every character of a character class, is not in the XRPL base58 alphabet, as such
+// an expression would never or only partially match.
+func VanityRegexp(expr string) (VanityMatcher, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVanityRegexp(expr, re); err != nil {
+		return nil, err
+	}
+	compiled, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return VanityMatcherFunc(compiled.MatchString), nil
+}
+
+// validateVanityPattern checks that every character of pattern is in the XRPL base58 alphabet.
+func validateVanityPattern(pattern string) error {
+	if pattern == "" {
+		return ErrEmptyVanityPattern
+	}
+	for _, c := range pattern {
+		if !addresscodec.IsBase58Char(c) {
+			return ErrInvalidVanityCharacter{Pattern: pattern, Char: c}
+		}
+	}
+	return nil
+}
+
+// validateVanityRegexp checks that the literals and character classes of re can match base58 characters.
+func validateVanityRegexp(expr string, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if !isBase58Fold(c, re.Flags&syntax.FoldCase != 0) {
+				return ErrInvalidVanityCharacter{Pattern: expr, Char: c}
+			}
+		}
+	case syntax.OpCharClass:
+		if !classHasBase58Char(re.Rune) {
+			return ErrInvalidVanityCharacter{Pattern: expr, Char: re.Rune[0]}
+		}
+	}
+	for _, sub := range re.Sub {
+		if err := validateVanityRegexp(expr, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isBase58Fold reports whether c, or one of its case variants if fold is set, is a base58 character.
+func isBase58Fold(c rune, fold bool) bool {
+	if addresscodec.IsBase58Char(c) {
+		return true
+	}
+	if !fold {
+		return false
+	}
+	for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+		if addresscodec.IsBase58Char(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// classHasBase58Char reports whether the character class, given as pairs of inclusive ranges,
+// contains at least one base58 character.
+func classHasBase58Char(ranges []rune) bool {
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for c := ranges[i]; c <= min(ranges[i+1], unicode.MaxASCII); c++ {
+			if addresscodec.IsBase58Char(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// VanityProgress reports the progress of a vanity address search.
+type VanityProgress struct {
+	// Attempts is the number of addresses derived so far.
+	Attempts uint64
+	// Elapsed is the time elapsed since the search started.
+	Elapsed time.Duration
+	// Rate is the number of addresses derived per second.
+	Rate float64
+}
+
+// vanityOptions are the options used to search for a vanity address.
+type vanityOptions struct {
+	workers          int
+	algorithms       []interfaces.CryptoImplementation
+	progress         func(VanityProgress)
+	progressInterval time.Duration
+}
+
+// VanityOpt is a function that configures the search for a vanity address.
+type VanityOpt func(*vanityOptions)
+
+// WithVanityWorkers sets the number of goroutines deriving addresses.
+// Default: runtime.NumCPU().
+func WithVanityWorkers(workers int) VanityOpt {
+	return func(o *vanityOptions) {
+		o.workers = workers
+	}
+}
+
+// WithVanityAlgorithms sets the algorithms used to derive addresses. Attempts are spread evenly across them.
+// Default: ED25519 and SECP256K1.
+func WithVanityAlgorithms(algorithms ...interfaces.CryptoImplementation) VanityOpt {
+	return func(o *vanityOptions) {
+		o.algorithms = algorithms
+	}
+}
+
+// WithVanityProgress sets a function called with the progress of the search every interval.
+// It is called from a single goroutine, so it does not need to be safe for concurrent use.
+// Default: no progress is reported.
+func WithVanityProgress(interval time.Duration, fn func(VanityProgress)) VanityOpt {
+	return func(o *vanityOptions) {
+		o.progressInterval = interval
+		o.progress = fn
+	}
+}
+
+// GenerateVanity searches for a random wallet whose classic address matches matcher.
+// Random family seeds are derived by several worker goroutines until one matches, and the wallet of the
+// first match is returned. Every base58 character of a prefix or suffix makes the search about 58 times
+// longer, so the search is usually bounded with a context, whose error is returned when it is done.
+func GenerateVanity(ctx context.Context, matcher VanityMatcher, opts ...VanityOpt) (*Wallet, error) {
+	o := vanityOptions{
+		workers:    runtime.NumCPU(),
+		algorithms: []interfaces.CryptoImplementation{crypto.ED25519(), crypto.SECP256K1()},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.workers <= 0 {
+		o.workers = 1
+	}
+	if len(o.algorithms) == 0 {
+		return nil, ErrNoVanityAlgorithms
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		attempts atomic.Uint64
+		wg       sync.WaitGroup
+		found    = make(chan string, 1)
+		failed   = make(chan error, 1)
+		start    = time.Now()
+	)
+
+	for w := range o.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seed, err := searchVanity(ctx, matcher, o.algorithms, w, &attempts)
+			switch {
+			case err != nil:
+				select {
+				case failed <- err:
+				default:
+				}
+				cancel()
+			case seed != "":
+				select {
+				case found <- seed:
+				default:
+				}
+				cancel()
+			}
+		}()
+	}
+
+	if o.progress != nil && o.progressInterval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reportVanityProgress(ctx, o.progressInterval, o.progress, start, &attempts)
+		}()
+	}
+
+	wg.Wait()
+
+	select {
+	case seed := <-found:
+		w, err := FromSeed(seed, "")
+		if err != nil {
+			return nil, err
+		}
+		return &w, nil
+	case err := <-failed:
+		return nil, err
+	default:
+		return nil, ctx.Err()
+	}
+}
+
+// searchVanity derives random addresses until one matches or the context is done, and returns its seed.
+// Workers start at a different algorithm, so a single attempt per worker already covers all of them.
+func searchVanity(ctx context.Context, matcher VanityMatcher, algorithms []interfaces.CryptoImplementation, worker int, attempts *atomic.Uint64) (string, error) {
+	r := random.NewRandomizer()
+
+	for i := worker; ctx.Err() == nil; i++ {
+		alg := algorithms[i%len(algorithms)]
+
+		entropy, err := r.GenerateBytes(addresscodec.FamilySeedLength)
+		if err != nil {
+			return "", err
+		}
+		// The derivation may modify the seed it is given, so it is given a copy.
+		_, pubKey, err := alg.DeriveKeypair(bytes.Clone(entropy), false)
+		if err != nil {
+			return "", err
+		}
+		address, err := keypairs.DeriveClassicAddress(pubKey)
+		if err != nil {
+			return "", err
+		}
+		attempts.Add(1)
+
+		if matcher.Match(address) {
+			return addresscodec.EncodeSeed(entropy, alg)
+		}
+	}
+	return "", nil
+}
+
+// reportVanityProgress calls fn with the progress of the search every interval, until the context is done.
+func reportVanityProgress(ctx context.Context, interval time.Duration, fn func(VanityProgress), start time.Time, attempts *atomic.Uint64) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			elapsed := time.Since(start)
+			n := attempts.Load()
+			fn(VanityProgress{
+				Attempts: n,
+				Elapsed:  elapsed,
+				Rate:     float64(n) / elapsed.Seconds(),
+			})
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVanityPrefix(t *testing.T) {
+	tt := []struct {
+		name        string
+		prefix      string
+		address     string
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:     "pass - matches after the leading r",
+			prefix:   "Hb9",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: true,
+		},
+		{
+			name:     "pass - does not match",
+			prefix:   "XRP",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: false,
+		},
+		{
+			name:        "fail - character not in the alphabet",
+			prefix:      "XRP0",
+			expectedErr: ErrInvalidVanityCharacter{Pattern: "XRP0", Char: '0'},
+		},
+		{
+			name:        "fail - empty prefix",
+			prefix:      "",
+			expectedErr: ErrEmptyVanityPattern,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := VanityPrefix(tc.prefix)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, matcher.Match(tc.address))
+		})
+	}
+}
+
+func TestVanitySuffix(t *testing.T) {
+	tt := []struct {
+		name        string
+		suffix      string
+		address     string
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:     "pass - matches",
+			suffix:   "dtyTh",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: true,
+		},
+		{
+			name:     "pass - does not match",
+			suffix:   "XRP",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: false,
+		},
+		{
+			name:        "fail - character not in the alphabet",
+			suffix:      "Il",
+			expectedErr: ErrInvalidVanityCharacter{Pattern: "Il", Char: 'I'},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := VanitySuffix(tc.suffix)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, matcher.Match(tc.address))
+		})
+	}
+}
+
+func TestVanityRegexp(t *testing.T) {
+	tt := []struct {
+		name        string
+		expr        string
+		address     string
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:     "pass - anchored expression",
+			expr:     "^rHb9.*Th$",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: true,
+		},
+		{
+			name:     "pass - case insensitive literal",
+			expr:     "(?i)cjaw",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: true,
+		},
+		{
+			name:     "pass - character class with some base58 characters",
+			expr:     "^r[0-9]",
+			address:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expected: false,
+		},
+		{
+			name:        "fail - literal not in the alphabet",
+			expr:        "^rXRP0",
+			expectedErr: ErrInvalidVanityCharacter{Pattern: "^rXRP0", Char: '0'},
+		},
+		{
+			name:        "fail - character class without base58 characters",
+			expr:        "^r[0O]",
+			expectedErr: ErrInvalidVanityCharacter{Pattern: "^r[0O]", Char: '0'},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := VanityRegexp(tc.expr)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, matcher.Match(tc.address))
+		})
+	}
+
+	t.Run("fail - invalid expression", func(t *testing.T) {
+		_, err := VanityRegexp("^r(")
+		require.Error(t, err)
+	})
+}
+
+func TestGenerateVanity(t *testing.T) {
+	t.Run("pass - suffix with a single algorithm", func(t *testing.T) {
+		matcher, err := VanitySuffix("x")
+		require.NoError(t, err)
+
+		w, err := GenerateVanity(context.Background(), matcher,
+			WithVanityWorkers(2),
+			WithVanityAlgorithms(crypto.ED25519()),
+		)
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(w.ClassicAddress.String(), "x"))
+		require.True(t, strings.HasPrefix(w.PublicKey, "ED"))
+
+		derived, err := FromSeed(w.Seed, "")
+		require.NoError(t, err)
+		require.Equal(t, *w, derived)
+	})
+
+	t.Run("pass - prefix with both algorithms", func(t *testing.T) {
+		matcher, err := VanityPrefix("p")
+		require.NoError(t, err)
+
+		w, err := GenerateVanity(context.Background(), matcher, WithVanityWorkers(1))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(w.ClassicAddress.String(), "rp"))
+	})
+
+	t.Run("pass - reports progress until cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		var reports atomic.Int32
+		never := VanityMatcherFunc(func(string) bool { return false })
+
+		w, err := GenerateVanity(ctx, never,
+			WithVanityWorkers(2),
+			WithVanityProgress(10*time.Millisecond, func(p VanityProgress) {
+				reports.Add(1)
+				require.Positive(t, p.Elapsed)
+				require.GreaterOrEqual(t, p.Rate, 0.0)
+			}),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Nil(t, w)
+		require.Positive(t, reports.Load())
+	})
+
+	t.Run("fail - no algorithms", func(t *testing.T) {
+		never := VanityMatcherFunc(func(string) bool { return false })
+
+		_, err := GenerateVanity(context.Background(), never, WithVanityAlgorithms())
+		require.ErrorIs(t, err, ErrNoVanityAlgorithms)
+	})
+}
@@ -3,24 +3,12 @@
 package wallet
 
 import (
-	"encoding/hex"
-	"fmt"
-	"strings"
-
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
-	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/keypairs"
 	"github.com/Peersyst/xrpl-go/pkg/random"
-	"github.com/Peersyst/xrpl-go/xrpl/hash"
 	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
-	bip32 "github.com/bsv-blockchain/go-sdk/compat/bip32"
-	"github.com/bsv-blockchain/go-sdk/compat/bip39"
-	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
-)
-
-var (
-	nilHDPrivateKeyID = [4]byte{0x00, 0x00, 0x00, 0x00}
 )
 
 // Wallet is a utility for deriving a wallet composed of a keypair (publicKey/privateKey).
@@ -78,99 +66,49 @@ func FromSecret(seed string) (Wallet, error) {
 }
 
 // FromMnemonic derives a Wallet from a bip39 or RFC1751 mnemonic (defaults to bip39).
-func FromMnemonic(mnemonic string) (*Wallet, error) {
-	// Validate the mnemonic
-	if !bip39.IsMnemonicValid(mnemonic) {
-		return nil, bip39.ErrInvalidMnemonic
-	}
-
-	// Generate seed from mnemonic
-	seed := bip39.NewSeed(mnemonic, "")
-
-	// Derive the master key
-
-	params := &chaincfg.Params{
-		HDPrivateKeyID: nilHDPrivateKeyID,
-	}
-	masterKey, err := bip32.NewMaster(seed, params)
-	if err != nil {
-		return nil, err
-	}
-
-	// Derive the key using the path m/44'/144'/0'/0/0
-	path := []uint32{
-		44 + bip32.HardenedKeyStart,
-		144 + bip32.HardenedKeyStart,
-		bip32.HardenedKeyStart,
-		0,
-		0,
-	}
-
-	key := masterKey
-	for _, childNum := range path {
-		key, err = key.Child(childNum)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Convert the private key to the format expected by the XRPL library
-	ecPriv, err := key.ECPrivKey()
-	if err != nil {
-		return nil, err
+// BIP39 mnemonics derive a SECP256K1 key at the BIP44 path m/44'/144'/0'/0/0 by default, which can be changed
+// with WithDerivationPath, and accept a passphrase with WithPassphrase. RFC1751 mnemonics, selected with
+// WithMnemonicEncoding, encode a family seed, which is set in the Seed field of the wallet.
+func FromMnemonic(mnemonic string, opts ...MnemonicOpt) (*Wallet, error) {
+	o := mnemonicOptions{
+		encoding: MnemonicEncodingBIP39,
+		path:     DefaultDerivationPath,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	switch o.encoding {
+	case MnemonicEncodingBIP39:
+		return fromBIP39Mnemonic(mnemonic, o)
+	case MnemonicEncodingRFC1751:
+		return fromRFC1751Mnemonic(mnemonic, o)
+	default:
+		return nil, ErrUnknownMnemonicEncoding
 	}
-
-	privKey := strings.ToUpper(ecPriv.Hex())
-	pubKey := strings.ToUpper(hex.EncodeToString(ecPriv.PubKey().Compressed()))
-
-	// Derive classic address
-	classicAddr, err := keypairs.DeriveClassicAddress(pubKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return &Wallet{
-		PublicKey:      pubKey,
-		PrivateKey:     fmt.Sprintf("00%s", privKey),
-		ClassicAddress: types.Address(classicAddr),
-		Seed:           "", // We don't have the seed in this case
-	}, nil
 }
 
-// Sign signs a transaction offline, returning the transaction blob and its signature.
-// TODO: Refactor to accept a `Transaction` object instead of a map.
+// Sign signs a transaction offline, returning the transaction blob and its hash.
+// The transaction is not modified: SigningPubKey and TxnSignature are only set in the blob.
+// See SignTx to sign a typed transaction. The private key is parsed on every call; see Signer.
 func (w *Wallet) Sign(tx map[string]interface{}) (string, string, error) {
-	tx["SigningPubKey"] = w.PublicKey
-
-	// Copy the transaction to avoid modifying the original transaction
-	signTx := make(map[string]interface{}, len(tx))
-	for k, v := range tx {
-		signTx[k] = v
-	}
-
-	encodedTx, err := binarycodec.EncodeForSigning(signTx)
-	if err != nil {
-		return "", "", err
-	}
-
-	txHash, err := w.computeSignature(encodedTx)
-	if err != nil {
-		return "", "", err
-	}
-
-	tx["TxnSignature"] = txHash
-
-	txBlob, err := binarycodec.Encode(tx)
+	s, err := w.Signer()
 	if err != nil {
 		return "", "", err
 	}
+	return s.Sign(tx)
+}
 
-	txHash, err = hash.SignTxBlob(txBlob)
+// SignTx signs a typed transaction offline, returning the transaction blob and its hash, as Sign does
+// with its flattened form. The transaction is encoded straight from its struct, without building a map,
+// and is not modified. The transaction type is taken from TxType, as with Flatten.
+// Transactions that are not structs are signed in their flattened form instead.
+func (w *Wallet) SignTx(tx transaction.Tx) (string, string, error) {
+	s, err := w.Signer()
 	if err != nil {
 		return "", "", err
 	}
-
-	return txBlob, txHash, nil
+	return s.SignTx(tx)
 }
 
 // GetAddress returns the classic address of the wallet.
@@ -179,52 +117,33 @@ func (w *Wallet) GetAddress() types.Address {
 }
 
 // Multisign signs a multisigned transaction offline, returning the signed transaction blob and its transaction hash.
+// The transaction is not modified: the signer is only set in the blob.
 func (w *Wallet) Multisign(tx map[string]interface{}) (string, string, error) {
-	encodedTx, err := binarycodec.EncodeForMultisigning(tx, w.ClassicAddress.String())
-	if err != nil {
-		return "", "", err
-	}
-
-	txHash, err := w.computeSignature(encodedTx)
+	s, err := w.Signer()
 	if err != nil {
 		return "", "", err
 	}
+	return s.Multisign(tx)
+}
 
-	signer := types.Signer{
-		SignerData: types.SignerData{
-			Account:       w.ClassicAddress,
-			TxnSignature:  txHash,
-			SigningPubKey: w.PublicKey,
-		},
-	}
-
-	tx["Signers"] = []any{signer.Flatten()}
-	blob, err := binarycodec.Encode(tx)
+// Signer returns a signer with the parsed private key of the wallet, to sign many transactions or messages
+// without parsing the key on every signature.
+func (w *Wallet) Signer() (*Signer, error) {
+	privKey, err := keypairs.ParsePrivateKey(w.PrivateKey)
 	if err != nil {
-		return "", "", err
-	}
-	blobHash, err := hash.SignTxBlob(blob)
-	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-
-	return blob, blobHash, nil
+	return &Signer{key: privKey, publicKey: w.PublicKey, address: w.ClassicAddress}, nil
 }
 
 // Computes the signature of a transaction.
 // Returns the signature of the transaction. If an error occurs, it will return an error.
 func (w *Wallet) computeSignature(encodedTx string) (string, error) {
-	hexTx, err := hex.DecodeString(encodedTx)
+	s, err := w.Signer()
 	if err != nil {
 		return "", err
 	}
-
-	txHash, err := keypairs.Sign(string(hexTx), w.PrivateKey)
-	if err != nil {
-		return "", err
-	}
-
-	return txHash, nil
+	return s.computeSignature(encodedTx)
 }
 
 // Ensures that the address is a classic address.
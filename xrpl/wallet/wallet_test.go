@@ -1,9 +1,15 @@
 package wallet
 
 import (
+	"maps"
 	"testing"
 
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewWalletFromSeed(t *testing.T) {
@@ -227,3 +233,331 @@ func TestSign(t *testing.T) {
 		})
 	}
 }
+
+func TestSign_DoesNotModifyTransaction(t *testing.T) {
+	w := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	tx := map[string]any{
+		"Account":         "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		"TransactionType": "Payment",
+		"Amount":          "15",
+		"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		"Fee":             "12",
+		"Sequence":        uint32(1798962),
+		"UnknownField":    "kept",
+	}
+	original := maps.Clone(tx)
+
+	_, _, err := w.Sign(tx)
+	require.NoError(t, err)
+	require.Equal(t, original, tx)
+
+	_, _, err = w.Multisign(tx)
+	require.NoError(t, err)
+	require.Equal(t, original, tx)
+}
+
+func TestSignTx(t *testing.T) {
+	w := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	base := transaction.BaseTx{
+		Account:            "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		Fee:                types.XRPCurrencyAmount(12),
+		Sequence:           1798962,
+		LastLedgerSequence: 1799000,
+		Memos: []types.MemoWrapper{
+			{Memo: types.Memo{MemoType: "687474703A2F2F6578616D706C652E636F6D", MemoData: "72656E74"}},
+		},
+	}
+	usd := types.IssuedCurrencyAmount{
+		Issuer:   "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		Currency: "USD",
+		Value:    "1.5",
+	}
+	destinationTag := uint32(0)
+	domain := "6578616D706C652E636F6D"
+	tickSize := uint8(5)
+	assetScale := uint8(2)
+
+	testCases := []struct {
+		name string
+		tx   transaction.Tx
+	}{
+		{
+			name: "pass - XRP payment",
+			tx: &transaction.Payment{
+				BaseTx:         base,
+				Amount:         types.XRPCurrencyAmount(15),
+				Destination:    "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				DestinationTag: &destinationTag,
+			},
+		},
+		{
+			name: "pass - issued currency payment",
+			tx: &transaction.Payment{
+				BaseTx:      base,
+				Amount:      usd,
+				SendMax:     types.XRPCurrencyAmount(2000000),
+				Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			},
+		},
+		{
+			name: "pass - MPT payment",
+			tx: &transaction.Payment{
+				BaseTx: base,
+				Amount: types.MPTCurrencyAmount{
+					MPTIssuanceID: "00000001A407AF5856CEFBF81F3D4A8A4C4F5B2E0E4E0F1B",
+					Value:         "100",
+				},
+				Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			},
+		},
+		{
+			name: "pass - offer create",
+			tx: &transaction.OfferCreate{
+				BaseTx:    base,
+				TakerGets: types.XRPCurrencyAmount(1000000),
+				TakerPays: usd,
+			},
+		},
+		{
+			name: "pass - trust set",
+			tx: &transaction.TrustSet{
+				BaseTx:      base,
+				LimitAmount: usd,
+				QualityIn:   1000000000,
+			},
+		},
+		{
+			name: "pass - account set with pointers",
+			tx: &transaction.AccountSet{
+				BaseTx:   base,
+				SetFlag:  8,
+				Domain:   &domain,
+				TickSize: &tickSize,
+			},
+		},
+		{
+			name: "pass - nftoken mint with zero taxon",
+			tx: &transaction.NFTokenMint{
+				BaseTx:       base,
+				NFTokenTaxon: 0,
+				URI:          "697066733A2F2F",
+			},
+		},
+		{
+			name: "pass - mptoken issuance create",
+			tx: &transaction.MPTokenIssuanceCreate{
+				BaseTx:     base,
+				AssetScale: &assetScale,
+			},
+		},
+		{
+			name: "pass - delegate set",
+			tx: &transaction.DelegateSet{
+				BaseTx:    base,
+				Authorize: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				Permissions: []types.Permission{
+					{Permission: types.PermissionValue{PermissionValue: "Payment"}},
+					{Permission: types.PermissionValue{PermissionValue: "TrustlineAuthorize"}},
+				},
+			},
+		},
+		{
+			name: "pass - escrow create",
+			tx: &transaction.EscrowCreate{
+				BaseTx:      base,
+				Amount:      types.XRPCurrencyAmount(10000),
+				Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				CancelAfter: 533257958,
+				FinishAfter: 533171558,
+			},
+		},
+		{
+			name: "pass - credential create",
+			tx: &transaction.CredentialCreate{
+				BaseTx:         base,
+				Subject:        "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				CredentialType: "6D795F63726564656E7469616C",
+				Expiration:     1000,
+			},
+		},
+		{
+			name: "pass - oracle set",
+			tx: &transaction.OracleSet{
+				BaseTx:           base,
+				OracleDocumentID: 1,
+				Provider:         "70726F7669646572",
+				AssetClass:       "63757272656E6379",
+				LastUpdatedTime:  743609014,
+				PriceDataSeries: []ledger.PriceDataWrapper{
+					// AssetPrice is flattened as a decimal string, which matches its hex form below 10.
+					{PriceData: ledger.PriceData{BaseAsset: "XRP", QuoteAsset: "USD", AssetPrice: 7, Scale: 1}},
+					{PriceData: ledger.PriceData{BaseAsset: "XRP", QuoteAsset: "EUR", AssetPrice: 6, Scale: 1}},
+				},
+			},
+		},
+		{
+			// The bridge is left out, as XChainBridge fields cannot be decoded to hash the signed blob.
+			name: "pass - xchain add claim attestation",
+			tx: &transaction.XChainAddClaimAttestation{
+				BaseTx:                   base,
+				Amount:                   types.XRPCurrencyAmount(10000000),
+				AttestationRewardAccount: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				AttestationSignerAccount: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+				Destination:              "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				OtherChainSource:         "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+				PublicKey:                "03ADB44CA8E56F78A0096825E5667C450ABD5C24C34E027BC1AAF7E5BD114CB5B5",
+				Signature:                "3044022036C8B90F85E8073C465F00625248A72D4714600F98EBBADBAD3B7ED226109A3A02204C5A0AE12D169CF790F66541F3DB59C289E0D99CA7511FDFE352BB601F667A26",
+				WasLockingChainSend:      1,
+				XChainClaimID:            "000000000000013F",
+			},
+		},
+		{
+			name: "pass - batch",
+			tx: &transaction.Batch{
+				BaseTx: base,
+				RawTransactions: []types.RawTransaction{
+					{RawTransaction: map[string]any{
+						"TransactionType": "Payment",
+						"Account":         "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+						"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+						"Amount":          "5000000",
+						"Fee":             "0",
+						"Sequence":        uint32(1798963),
+						"Flags":           types.TfInnerBatchTxn,
+						"SigningPubKey":   "",
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flat, ok := flattenTx(tc.tx)
+			require.True(t, ok)
+			expectedBlob, expectedHash, err := w.Sign(flat)
+			require.NoError(t, err)
+
+			blob, hash, err := w.SignTx(tc.tx)
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+		})
+	}
+}
+
+// flatTx is a transaction that is not a struct, so it can only be signed in its flattened form.
+type flatTx transaction.FlatTransaction
+
+func (tx flatTx) TxType() transaction.TxType {
+	return transaction.TxType(tx["TransactionType"].(string))
+}
+
+func (tx flatTx) Flatten() transaction.FlatTransaction {
+	return transaction.FlatTransaction(tx)
+}
+
+// validFlattenedPayment is a payment whose flattened form is valid whatever its struct holds.
+type validFlattenedPayment struct {
+	transaction.Payment
+}
+
+func (tx *validFlattenedPayment) Flatten() transaction.FlatTransaction {
+	valid := tx.Payment
+	valid.Destination = "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg"
+	return valid.Flatten()
+}
+
+func TestSignTx_Fallback(t *testing.T) {
+	w := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	payment := transaction.Payment{
+		BaseTx: transaction.BaseTx{
+			Account:  "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+			Fee:      types.XRPCurrencyAmount(12),
+			Sequence: 1798962,
+		},
+		Amount:      types.XRPCurrencyAmount(1000000),
+		Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+	}
+
+	t.Run("pass - transaction that is not a struct", func(t *testing.T) {
+		expectedBlob, expectedHash, err := w.Sign(payment.Flatten())
+		require.NoError(t, err)
+
+		blob, hash, err := w.SignTx(flatTx(payment.Flatten()))
+		require.NoError(t, err)
+		require.Equal(t, expectedBlob, blob)
+		require.Equal(t, expectedHash, hash)
+	})
+
+	t.Run("fail - struct encoding error", func(t *testing.T) {
+		invalid := payment
+		invalid.Destination = "invalid"
+
+		_, _, err := w.SignTx(&validFlattenedPayment{Payment: invalid})
+		require.ErrorIs(t, err, addresscodec.ErrInvalidAddressFormat)
+	})
+}
+
+func TestSignTx_IntegerFields(t *testing.T) {
+	w := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	base := transaction.BaseTx{
+		Account:  "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		Fee:      types.XRPCurrencyAmount(12),
+		Sequence: 1798962,
+	}
+	transferFee := uint16(500)
+
+	testCases := []struct {
+		name     string
+		tx       transaction.Tx
+		expected map[string]any
+	}{
+		{
+			name: "pass - amm create with zero trading fee",
+			tx: &transaction.AMMCreate{
+				BaseTx:  base,
+				Amount:  types.XRPCurrencyAmount(1000000),
+				Amount2: types.IssuedCurrencyAmount{Issuer: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg", Currency: "USD", Value: "1"},
+			},
+			expected: map[string]any{"TransactionType": "AMMCreate", "TradingFee": 0},
+		},
+		{
+			name: "pass - nftoken mint with transfer fee",
+			tx: &transaction.NFTokenMint{
+				BaseTx:      base,
+				TransferFee: &transferFee,
+			},
+			expected: map[string]any{"TransactionType": "NFTokenMint", "NFTokenTaxon": uint32(0), "TransferFee": 500},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blob, _, err := w.SignTx(tc.tx)
+			require.NoError(t, err)
+
+			decoded, err := binarycodec.Decode(blob)
+			require.NoError(t, err)
+			for k, v := range tc.expected {
+				require.Equal(t, v, decoded[k], k)
+			}
+		})
+	}
+}
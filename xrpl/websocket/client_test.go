@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
@@ -1652,3 +1653,28 @@ func setupTestClientForAutofill(t *testing.T, serverMessages []map[string]any) (
 		s.Close()
 	}
 }
+
+func TestClient_RequestWithInterceptors(t *testing.T) {
+	cl, cleanup := setupTestClient(t, []map[string]any{
+		{
+			"id": 1,
+			"result": map[string]any{
+				"account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			},
+		},
+	})
+	defer cleanup()
+
+	var methods []string
+	cl.cfg = cl.cfg.WithInterceptors(func(req xrpl.Request, next xrpl.Invoker) (xrpl.Response, error) {
+		methods = append(methods, req.Method())
+		return next(req)
+	})
+
+	res, err := cl.Request(&account.ChannelsRequest{
+		Account: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.ID)
+	require.Equal(t, []string{"account_channels"}, methods)
+}
@@ -3,6 +3,7 @@ package websocket
 import (
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 )
 
@@ -21,6 +22,12 @@ type ClientConfig struct {
 
 	// Faucet config
 	faucetProvider common.FaucetProvider
+
+	// Interceptors config
+	interceptors []xrpl.Interceptor
+
+	// Stand-alone mode config
+	standalone bool
 }
 
 // NewClientConfig returns a ClientConfig initialized with default settings.
@@ -91,3 +98,20 @@ func (wc ClientConfig) WithTimeout(timeout time.Duration) ClientConfig {
 	wc.timeout = timeout
 	return wc
 }
+
+// WithInterceptors appends interceptors to the chain wrapping every request.
+// The first interceptor is the outermost one. See xrpl.LoggingInterceptor and xrpl.MetricsInterceptor.
+// Default: no interceptors
+func (wc ClientConfig) WithInterceptors(interceptors ...xrpl.Interceptor) ClientConfig {
+	wc.interceptors = append(append([]xrpl.Interceptor{}, wc.interceptors...), interceptors...)
+	return wc
+}
+
+// WithStandalone enables stand-alone mode, for local rippled servers started with --standalone.
+// In this mode, SubmitTxBlobAndWait and SubmitTxAndWait close ledgers with ledger_accept after submitting
+// until the transaction is validated, instead of waiting for consensus. It requires an admin connection to the server.
+// Default: false
+func (wc ClientConfig) WithStandalone() ClientConfig {
+	wc.standalone = true
+	return wc
+}
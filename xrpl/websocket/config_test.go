@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/faucet"
 	"github.com/stretchr/testify/require"
@@ -48,3 +49,15 @@ func TestWithTimeout(t *testing.T) {
 	config := NewClientConfig().WithTimeout(10 * time.Second)
 	require.Equal(t, config.timeout, 10*time.Second)
 }
+
+func TestWithInterceptors(t *testing.T) {
+	base := NewClientConfig().WithInterceptors(xrpl.MetricsInterceptor(nil))
+	config := base.WithInterceptors(xrpl.MetricsInterceptor(nil))
+	require.Len(t, base.interceptors, 1)
+	require.Len(t, config.interceptors, 2)
+}
+
+func TestWithStandalone(t *testing.T) {
+	config := NewClientConfig().WithStandalone()
+	require.True(t, config.standalone)
+}
@@ -3,6 +3,8 @@ package websocket
 import (
 	"errors"
 	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 const (
@@ -20,34 +22,34 @@ var (
 	// ErrMissingWallet is returned when a wallet is required but not provided for an unsigned transaction.
 	ErrMissingWallet = errors.New("wallet must be provided when submitting an unsigned transaction")
 	// ErrTransactionNotFound is returned when a transaction cannot be found.
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound = xrpl.ErrTransactionNotFound
 	// ErrMissingAccountInTransaction is returned when the Account field is missing from a transaction.
-	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
+	ErrMissingAccountInTransaction = xrpl.ErrMissingAccountInTransaction
 	// ErrTransactionTypeMissing is returned when the transaction type is missing from a transaction.
-	ErrTransactionTypeMissing = errors.New("transaction type is missing in transaction")
+	ErrTransactionTypeMissing = xrpl.ErrTransactionTypeMissing
 	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
-	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+	ErrInvalidFulfillmentLength = xrpl.ErrInvalidFulfillmentLength
 
 	// fields
 
 	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	ErrRawTransactionsFieldIsNotAnArray = xrpl.ErrRawTransactionsFieldIsNotAnArray
 	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
-	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	ErrRawTransactionFieldIsNotAnObject = xrpl.ErrRawTransactionFieldIsNotAnObject
 	// ErrSigningPubKeyFieldMustBeEmpty is returned when the SigningPubKey field should be empty but isn't.
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	ErrSigningPubKeyFieldMustBeEmpty = xrpl.ErrSigningPubKeyFieldMustBeEmpty
 	// ErrTxnSignatureFieldMustBeEmpty is returned when the TxnSignature field should be empty but isn't.
-	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	ErrTxnSignatureFieldMustBeEmpty = xrpl.ErrTxnSignatureFieldMustBeEmpty
 	// ErrSignersFieldMustBeEmpty is returned when the Signers field should be empty but isn't.
-	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	ErrSignersFieldMustBeEmpty = xrpl.ErrSignersFieldMustBeEmpty
 	// ErrAccountFieldIsNotAString is returned when the Account field is not a string type.
-	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	ErrAccountFieldIsNotAString = xrpl.ErrAccountFieldIsNotAString
 	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
-	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	ErrRawTransactionsFieldMissing = xrpl.ErrRawTransactionsFieldMissing
 	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
-	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	ErrRawTransactionFieldMissing = xrpl.ErrRawTransactionFieldMissing
 	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
-	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+	ErrFeeFieldMissing = xrpl.ErrFeeFieldMissing
 
 	// client
 
@@ -59,6 +61,8 @@ var (
 	ErrRequestTimedOut = errors.New("request timed out")
 	// ErrSignerDataIsEmpty is returned when signer data is empty or missing.
 	ErrSignerDataIsEmpty = errors.New("signer data is empty")
+	// ErrUnsupportedResponse is returned when an interceptor returns a response that is not a ClientResponse.
+	ErrUnsupportedResponse = errors.New("response must be a ClientResponse")
 
 	// wallet
 
@@ -68,19 +72,24 @@ var (
 	// fees
 
 	// ErrCouldNotGetBaseFeeXrp is returned when BaseFeeXrp cannot be retrieved from ServerInfo.
-	ErrCouldNotGetBaseFeeXrp = errors.New("get fee xrp: could not get BaseFeeXrp from ServerInfo")
+	ErrCouldNotGetBaseFeeXrp = xrpl.ErrCouldNotGetBaseFeeXrp
 	// ErrCouldNotFetchOwnerReserve is returned when the owner reserve fee cannot be fetched.
-	ErrCouldNotFetchOwnerReserve = errors.New("could not fetch Owner Reserve")
+	ErrCouldNotFetchOwnerReserve = xrpl.ErrCouldNotFetchOwnerReserve
 
 	// account
 
 	// ErrAccountCannotBeDeleted is returned when an account cannot be deleted due to associated objects.
-	ErrAccountCannotBeDeleted = errors.New("account cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
+	ErrAccountCannotBeDeleted = xrpl.ErrAccountCannotBeDeleted
 
 	// payment
 
 	// ErrAmountAndDeliverMaxMustBeIdentical is returned when Amount and DeliverMax fields are not identical.
-	ErrAmountAndDeliverMaxMustBeIdentical = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+	ErrAmountAndDeliverMaxMustBeIdentical = xrpl.ErrAmountAndDeliverMaxMustBeIdentical
+
+	// order book
+
+	// ErrInvalidOrderBookAssets is returned when the assets of an order book are not two different XRP or issued currency assets.
+	ErrInvalidOrderBookAssets = errors.New("order book assets must be two different XRP or issued currency assets")
 
 	// connection
 
@@ -121,12 +130,4 @@ func (e ErrMaxReconnectionAttemptsReached) Error() string {
 }
 
 // ErrFailedToParseFee is returned when fee parsing fails.
-type ErrFailedToParseFee struct {
-	Fee string
-	Err error
-}
-
-// Error implements the error interface for ErrFailedToParseFee
-func (e ErrFailedToParseFee) Error() string {
-	return fmt.Sprintf("failed to parse fee %s: %v", e.Fee, e.Err)
-}
+type ErrFailedToParseFee = xrpl.ErrFailedToParseFee
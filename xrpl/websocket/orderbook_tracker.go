@@ -0,0 +1,576 @@
+package websocket
+
+import (
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/orderbook"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// OrderBookTrackerConfig configures an OrderBookTracker.
+type OrderBookTrackerConfig struct {
+	// Base and Quote are the assets of the book: asks give Base for Quote, and bids give Quote for Base.
+	// They must be XRP or issued currencies.
+	Base  currency.Asset
+	Quote currency.Asset
+	// Taker is the account taking the offers, if any. Offers it owns are ignored by book_offers, and it pays no
+	// transfer fee on the assets it issues.
+	Taker types.Address
+	// Limit is the maximum number of offers of each side loaded from book_offers, or 0 for the server default.
+	// Offers worse than the last one loaded are only known once created or modified after the snapshot.
+	Limit int
+}
+
+// PriceLevel is the offers of a side of an order book at the same price.
+type PriceLevel struct {
+	// Price is the price of the level in Quote per Base, drops being the unit of XRP.
+	Price currency.Amount
+	// Quality is the quality of the offers of the level.
+	Quality orderbook.Quality
+	// Base and Quote are the funded amounts of the offers of the level.
+	Base  currency.Amount
+	Quote currency.Amount
+	// Offers is the number of offers of the level.
+	Offers int
+}
+
+// OrderBookUpdate is a change of the order book of an OrderBookTracker.
+type OrderBookUpdate struct {
+	// LedgerIndex is the validated ledger of the change.
+	LedgerIndex common.LedgerIndex
+	// Hash is the hash of the transaction that changed the book. It is empty when Resync is true.
+	Hash common.LedgerHash
+	// Resync is true if the book was reloaded from a book_offers snapshot.
+	Resync bool
+}
+
+// orderBookSnapshot is the state of both sides of a book at a validated ledger.
+type orderBookSnapshot struct {
+	ledgerIndex common.LedgerIndex
+	asks, bids  []pathtypes.BookOffer
+	baseRate    uint32
+	quoteRate   uint32
+}
+
+// orderBookEvent is an event processed by the worker of an OrderBookTracker: a transaction, a closed ledger or
+// a resync request.
+type orderBookEvent struct {
+	tx     *streamtypes.TransactionStream
+	ledger *streamtypes.LedgerStream
+	resync bool
+}
+
+// OrderBookTracker keeps a local copy of both sides of an order book: it loads a book_offers snapshot at a
+// validated ledger, then applies the Offer changes of the validated transactions of the book stream, using
+// orderbook.Book.ApplyMetadata. The ledger stream is used to detect gaps: when a ledger or a transaction of the
+// book is missed, the tracker reloads the snapshot.
+//
+// Owner funds are those of the snapshot, updated by the balance changes of the transactions of the book.
+// The view methods are safe for concurrent use. Handlers are called from the goroutine applying the changes.
+type OrderBookTracker struct {
+	client *Client
+	cfg    OrderBookTrackerConfig
+
+	// subscribe and snapshot are replaced in tests.
+	subscribe func() error
+	snapshot  func() (orderBookSnapshot, error)
+
+	mu          sync.RWMutex
+	asks        *orderbook.Book
+	bids        *orderbook.Book
+	askOffers   []orderbook.Offer
+	bidOffers   []orderbook.Offer
+	askLevels   []PriceLevel
+	bidLevels   []PriceLevel
+	snapshotIdx common.LedgerIndex
+	ledgerIdx   common.LedgerIndex
+	synced      bool
+
+	updateHandler func(update *OrderBookUpdate)
+	errHandler    func(err error)
+
+	queueMu  sync.Mutex
+	queue    []orderBookEvent
+	signal   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewOrderBookTracker returns a tracker of the order book of cfg.Base and cfg.Quote, which follows the book once
+// started.
+func NewOrderBookTracker(client *Client, cfg OrderBookTrackerConfig) (*OrderBookTracker, error) {
+	if !isBookAsset(cfg.Base) || !isBookAsset(cfg.Quote) || cfg.Base == cfg.Quote {
+		return nil, ErrInvalidOrderBookAssets
+	}
+	t := &OrderBookTracker{
+		client: client,
+		cfg:    cfg,
+		asks:   orderbook.NewBook(cfg.Base, cfg.Quote),
+		bids:   orderbook.NewBook(cfg.Quote, cfg.Base),
+		signal: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	t.subscribe = t.subscribeStreams
+	t.snapshot = t.fetchSnapshot
+	return t, nil
+}
+
+// Start subscribes to the book and ledger streams, loads the snapshot of the book, and starts applying changes.
+// It sets the OnTransactions and OnLedgerClosed handlers of the client to HandleTransaction and
+// HandleLedgerClosed: clients with their own handlers must forward the streams to the tracker instead.
+func (t *OrderBookTracker) Start() error {
+	t.client.OnTransactions(t.HandleTransaction)
+	t.client.OnLedgerClosed(t.HandleLedgerClosed)
+
+	if err := t.subscribe(); err != nil {
+		return err
+	}
+	if err := t.sync(); err != nil {
+		return err
+	}
+	go t.run()
+	return nil
+}
+
+// Stop stops applying changes and unsubscribes from the book and ledger streams.
+func (t *OrderBookTracker) Stop() error {
+	t.stopOnce.Do(func() { close(t.done) })
+	_, err := t.client.Unsubscribe(&subscribe.UnsubscribeRequest{
+		Streams: []string{"ledger"},
+		Books: []subscribe.UnsubscribeOrderBook{{
+			TakerGets: bookCurrency(t.cfg.Base),
+			TakerPays: bookCurrency(t.cfg.Quote),
+			Both:      true,
+		}},
+	})
+	return err
+}
+
+// Resync subscribes to the streams again and reloads the snapshot of the book, such as after the client
+// reconnected.
+func (t *OrderBookTracker) Resync() {
+	t.enqueue(orderBookEvent{resync: true})
+}
+
+// HandleTransaction queues a transaction of the book stream to be applied. It does not block.
+func (t *OrderBookTracker) HandleTransaction(tx *streamtypes.TransactionStream) {
+	t.enqueue(orderBookEvent{tx: tx})
+}
+
+// HandleLedgerClosed queues a closed ledger of the ledger stream, to detect gaps. It does not block.
+func (t *OrderBookTracker) HandleLedgerClosed(ledger *streamtypes.LedgerStream) {
+	t.enqueue(orderBookEvent{ledger: ledger})
+}
+
+// OnUpdate sets the handler called after every change of the offers of the book.
+func (t *OrderBookTracker) OnUpdate(handler func(update *OrderBookUpdate)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.updateHandler = handler
+}
+
+// OnError sets the handler called when the book cannot be loaded or a change cannot be applied. The tracker
+// is out of sync until the snapshot is reloaded, on the next closed ledger.
+func (t *OrderBookTracker) OnError(handler func(err error)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errHandler = handler
+}
+
+// Synced returns true if the book is loaded and no gap was detected since.
+func (t *OrderBookTracker) Synced() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.synced
+}
+
+// LedgerIndex returns the last validated ledger applied to the book.
+func (t *OrderBookTracker) LedgerIndex() common.LedgerIndex {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.ledgerIdx
+}
+
+// BestBid returns the price level of the highest bid, if any.
+func (t *OrderBookTracker) BestBid() (PriceLevel, bool) {
+	return t.best(&t.bidLevels)
+}
+
+// BestAsk returns the price level of the lowest ask, if any.
+func (t *OrderBookTracker) BestAsk() (PriceLevel, bool) {
+	return t.best(&t.askLevels)
+}
+
+// Bids returns up to depth price levels of bids, highest price first, or all of them if depth is 0.
+func (t *OrderBookTracker) Bids(depth int) []PriceLevel {
+	return t.levels(&t.bidLevels, depth)
+}
+
+// Asks returns up to depth price levels of asks, lowest price first, or all of them if depth is 0.
+func (t *OrderBookTracker) Asks(depth int) []PriceLevel {
+	return t.levels(&t.askLevels, depth)
+}
+
+// BidOffers returns the offers of the bids, best first, with their funded amounts.
+func (t *OrderBookTracker) BidOffers() []orderbook.Offer {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return append([]orderbook.Offer(nil), t.bidOffers...)
+}
+
+// AskOffers returns the offers of the asks, best first, with their funded amounts.
+func (t *OrderBookTracker) AskOffers() []orderbook.Offer {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return append([]orderbook.Offer(nil), t.askOffers...)
+}
+
+func (t *OrderBookTracker) best(levels *[]PriceLevel) (PriceLevel, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(*levels) == 0 {
+		return PriceLevel{}, false
+	}
+	return (*levels)[0], true
+}
+
+func (t *OrderBookTracker) levels(levels *[]PriceLevel, depth int) []PriceLevel {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if depth <= 0 || depth > len(*levels) {
+		depth = len(*levels)
+	}
+	return append([]PriceLevel(nil), (*levels)[:depth]...)
+}
+
+// enqueue adds an event to the queue of the worker. The queue is unbounded so that the stream handlers never
+// block the reading of messages, which the requests of a resync wait for.
+func (t *OrderBookTracker) enqueue(e orderBookEvent) {
+	t.queueMu.Lock()
+	t.queue = append(t.queue, e)
+	t.queueMu.Unlock()
+
+	select {
+	case t.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run processes the queued events until the tracker is stopped.
+func (t *OrderBookTracker) run() {
+	for {
+		select {
+		case <-t.done:
+			return
+		case <-t.signal:
+		}
+		t.drain()
+	}
+}
+
+// drain processes the queued events, in order.
+func (t *OrderBookTracker) drain() {
+	for {
+		t.queueMu.Lock()
+		events := t.queue
+		t.queue = nil
+		t.queueMu.Unlock()
+		if len(events) == 0 {
+			return
+		}
+		for _, e := range events {
+			t.process(e)
+		}
+	}
+}
+
+// process applies an event to the book.
+func (t *OrderBookTracker) process(e orderBookEvent) {
+	switch {
+	case e.resync:
+		if err := t.subscribe(); err != nil {
+			t.fail(err)
+			return
+		}
+		t.resync()
+	case e.ledger != nil:
+		t.processLedger(e.ledger)
+	case e.tx != nil:
+		t.processTransaction(e.tx)
+	}
+}
+
+// processLedger advances the ledger of the book, and reloads the snapshot if a ledger was missed or the book is
+// out of sync.
+func (t *OrderBookTracker) processLedger(ledger *streamtypes.LedgerStream) {
+	t.mu.Lock()
+	gap := !t.synced || ledger.LedgerIndex > t.ledgerIdx+1
+	if !gap && ledger.LedgerIndex > t.ledgerIdx {
+		t.ledgerIdx = ledger.LedgerIndex
+	}
+	t.mu.Unlock()
+
+	if gap {
+		t.resync()
+	}
+}
+
+// processTransaction applies the changes of a validated transaction to both sides of the book. Transactions
+// included in the snapshot are ignored, and transactions of a ledger after the next one reload the snapshot.
+func (t *OrderBookTracker) processTransaction(tx *streamtypes.TransactionStream) {
+	if !tx.Validated {
+		return
+	}
+
+	t.mu.Lock()
+	if !t.synced || tx.LedgerIndex <= t.snapshotIdx {
+		t.mu.Unlock()
+		return
+	}
+	if tx.LedgerIndex > t.ledgerIdx+1 {
+		t.mu.Unlock()
+		t.resync()
+		return
+	}
+	if tx.LedgerIndex > t.ledgerIdx {
+		t.ledgerIdx = tx.LedgerIndex
+	}
+
+	changedAsks, err := t.asks.ApplyMetadata(tx.Meta)
+	changedBids := false
+	if err == nil {
+		changedBids, err = t.bids.ApplyMetadata(tx.Meta)
+	}
+	changed := changedAsks || changedBids
+	if err == nil && changed {
+		err = t.refresh()
+	}
+	if err != nil {
+		t.synced = false
+	}
+	handler := t.updateHandler
+	t.mu.Unlock()
+
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	if changed && handler != nil {
+		handler(&OrderBookUpdate{LedgerIndex: tx.LedgerIndex, Hash: tx.Hash})
+	}
+}
+
+// resync reloads the snapshot of the book.
+func (t *OrderBookTracker) resync() {
+	if err := t.sync(); err != nil {
+		t.fail(err)
+		return
+	}
+
+	t.mu.RLock()
+	handler, ledgerIndex := t.updateHandler, t.ledgerIdx
+	t.mu.RUnlock()
+	if handler != nil {
+		handler(&OrderBookUpdate{LedgerIndex: ledgerIndex, Resync: true})
+	}
+}
+
+// sync loads the snapshot of the book.
+func (t *OrderBookTracker) sync() error {
+	s, err := t.snapshot()
+	if err != nil {
+		return err
+	}
+
+	asks := orderbook.NewBook(t.cfg.Base, t.cfg.Quote)
+	asks.TakerGetsTransferRate, asks.TakerPaysTransferRate, asks.Taker = s.baseRate, s.quoteRate, t.cfg.Taker
+	if err := asks.Load(s.asks); err != nil {
+		return err
+	}
+	bids := orderbook.NewBook(t.cfg.Quote, t.cfg.Base)
+	bids.TakerGetsTransferRate, bids.TakerPaysTransferRate, bids.Taker = s.quoteRate, s.baseRate, t.cfg.Taker
+	if err := bids.Load(s.bids); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.asks, t.bids = asks, bids
+	if err := t.refresh(); err != nil {
+		t.synced = false
+		return err
+	}
+	t.snapshotIdx, t.ledgerIdx, t.synced = s.ledgerIndex, s.ledgerIndex, true
+	return nil
+}
+
+// fail marks the book out of sync and reports the error.
+func (t *OrderBookTracker) fail(err error) {
+	t.mu.Lock()
+	t.synced = false
+	handler := t.errHandler
+	t.mu.Unlock()
+
+	if handler != nil {
+		handler(err)
+	}
+}
+
+// refresh computes the funded offers and price levels of both sides. It must be called with mu locked.
+func (t *OrderBookTracker) refresh() error {
+	askOffers, err := t.asks.Offers()
+	if err != nil {
+		return err
+	}
+	bidOffers, err := t.bids.Offers()
+	if err != nil {
+		return err
+	}
+	askLevels, err := priceLevels(askOffers, false)
+	if err != nil {
+		return err
+	}
+	bidLevels, err := priceLevels(bidOffers, true)
+	if err != nil {
+		return err
+	}
+	t.askOffers, t.bidOffers, t.askLevels, t.bidLevels = askOffers, bidOffers, askLevels, bidLevels
+	return nil
+}
+
+// subscribeStreams subscribes to both sides of the book and to the ledger stream.
+func (t *OrderBookTracker) subscribeStreams() error {
+	_, err := t.client.Subscribe(&subscribe.Request{
+		Streams: []string{"ledger"},
+		Books:   []streamtypes.OrderBook{t.orderBook()},
+	})
+	return err
+}
+
+// fetchSnapshot requests both sides of the book, and the transfer rates of their issuers, at the last
+// validated ledger.
+func (t *OrderBookTracker) fetchSnapshot() (orderBookSnapshot, error) {
+	ledgerIndex, err := t.client.GetLedgerIndex()
+	if err != nil {
+		return orderBookSnapshot{}, err
+	}
+	s := orderBookSnapshot{ledgerIndex: ledgerIndex}
+
+	if s.baseRate, err = t.transferRate(t.cfg.Base, ledgerIndex); err != nil {
+		return orderBookSnapshot{}, err
+	}
+	if s.quoteRate, err = t.transferRate(t.cfg.Quote, ledgerIndex); err != nil {
+		return orderBookSnapshot{}, err
+	}
+	if s.asks, err = t.bookOffers(t.cfg.Base, t.cfg.Quote, ledgerIndex); err != nil {
+		return orderBookSnapshot{}, err
+	}
+	if s.bids, err = t.bookOffers(t.cfg.Quote, t.cfg.Base, ledgerIndex); err != nil {
+		return orderBookSnapshot{}, err
+	}
+	return s, nil
+}
+
+// bookOffers requests the offers giving takerGets for takerPays.
+func (t *OrderBookTracker) bookOffers(takerGets, takerPays currency.Asset, ledgerIndex common.LedgerIndex) ([]pathtypes.BookOffer, error) {
+	res, err := t.client.GetBookOffers(&path.BookOffersRequest{
+		TakerGets:   bookOfferCurrency(takerGets),
+		TakerPays:   bookOfferCurrency(takerPays),
+		Taker:       t.cfg.Taker,
+		LedgerIndex: ledgerIndex,
+		Limit:       t.cfg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Offers, nil
+}
+
+// transferRate returns the TransferRate of the issuer of an issued currency, and 0 for XRP.
+func (t *OrderBookTracker) transferRate(asset currency.Asset, ledgerIndex common.LedgerIndex) (uint32, error) {
+	if asset.Kind != types.ISSUED {
+		return 0, nil
+	}
+	res, err := t.client.GetAccountInfo(&account.InfoRequest{Account: asset.Issuer, LedgerIndex: ledgerIndex})
+	if err != nil {
+		return 0, err
+	}
+	return res.AccountData.TransferRate, nil
+}
+
+// orderBook returns the book stream subscription of both sides of the book.
+func (t *OrderBookTracker) orderBook() streamtypes.OrderBook {
+	return streamtypes.OrderBook{
+		TakerGets: bookCurrency(t.cfg.Base),
+		TakerPays: bookCurrency(t.cfg.Quote),
+		Taker:     t.cfg.Taker,
+		Both:      true,
+	}
+}
+
+// priceLevels groups funded offers of the same quality into price levels. The offers of bids give Quote for
+// Base, so their price is the inverse of their quality.
+func priceLevels(offers []orderbook.Offer, bids bool) ([]PriceLevel, error) {
+	var levels []PriceLevel
+	for _, o := range offers {
+		if o.TakerGetsFunded.Signum() <= 0 || o.TakerPaysFunded.Signum() <= 0 {
+			continue
+		}
+		base, quote := o.TakerGetsFunded, o.TakerPaysFunded
+		if bids {
+			base, quote = quote, base
+		}
+
+		if n := len(levels); n > 0 && levels[n-1].Quality == o.Quality {
+			l := &levels[n-1]
+			var err error
+			if l.Base, err = l.Base.Add(base); err != nil {
+				return nil, err
+			}
+			if l.Quote, err = l.Quote.Add(quote); err != nil {
+				return nil, err
+			}
+			l.Offers++
+			continue
+		}
+
+		price := o.Quality.Rate()
+		if bids {
+			one, err := currency.NewAmountFromMantissa(price.Asset(), 1, 0, false)
+			if err != nil {
+				return nil, err
+			}
+			if price, err = currency.RoundToNearest.Divide(one, price, price.Asset()); err != nil {
+				return nil, err
+			}
+		}
+		levels = append(levels, PriceLevel{Price: price, Quality: o.Quality, Base: base, Quote: quote, Offers: 1})
+	}
+	return levels, nil
+}
+
+// isBookAsset reports whether an asset can be traded in a book stream: XRP or an issued currency.
+func isBookAsset(asset currency.Asset) bool {
+	return asset.Kind == types.XRP || (asset.Kind == types.ISSUED && asset.Currency != "" && asset.Issuer != "")
+}
+
+func bookCurrency(asset currency.Asset) types.IssuedCurrencyAmount {
+	if asset.Kind == types.XRP {
+		return types.IssuedCurrencyAmount{Currency: "XRP"}
+	}
+	return types.IssuedCurrencyAmount{Currency: asset.Currency, Issuer: asset.Issuer}
+}
+
+func bookOfferCurrency(asset currency.Asset) pathtypes.BookOfferCurrency {
+	if asset.Kind == types.XRP {
+		return pathtypes.BookOfferCurrency{Currency: "XRP"}
+	}
+	return pathtypes.BookOfferCurrency{Currency: asset.Currency, Issuer: asset.Issuer.String()}
+}
@@ -0,0 +1,274 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	trackerIssuer types.Address = "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"
+	trackerAlice  types.Address = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	trackerBob    types.Address = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+)
+
+func trackerUSD(value string) map[string]any {
+	return map[string]any{"currency": "USD", "issuer": string(trackerIssuer), "value": value}
+}
+
+func trackerIndex(n int) types.Hash256 {
+	return types.Hash256(fmt.Sprintf("%064X", n))
+}
+
+// trackerSnapshot returns a snapshot of the USD/XRP book at ledgerIndex: asks of 10 and 5 USD at 2 XRP and of
+// 10 USD at 2.5 XRP, and a bid of 18 XRP for 10 USD.
+func trackerSnapshot(ledgerIndex common.LedgerIndex) orderBookSnapshot {
+	return orderBookSnapshot{
+		ledgerIndex: ledgerIndex,
+		asks: []pathtypes.BookOffer{
+			{Index: trackerIndex(1), Account: trackerAlice, Sequence: 1, TakerGets: trackerUSD("10"), TakerPays: "20000000"},
+			{Index: trackerIndex(2), Account: trackerBob, Sequence: 2, TakerGets: trackerUSD("5"), TakerPays: "10000000"},
+			{Index: trackerIndex(3), Account: trackerBob, Sequence: 3, TakerGets: trackerUSD("10"), TakerPays: "25000000"},
+		},
+		bids: []pathtypes.BookOffer{
+			{Index: trackerIndex(4), Account: trackerAlice, Sequence: 4, TakerGets: "18000000", TakerPays: trackerUSD("10")},
+		},
+	}
+}
+
+// newTestTracker returns a tracker of the USD/XRP book synced from trackerSnapshot, counting the snapshots.
+func newTestTracker(t *testing.T, ledgerIndex common.LedgerIndex, snapshotErr *error) (*OrderBookTracker, *int) {
+	t.Helper()
+	tracker, err := NewOrderBookTracker(nil, OrderBookTrackerConfig{
+		Base:  currency.IssuedAsset("USD", trackerIssuer),
+		Quote: currency.XRPAsset(),
+	})
+	require.NoError(t, err)
+
+	snapshots := 0
+	tracker.subscribe = func() error { return nil }
+	tracker.snapshot = func() (orderBookSnapshot, error) {
+		if snapshotErr != nil && *snapshotErr != nil {
+			return orderBookSnapshot{}, *snapshotErr
+		}
+		snapshots++
+		return trackerSnapshot(ledgerIndex + common.LedgerIndex(snapshots-1)), nil
+	}
+	require.NoError(t, tracker.sync())
+	return tracker, &snapshots
+}
+
+func createdOfferTx(ledgerIndex common.LedgerIndex, n int, gets, pays any) *streamtypes.TransactionStream {
+	return &streamtypes.TransactionStream{
+		Hash:        common.LedgerHash(fmt.Sprintf("%064X", 1000+n)),
+		LedgerIndex: ledgerIndex,
+		Validated:   true,
+		Meta: transaction.TxObjMeta{AffectedNodes: []transaction.AffectedNode{{
+			CreatedNode: &transaction.CreatedNode{
+				LedgerEntryType: ledger.OfferEntry,
+				LedgerIndex:     string(trackerIndex(n)),
+				NewFields: ledger.FlatLedgerObject{
+					"Account":   string(trackerBob),
+					"Sequence":  float64(n),
+					"TakerGets": gets,
+					"TakerPays": pays,
+				},
+			},
+		}}},
+	}
+}
+
+func TestNewOrderBookTracker(t *testing.T) {
+	usd := currency.IssuedAsset("USD", trackerIssuer)
+
+	tt := []struct {
+		description string
+		base, quote currency.Asset
+		expectedErr error
+	}{
+		{description: "pass - issued currency and XRP", base: usd, quote: currency.XRPAsset()},
+		{description: "fail - same assets", base: usd, quote: usd, expectedErr: ErrInvalidOrderBookAssets},
+		{description: "fail - MPT", base: currency.MPTAsset("00000001"), quote: currency.XRPAsset(), expectedErr: ErrInvalidOrderBookAssets},
+		{description: "fail - issued currency without issuer", base: currency.IssuedAsset("USD", ""), quote: currency.XRPAsset(), expectedErr: ErrInvalidOrderBookAssets},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			tracker, err := NewOrderBookTracker(nil, OrderBookTrackerConfig{Base: tc.base, Quote: tc.quote})
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, tracker)
+			require.False(t, tracker.Synced())
+		})
+	}
+}
+
+func TestOrderBookTracker_View(t *testing.T) {
+	tracker, _ := newTestTracker(t, 100, nil)
+	require.True(t, tracker.Synced())
+	require.Equal(t, common.LedgerIndex(100), tracker.LedgerIndex())
+
+	ask, ok := tracker.BestAsk()
+	require.True(t, ok)
+	require.Equal(t, "2000000", ask.Price.String())
+	require.Equal(t, "15", ask.Base.String())
+	require.Equal(t, "30000000", ask.Quote.String())
+	require.Equal(t, 2, ask.Offers)
+
+	asks := tracker.Asks(0)
+	require.Len(t, asks, 2)
+	require.Equal(t, "2500000", asks[1].Price.String())
+	require.Len(t, tracker.Asks(1), 1)
+	require.Len(t, tracker.AskOffers(), 3)
+
+	bid, ok := tracker.BestBid()
+	require.True(t, ok)
+	require.Equal(t, "1800000", bid.Price.String())
+	require.Equal(t, "10", bid.Base.String())
+	require.Equal(t, "18000000", bid.Quote.String())
+	require.Len(t, tracker.Bids(5), 1)
+	require.Len(t, tracker.BidOffers(), 1)
+}
+
+func TestOrderBookTracker_ProcessTransaction(t *testing.T) {
+	tt := []struct {
+		description string
+		tx          *streamtypes.TransactionStream
+		updated     bool
+		bestAsk     string
+		snapshots   int
+	}{
+		{
+			description: "pass - offer created in the next ledger",
+			tx:          createdOfferTx(101, 5, trackerUSD("1"), "1500000"),
+			updated:     true,
+			bestAsk:     "1500000",
+			snapshots:   1,
+		},
+		{
+			description: "pass - offer created in the current ledger",
+			tx:          createdOfferTx(100, 5, trackerUSD("1"), "1500000"),
+			bestAsk:     "2000000",
+			snapshots:   1,
+		},
+		{
+			description: "pass - not validated",
+			tx: func() *streamtypes.TransactionStream {
+				tx := createdOfferTx(101, 5, trackerUSD("1"), "1500000")
+				tx.Validated = false
+				return tx
+			}(),
+			bestAsk:   "2000000",
+			snapshots: 1,
+		},
+		{
+			description: "pass - offer of another book",
+			tx:          createdOfferTx(101, 5, trackerUSD("1"), trackerUSD("2")),
+			bestAsk:     "2000000",
+			snapshots:   1,
+		},
+		{
+			description: "pass - gap resyncs",
+			tx:          createdOfferTx(103, 5, trackerUSD("1"), "1500000"),
+			updated:     true,
+			bestAsk:     "2000000",
+			snapshots:   2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			tracker, snapshots := newTestTracker(t, 100, nil)
+			var updates []*OrderBookUpdate
+			tracker.OnUpdate(func(update *OrderBookUpdate) {
+				updates = append(updates, update)
+			})
+
+			tracker.HandleTransaction(tc.tx)
+			tracker.drain()
+
+			require.Equal(t, tc.snapshots, *snapshots)
+			require.Equal(t, tc.updated, len(updates) == 1)
+			ask, ok := tracker.BestAsk()
+			require.True(t, ok)
+			require.Equal(t, tc.bestAsk, ask.Price.String())
+			if tc.updated && tc.snapshots == 1 {
+				require.Equal(t, &OrderBookUpdate{LedgerIndex: tc.tx.LedgerIndex, Hash: tc.tx.Hash}, updates[0])
+			}
+		})
+	}
+}
+
+func TestOrderBookTracker_ProcessLedger(t *testing.T) {
+	tracker, snapshots := newTestTracker(t, 100, nil)
+	var updates []*OrderBookUpdate
+	tracker.OnUpdate(func(update *OrderBookUpdate) {
+		updates = append(updates, update)
+	})
+
+	tracker.HandleLedgerClosed(&streamtypes.LedgerStream{LedgerIndex: 100})
+	tracker.HandleLedgerClosed(&streamtypes.LedgerStream{LedgerIndex: 101})
+	tracker.drain()
+	require.Equal(t, 1, *snapshots)
+	require.Equal(t, common.LedgerIndex(101), tracker.LedgerIndex())
+	require.Empty(t, updates)
+
+	tracker.HandleLedgerClosed(&streamtypes.LedgerStream{LedgerIndex: 103})
+	tracker.drain()
+	require.Equal(t, 2, *snapshots)
+	require.Equal(t, common.LedgerIndex(101), tracker.LedgerIndex())
+	require.Equal(t, []*OrderBookUpdate{{LedgerIndex: 101, Resync: true}}, updates)
+}
+
+func TestOrderBookTracker_ResyncError(t *testing.T) {
+	var snapshotErr error
+	tracker, snapshots := newTestTracker(t, 100, &snapshotErr)
+	var errs []error
+	tracker.OnError(func(err error) {
+		errs = append(errs, err)
+	})
+
+	snapshotErr = errors.New("book_offers failed")
+	tracker.Resync()
+	tracker.drain()
+	require.Equal(t, []error{snapshotErr}, errs)
+	require.False(t, tracker.Synced())
+
+	tracker.HandleTransaction(createdOfferTx(101, 5, trackerUSD("1"), "1500000"))
+	tracker.drain()
+	ask, ok := tracker.BestAsk()
+	require.True(t, ok)
+	require.Equal(t, "2000000", ask.Price.String())
+
+	snapshotErr = nil
+	tracker.HandleLedgerClosed(&streamtypes.LedgerStream{LedgerIndex: 101})
+	tracker.drain()
+	require.True(t, tracker.Synced())
+	require.Equal(t, 2, *snapshots)
+	require.Len(t, errs, 1)
+}
+
+func TestOrderBookTracker_ApplyError(t *testing.T) {
+	tracker, _ := newTestTracker(t, 100, nil)
+	var errs []error
+	tracker.OnError(func(err error) {
+		errs = append(errs, err)
+	})
+
+	tracker.HandleTransaction(createdOfferTx(101, 5, trackerUSD("1"), "not drops"))
+	tracker.drain()
+	require.Len(t, errs, 1)
+	require.False(t, tracker.Synced())
+}
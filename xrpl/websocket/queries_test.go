@@ -7,9 +7,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/admin"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	ledgerqueries "github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
@@ -21,6 +23,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -2403,6 +2406,88 @@ func TestClient_GetFee(t *testing.T) {
 	}
 }
 
+func TestClient_GetServerDefinitions(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *server.DefinitionsResponse
+		expectedErr    error
+	}{
+		{
+			name: "successful response",
+			serverMessages: []map[string]any{
+				{
+					"id":     1,
+					"status": "success",
+					"type":   "response",
+					"result": map[string]any{
+						"TYPES":              map[string]any{"UInt32": 2},
+						"LEDGER_ENTRY_TYPES": map[string]any{"AccountRoot": 97},
+						"FIELDS": []any{
+							[]any{"Sequence", map[string]any{"nth": 4, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}},
+						},
+						"TRANSACTION_RESULTS": map[string]any{"tesSUCCESS": 0},
+						"TRANSACTION_TYPES":   map[string]any{"Payment": 0},
+						"hash":                "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+					},
+				},
+			},
+			expected: &server.DefinitionsResponse{
+				Document: definitions.Document{
+					Types:            map[string]int32{"UInt32": 2},
+					LedgerEntryTypes: map[string]int32{"AccountRoot": 97},
+					Fields: []definitions.FieldDefinition{
+						{
+							Name: "Sequence",
+							Info: definitions.FieldInfo{Nth: 4, IsSerialized: true, IsSigningField: true, Type: "UInt32"},
+						},
+					},
+					TransactionResults: map[string]int32{"tesSUCCESS": 0},
+					TransactionTypes:   map[string]int32{"Payment": 0},
+				},
+				Hash: "3B4A2F6C4A1B3E9E8F7D5C2B0A9E8D7C6B5A4F3E2D1C0B9A8F7E6D5C4B3A2F1E",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error response",
+			serverMessages: []map[string]any{
+				{
+					"id":     1,
+					"status": "error",
+					"type":   "response",
+					"error":  "invalidParams",
+				},
+			},
+			expected:    nil,
+			expectedErr: errors.New("invalidParams"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetServerDefinitions(&server.DefinitionsRequest{})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_GetManifest(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2753,3 +2838,95 @@ func TestClient_GetRandom(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_LedgerAccept(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *admin.LedgerAcceptResponse
+		expectedErr    error
+	}{
+		{
+			name: "successful ledger accept",
+			serverMessages: []map[string]any{
+				{
+					"id":     1,
+					"result": map[string]any{"ledger_current_index": 6643099},
+				},
+			},
+			expected:    &admin.LedgerAcceptResponse{LedgerCurrentIndex: 6643099},
+			expectedErr: nil,
+		},
+		{
+			name: "not standalone",
+			serverMessages: []map[string]any{
+				{
+					"id":     1,
+					"error":  "notStandAlone",
+					"status": "error",
+					"type":   "response",
+				},
+			},
+			expected:    nil,
+			expectedErr: errors.New("notStandAlone"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.LedgerAccept(&admin.LedgerAcceptRequest{})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestClient_GetTx_Binary(t *testing.T) {
+	cl, cleanup := setupTestClient(t, []map[string]any{
+		{
+			"id":     1,
+			"status": "success",
+			"type":   "response",
+			"result": map[string]any{
+				"hash":         "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+				"ledger_index": 71766343,
+				"meta_blob":    "201C00000001031000",
+				"tx_blob":      "12000022800000002400000001614000000005F5E10068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100A7CCD1B5F67D76C7F2C0B6C199C9D2F3721A14A3C69F3CB134E9BF9D9DD6F8B002206A5F974C4F4D07B4F5A99391BF3E93D9B0A7346861E12E924B6451B6D8ED4F0981144B4E9C06F24296074F7BC48F92A97916C6DC5EA983143E9D4A2B8AA0780F682D136F7A56D6724EF53754",
+				"validated":    true,
+			},
+		},
+	})
+	defer cleanup()
+
+	resp, err := cl.GetTx(&transactions.TxRequest{
+		Transaction: "2E2DDBF5B8F29AEED7494CC0A863A93A4BD3C066BF880A577C5C466EE2C637DF",
+		Binary:      true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.TxJSON["TransactionType"] != "Payment" {
+		t.Errorf("Expected Payment transaction, but got %v", resp.TxJSON["TransactionType"])
+	}
+	if resp.Meta.TransactionResult != "tesSUCCESS" || resp.Meta.TransactionIndex != 1 {
+		t.Errorf("Unexpected metadata: %+v", resp.Meta)
+	}
+	if resp.Meta.DeliveredAmount != "100000000" {
+		t.Errorf("Expected delivered amount 100000000, but got %v", resp.Meta.DeliveredAmount)
+	}
+}
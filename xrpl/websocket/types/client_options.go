@@ -2,12 +2,8 @@
 package types
 
 import (
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 // SubmitOptions configures transaction submission options over WebSocket, including autofill, wallet and fail-hard.
-type SubmitOptions struct {
-	Autofill bool
-	Wallet   *wallet.Wallet
-	FailHard bool
-}
+type SubmitOptions = xrpl.SubmitOptions